@@ -1,6 +1,11 @@
 #!/bin/bash
-protoc --proto_path=third_party --proto_path=api/proto/v1 --go_out=plugins=grpc:pkg/api/v1               todo-service.proto
-protoc --proto_path=third_party --proto_path=api/proto/v1 --gorm_out=logtostderr=true:pkg/api/v1         todo-service.proto
-protoc --proto_path=third_party --proto_path=api/proto/v1 --grpc-gateway_out=logtostderr=true:pkg/api/v1 todo-service.proto
-protoc --proto_path=third_party --proto_path=api/proto/v1 --swagger_out=logtostderr=true:api/swagger/v1  todo-service.proto
+protoc --proto_path=api/proto --go_out=paths=source_relative:pkg/api log/log.proto
 
+protoc --proto_path=third_party --proto_path=api/proto --proto_path=api/proto/v1 --go_out=plugins=grpc:pkg/api/v1               todo-service.proto
+protoc --proto_path=third_party --proto_path=api/proto --proto_path=api/proto/v1 --gorm_out=logtostderr=true:pkg/api/v1         todo-service.proto
+protoc --proto_path=third_party --proto_path=api/proto --proto_path=api/proto/v1 --grpc-gateway_out=logtostderr=true:pkg/api/v1 todo-service.proto
+protoc --proto_path=third_party --proto_path=api/proto --proto_path=api/proto/v1 --swagger_out=logtostderr=true:api/swagger/v1  todo-service.proto
+
+protoc --proto_path=third_party --proto_path=api/proto --proto_path=api/proto/v1 --go_out=plugins=grpc:pkg/api/v1               admin-service.proto
+protoc --proto_path=third_party --proto_path=api/proto --proto_path=api/proto/v1 --grpc-gateway_out=logtostderr=true:pkg/api/v1 admin-service.proto
+protoc --proto_path=third_party --proto_path=api/proto --proto_path=api/proto/v1 --swagger_out=logtostderr=true:api/swagger/v1  admin-service.proto
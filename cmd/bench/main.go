@@ -0,0 +1,11 @@
+package main
+
+import (
+	"os"
+
+	"go.smartmachine.io/go-grpc-api/pkg/cmd/bench"
+)
+
+func main() {
+	os.Exit(bench.Run(os.Args[1:], os.Stdout, os.Stderr))
+}
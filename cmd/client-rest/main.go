@@ -1,14 +1,20 @@
 package main
 
 import (
-	"encoding/json"
+	"context"
 	"flag"
-	"fmt"
-	"io/ioutil"
 	"log"
-	"net/http"
-	"strings"
 	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
+	"go.smartmachine.io/go-grpc-api/pkg/client/rest"
+)
+
+const (
+	// apiVersion is version of API is provided by server
+	apiVersion = "v1"
 )
 
 func main() {
@@ -16,111 +22,72 @@ func main() {
 	address := flag.String("server", "http://localhost:8080", "HTTP gateway url, e.g. http://localhost:8080")
 	flag.Parse()
 
+	c := rest.NewToDoServiceClient(*address, nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
 	t := time.Now().In(time.UTC)
+	reminder, _ := ptypes.TimestampProto(t)
 	pfx := t.Format(time.RFC3339Nano)
 
-	var body string
-
 	// Call Create
-	resp, err := http.Post(*address+"/v1/todo", "application/json", strings.NewReader(fmt.Sprintf(`
-		{
-			"api":"v1",
-			"toDo": {
-				"title":"title (%s)",
-				"description":"description (%s)",
-				"reminder":"%s"
-			}
-		}
-	`, pfx, pfx, pfx)))
+	res1, err := c.Create(ctx, &v1.CreateRequest{
+		Api: apiVersion,
+		ToDo: &v1.ToDo{
+			Title:       "title (" + pfx + ")",
+			Description: "description (" + pfx + ")",
+			Reminder:    reminder,
+		},
+	})
 	if err != nil {
-		log.Fatalf("failed to call Create method: %v", err)
+		log.Fatalf("Create failed: %v", err)
 	}
-	bodyBytes, err := ioutil.ReadAll(resp.Body)
-	resp.Body.Close()
-	if err != nil {
-		body = fmt.Sprintf("failed read Create response body: %v", err)
-	} else {
-		body = string(bodyBytes)
-	}
-	log.Printf("Create response: Code=%d, Body=%s\n\n", resp.StatusCode, body)
+	log.Printf("Create result: <%+v>\n\n", res1)
 
-	// parse ID of created ToDo
-	var created struct {
-		API string `json:"api"`
-		ID  string `json:"id"`
-	}
-	err = json.Unmarshal(bodyBytes, &created)
-	if err != nil {
-		log.Fatalf("failed to unmarshal JSON response of Create method: %v", err)
-		fmt.Println("error:", err)
-	}
+	id := res1.Id
 
-	// Call Read
-	resp, err = http.Get(fmt.Sprintf("%s%s/%s", *address, "/v1/todo", created.ID))
+	// Read
+	res2, err := c.Read(ctx, &v1.ReadRequest{
+		Api: apiVersion,
+		Id:  id,
+	})
 	if err != nil {
-		log.Fatalf("failed to call Read method: %v", err)
+		log.Fatalf("Read failed: %v", err)
 	}
-	bodyBytes, err = ioutil.ReadAll(resp.Body)
-	resp.Body.Close()
-	if err != nil {
-		body = fmt.Sprintf("failed read Read response body: %v", err)
-	} else {
-		body = string(bodyBytes)
-	}
-	log.Printf("Read response: Code=%d, Body=%s\n\n", resp.StatusCode, body)
+	log.Printf("Read result: <%+v>\n\n", res2)
 
-	// Call Update
-	req, err := http.NewRequest("PUT", fmt.Sprintf("%s%s/%s", *address, "/v1/todo", created.ID),
-		strings.NewReader(fmt.Sprintf(`
-		{
-			"api":"v1",
-			"toDo": {
-				"title":"title (%s) + updated",
-				"description":"description (%s) + updated",
-				"reminder":"%s"
-			}
-		}
-	`, pfx, pfx, pfx)))
-	req.Header.Set("Content-Type", "application/json")
-	resp, err = http.DefaultClient.Do(req)
-	if err != nil {
-		log.Fatalf("failed to call Update method: %v", err)
-	}
-	bodyBytes, err = ioutil.ReadAll(resp.Body)
-	resp.Body.Close()
+	// Update
+	res3, err := c.Update(ctx, &v1.UpdateRequest{
+		Api: apiVersion,
+		ToDo: &v1.ToDo{
+			Id:          res2.ToDo.Id,
+			Title:       res2.ToDo.Title + " + updated",
+			Description: res2.ToDo.Description + " + updated",
+			Reminder:    res2.ToDo.Reminder,
+		},
+	})
 	if err != nil {
-		body = fmt.Sprintf("failed read Update response body: %v", err)
-	} else {
-		body = string(bodyBytes)
+		log.Fatalf("Update failed: %v", err)
 	}
-	log.Printf("Update response: Code=%d, Body=%s\n\n", resp.StatusCode, body)
+	log.Printf("Update result: <%+v>\n\n", res3)
 
 	// Call ReadAll
-	resp, err = http.Get(*address + "/v1/todo/all")
-	if err != nil {
-		log.Fatalf("failed to call ReadAll method: %v", err)
-	}
-	bodyBytes, err = ioutil.ReadAll(resp.Body)
-	resp.Body.Close()
+	res4, err := c.ReadAll(ctx, &v1.ReadAllRequest{
+		Api: apiVersion,
+	})
 	if err != nil {
-		body = fmt.Sprintf("failed read ReadAll response body: %v", err)
-	} else {
-		body = string(bodyBytes)
+		log.Fatalf("ReadAll failed: %v", err)
 	}
-	log.Printf("ReadAll response: Code=%d, Body=%s\n\n", resp.StatusCode, body)
+	log.Printf("ReadAll result: <%+v>\n\n", res4)
 
-	// Call Delete
-	req, err = http.NewRequest("DELETE", fmt.Sprintf("%s%s/%s", *address, "/v1/todo", created.ID), nil)
-	resp, err = http.DefaultClient.Do(req)
-	if err != nil {
-		log.Fatalf("failed to call Delete method: %v", err)
-	}
-	bodyBytes, err = ioutil.ReadAll(resp.Body)
-	resp.Body.Close()
+	// Delete
+	res5, err := c.Delete(ctx, &v1.DeleteRequest{
+		Api: apiVersion,
+		Id:  id,
+	})
 	if err != nil {
-		body = fmt.Sprintf("failed read Delete response body: %v", err)
-	} else {
-		body = string(bodyBytes)
+		log.Fatalf("Delete failed: %v", err)
 	}
-	log.Printf("Delete response: Code=%d, Body=%s\n\n", resp.StatusCode, body)
+	log.Printf("Delete result: <%+v>\n\n", res5)
 }
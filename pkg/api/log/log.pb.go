@@ -0,0 +1,51 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: log/log.proto
+
+package log
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	descriptor "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+var E_Redact = &proto.ExtensionDesc{
+	ExtendedType:  (*descriptor.FieldOptions)(nil),
+	ExtensionType: (*bool)(nil),
+	Field:         50001,
+	Name:          "log.redact",
+	Tag:           "varint,50001,opt,name=redact",
+	Filename:      "log/log.proto",
+}
+
+func init() {
+	proto.RegisterExtension(E_Redact)
+}
+
+func init() { proto.RegisterFile("log/log.proto", fileDescriptor_41e0d72543c57a28) }
+
+var fileDescriptor_41e0d72543c57a28 = []byte{
+	// 159 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0xcd, 0xc9, 0x4f, 0xd7,
+	0xcf, 0xc9, 0x4f, 0xd7, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0xce, 0xc9, 0x4f, 0x97, 0x52,
+	0x48, 0xcf, 0xcf, 0x4f, 0xcf, 0x49, 0xd5, 0x07, 0x0b, 0x25, 0x95, 0xa6, 0xe9, 0xa7, 0xa4, 0x16,
+	0x27, 0x17, 0x65, 0x16, 0x94, 0xe4, 0x17, 0x41, 0x94, 0x59, 0x99, 0x73, 0xb1, 0x15, 0xa5, 0xa6,
+	0x24, 0x26, 0x97, 0x08, 0xc9, 0xea, 0x41, 0x14, 0xeb, 0xc1, 0x14, 0xeb, 0xb9, 0x65, 0xa6, 0xe6,
+	0xa4, 0xf8, 0x17, 0x94, 0x64, 0xe6, 0xe7, 0x15, 0x4b, 0x5c, 0x6c, 0x63, 0x56, 0x60, 0xd4, 0xe0,
+	0x08, 0x82, 0x2a, 0x77, 0xd2, 0x89, 0xd2, 0x4a, 0xcf, 0xd7, 0x2b, 0xce, 0x4d, 0x2c, 0x2a, 0xc9,
+	0x4d, 0x4c, 0xce, 0xc8, 0xcc, 0x4b, 0xd5, 0xcb, 0xcc, 0xd7, 0x4f, 0xcf, 0xd7, 0x4d, 0x2f, 0x2a,
+	0x48, 0xd6, 0x4d, 0x2c, 0xc8, 0xd4, 0x2f, 0xc8, 0x4e, 0xd7, 0x07, 0xd1, 0x39, 0xf9, 0xe9, 0x49,
+	0x6c, 0x60, 0x43, 0x8d, 0x01, 0x03, 0x00, 0xaa, 0x07, 0x15, 0xdc, 0xa5, 0x00, 0x00, 0x00,
+}
@@ -0,0 +1,1204 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: admin-service.proto
+
+package v1
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	_ "github.com/grpc-ecosystem/grpc-gateway/protoc-gen-swagger/options"
+	_ "go.smartmachine.io/go-grpc-api/pkg/api/log"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// Log level of a named logger
+type LogLevel struct {
+	// Logger name: package name (e.g. "rest"), gRPC service (e.g. "/v1.ToDoService")
+	// or gRPC method (e.g. "/v1.ToDoService/Create"). Empty name is the global log level.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Log level: debug, info, warn, error, dpanic, panic or fatal
+	Level                string   `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LogLevel) Reset()         { *m = LogLevel{} }
+func (m *LogLevel) String() string { return proto.CompactTextString(m) }
+func (*LogLevel) ProtoMessage()    {}
+func (*LogLevel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92fc024f5e3d0ce8, []int{0}
+}
+
+func (m *LogLevel) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogLevel.Unmarshal(m, b)
+}
+func (m *LogLevel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogLevel.Marshal(b, m, deterministic)
+}
+func (m *LogLevel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogLevel.Merge(m, src)
+}
+func (m *LogLevel) XXX_Size() int {
+	return xxx_messageInfo_LogLevel.Size(m)
+}
+func (m *LogLevel) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogLevel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogLevel proto.InternalMessageInfo
+
+func (m *LogLevel) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *LogLevel) GetLevel() string {
+	if m != nil {
+		return m.Level
+	}
+	return ""
+}
+
+// Request data to read log levels
+type GetLogLevelsRequest struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api                  string   `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetLogLevelsRequest) Reset()         { *m = GetLogLevelsRequest{} }
+func (m *GetLogLevelsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetLogLevelsRequest) ProtoMessage()    {}
+func (*GetLogLevelsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92fc024f5e3d0ce8, []int{1}
+}
+
+func (m *GetLogLevelsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLogLevelsRequest.Unmarshal(m, b)
+}
+func (m *GetLogLevelsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLogLevelsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetLogLevelsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLogLevelsRequest.Merge(m, src)
+}
+func (m *GetLogLevelsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetLogLevelsRequest.Size(m)
+}
+func (m *GetLogLevelsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLogLevelsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLogLevelsRequest proto.InternalMessageInfo
+
+func (m *GetLogLevelsRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+// Contains global log level and all overrides
+type GetLogLevelsResponse struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Global log level
+	Level string `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`
+	// Log level overrides of named loggers
+	Overrides            []*LogLevel `protobuf:"bytes,3,rep,name=overrides,proto3" json:"overrides,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *GetLogLevelsResponse) Reset()         { *m = GetLogLevelsResponse{} }
+func (m *GetLogLevelsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetLogLevelsResponse) ProtoMessage()    {}
+func (*GetLogLevelsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92fc024f5e3d0ce8, []int{2}
+}
+
+func (m *GetLogLevelsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLogLevelsResponse.Unmarshal(m, b)
+}
+func (m *GetLogLevelsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLogLevelsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetLogLevelsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLogLevelsResponse.Merge(m, src)
+}
+func (m *GetLogLevelsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetLogLevelsResponse.Size(m)
+}
+func (m *GetLogLevelsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLogLevelsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLogLevelsResponse proto.InternalMessageInfo
+
+func (m *GetLogLevelsResponse) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *GetLogLevelsResponse) GetLevel() string {
+	if m != nil {
+		return m.Level
+	}
+	return ""
+}
+
+func (m *GetLogLevelsResponse) GetOverrides() []*LogLevel {
+	if m != nil {
+		return m.Overrides
+	}
+	return nil
+}
+
+// Request data to change log level
+type SetLogLevelRequest struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Log level to set. Empty level of a named logger removes its override
+	LogLevel             *LogLevel `protobuf:"bytes,2,opt,name=logLevel,proto3" json:"logLevel,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *SetLogLevelRequest) Reset()         { *m = SetLogLevelRequest{} }
+func (m *SetLogLevelRequest) String() string { return proto.CompactTextString(m) }
+func (*SetLogLevelRequest) ProtoMessage()    {}
+func (*SetLogLevelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92fc024f5e3d0ce8, []int{3}
+}
+
+func (m *SetLogLevelRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetLogLevelRequest.Unmarshal(m, b)
+}
+func (m *SetLogLevelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetLogLevelRequest.Marshal(b, m, deterministic)
+}
+func (m *SetLogLevelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetLogLevelRequest.Merge(m, src)
+}
+func (m *SetLogLevelRequest) XXX_Size() int {
+	return xxx_messageInfo_SetLogLevelRequest.Size(m)
+}
+func (m *SetLogLevelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetLogLevelRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetLogLevelRequest proto.InternalMessageInfo
+
+func (m *SetLogLevelRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *SetLogLevelRequest) GetLogLevel() *LogLevel {
+	if m != nil {
+		return m.LogLevel
+	}
+	return nil
+}
+
+// Contains log levels after the change
+type SetLogLevelResponse struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Global log level
+	Level string `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`
+	// Log level overrides of named loggers
+	Overrides            []*LogLevel `protobuf:"bytes,3,rep,name=overrides,proto3" json:"overrides,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *SetLogLevelResponse) Reset()         { *m = SetLogLevelResponse{} }
+func (m *SetLogLevelResponse) String() string { return proto.CompactTextString(m) }
+func (*SetLogLevelResponse) ProtoMessage()    {}
+func (*SetLogLevelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92fc024f5e3d0ce8, []int{4}
+}
+
+func (m *SetLogLevelResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetLogLevelResponse.Unmarshal(m, b)
+}
+func (m *SetLogLevelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetLogLevelResponse.Marshal(b, m, deterministic)
+}
+func (m *SetLogLevelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetLogLevelResponse.Merge(m, src)
+}
+func (m *SetLogLevelResponse) XXX_Size() int {
+	return xxx_messageInfo_SetLogLevelResponse.Size(m)
+}
+func (m *SetLogLevelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetLogLevelResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetLogLevelResponse proto.InternalMessageInfo
+
+func (m *SetLogLevelResponse) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *SetLogLevelResponse) GetLevel() string {
+	if m != nil {
+		return m.Level
+	}
+	return ""
+}
+
+func (m *SetLogLevelResponse) GetOverrides() []*LogLevel {
+	if m != nil {
+		return m.Overrides
+	}
+	return nil
+}
+
+// Webhook endpoint receiving changes of todo tasks and fired reminders.
+// Events are posted as JSON Event messages signed in X-Webhook-Signature header
+// as "sha256=" followed by hex encoded HMAC-SHA256 of the body keyed by the secret
+type Webhook struct {
+	// Unique integer identifier of the webhook
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Absolute http or https URL events are posted to
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// Secret payloads are signed with, it is generated if not set.
+	// Secret is returned only when webhook is created
+	Secret string `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+	// Kinds of events delivered, all kinds if empty
+	Types []EventType `protobuf:"varint,4,rep,packed,name=types,proto3,enum=v1.EventType" json:"types,omitempty"`
+	// Date and time the webhook was created
+	CreateTime           *timestamp.Timestamp `protobuf:"bytes,5,opt,name=createTime,proto3" json:"createTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Webhook) Reset()         { *m = Webhook{} }
+func (m *Webhook) String() string { return proto.CompactTextString(m) }
+func (*Webhook) ProtoMessage()    {}
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92fc024f5e3d0ce8, []int{5}
+}
+
+func (m *Webhook) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Webhook.Unmarshal(m, b)
+}
+func (m *Webhook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Webhook.Marshal(b, m, deterministic)
+}
+func (m *Webhook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Webhook.Merge(m, src)
+}
+func (m *Webhook) XXX_Size() int {
+	return xxx_messageInfo_Webhook.Size(m)
+}
+func (m *Webhook) XXX_DiscardUnknown() {
+	xxx_messageInfo_Webhook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Webhook proto.InternalMessageInfo
+
+func (m *Webhook) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Webhook) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *Webhook) GetSecret() string {
+	if m != nil {
+		return m.Secret
+	}
+	return ""
+}
+
+func (m *Webhook) GetTypes() []EventType {
+	if m != nil {
+		return m.Types
+	}
+	return nil
+}
+
+func (m *Webhook) GetCreateTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreateTime
+	}
+	return nil
+}
+
+// Request data to register webhook
+type CreateWebhookRequest struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Webhook to register, ID is assigned by server
+	Webhook              *Webhook `protobuf:"bytes,2,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateWebhookRequest) Reset()         { *m = CreateWebhookRequest{} }
+func (m *CreateWebhookRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateWebhookRequest) ProtoMessage()    {}
+func (*CreateWebhookRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92fc024f5e3d0ce8, []int{6}
+}
+
+func (m *CreateWebhookRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateWebhookRequest.Unmarshal(m, b)
+}
+func (m *CreateWebhookRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateWebhookRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateWebhookRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateWebhookRequest.Merge(m, src)
+}
+func (m *CreateWebhookRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateWebhookRequest.Size(m)
+}
+func (m *CreateWebhookRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateWebhookRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateWebhookRequest proto.InternalMessageInfo
+
+func (m *CreateWebhookRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *CreateWebhookRequest) GetWebhook() *Webhook {
+	if m != nil {
+		return m.Webhook
+	}
+	return nil
+}
+
+// Contains registered webhook
+type CreateWebhookResponse struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Registered webhook with its secret
+	Webhook              *Webhook `protobuf:"bytes,2,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateWebhookResponse) Reset()         { *m = CreateWebhookResponse{} }
+func (m *CreateWebhookResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateWebhookResponse) ProtoMessage()    {}
+func (*CreateWebhookResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92fc024f5e3d0ce8, []int{7}
+}
+
+func (m *CreateWebhookResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateWebhookResponse.Unmarshal(m, b)
+}
+func (m *CreateWebhookResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateWebhookResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateWebhookResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateWebhookResponse.Merge(m, src)
+}
+func (m *CreateWebhookResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateWebhookResponse.Size(m)
+}
+func (m *CreateWebhookResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateWebhookResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateWebhookResponse proto.InternalMessageInfo
+
+func (m *CreateWebhookResponse) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *CreateWebhookResponse) GetWebhook() *Webhook {
+	if m != nil {
+		return m.Webhook
+	}
+	return nil
+}
+
+// Request data to read all webhooks
+type ListWebhooksRequest struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api                  string   `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListWebhooksRequest) Reset()         { *m = ListWebhooksRequest{} }
+func (m *ListWebhooksRequest) String() string { return proto.CompactTextString(m) }
+func (*ListWebhooksRequest) ProtoMessage()    {}
+func (*ListWebhooksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92fc024f5e3d0ce8, []int{8}
+}
+
+func (m *ListWebhooksRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListWebhooksRequest.Unmarshal(m, b)
+}
+func (m *ListWebhooksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListWebhooksRequest.Marshal(b, m, deterministic)
+}
+func (m *ListWebhooksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListWebhooksRequest.Merge(m, src)
+}
+func (m *ListWebhooksRequest) XXX_Size() int {
+	return xxx_messageInfo_ListWebhooksRequest.Size(m)
+}
+func (m *ListWebhooksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListWebhooksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListWebhooksRequest proto.InternalMessageInfo
+
+func (m *ListWebhooksRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+// Contains list of all webhooks
+type ListWebhooksResponse struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// List of all webhooks, secrets are not returned
+	Webhooks             []*Webhook `protobuf:"bytes,2,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ListWebhooksResponse) Reset()         { *m = ListWebhooksResponse{} }
+func (m *ListWebhooksResponse) String() string { return proto.CompactTextString(m) }
+func (*ListWebhooksResponse) ProtoMessage()    {}
+func (*ListWebhooksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92fc024f5e3d0ce8, []int{9}
+}
+
+func (m *ListWebhooksResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListWebhooksResponse.Unmarshal(m, b)
+}
+func (m *ListWebhooksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListWebhooksResponse.Marshal(b, m, deterministic)
+}
+func (m *ListWebhooksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListWebhooksResponse.Merge(m, src)
+}
+func (m *ListWebhooksResponse) XXX_Size() int {
+	return xxx_messageInfo_ListWebhooksResponse.Size(m)
+}
+func (m *ListWebhooksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListWebhooksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListWebhooksResponse proto.InternalMessageInfo
+
+func (m *ListWebhooksResponse) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *ListWebhooksResponse) GetWebhooks() []*Webhook {
+	if m != nil {
+		return m.Webhooks
+	}
+	return nil
+}
+
+// Request data to delete webhook
+type DeleteWebhookRequest struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Unique integer identifier of the webhook to delete
+	Id                   int64    `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteWebhookRequest) Reset()         { *m = DeleteWebhookRequest{} }
+func (m *DeleteWebhookRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteWebhookRequest) ProtoMessage()    {}
+func (*DeleteWebhookRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92fc024f5e3d0ce8, []int{10}
+}
+
+func (m *DeleteWebhookRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteWebhookRequest.Unmarshal(m, b)
+}
+func (m *DeleteWebhookRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteWebhookRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteWebhookRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteWebhookRequest.Merge(m, src)
+}
+func (m *DeleteWebhookRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteWebhookRequest.Size(m)
+}
+func (m *DeleteWebhookRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteWebhookRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteWebhookRequest proto.InternalMessageInfo
+
+func (m *DeleteWebhookRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *DeleteWebhookRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// Contains status of delete operation
+type DeleteWebhookResponse struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Contains number of entities have been deleted
+	Deleted              int64    `protobuf:"varint,2,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteWebhookResponse) Reset()         { *m = DeleteWebhookResponse{} }
+func (m *DeleteWebhookResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteWebhookResponse) ProtoMessage()    {}
+func (*DeleteWebhookResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92fc024f5e3d0ce8, []int{11}
+}
+
+func (m *DeleteWebhookResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteWebhookResponse.Unmarshal(m, b)
+}
+func (m *DeleteWebhookResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteWebhookResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteWebhookResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteWebhookResponse.Merge(m, src)
+}
+func (m *DeleteWebhookResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteWebhookResponse.Size(m)
+}
+func (m *DeleteWebhookResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteWebhookResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteWebhookResponse proto.InternalMessageInfo
+
+func (m *DeleteWebhookResponse) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *DeleteWebhookResponse) GetDeleted() int64 {
+	if m != nil {
+		return m.Deleted
+	}
+	return 0
+}
+
+// Event which could not be delivered to webhook after all attempts
+type DeadLetter struct {
+	// Unique integer identifier of the dead letter
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Unique integer identifier of the webhook
+	WebhookId int64 `protobuf:"varint,2,opt,name=webhookId,proto3" json:"webhookId,omitempty"`
+	// URL the event was posted to
+	Url string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	// Undelivered event
+	Event *Event `protobuf:"bytes,4,opt,name=event,proto3" json:"event,omitempty"`
+	// Number of delivery attempts
+	Attempts int32 `protobuf:"varint,5,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// Error of the last attempt
+	LastError string `protobuf:"bytes,6,opt,name=lastError,proto3" json:"lastError,omitempty"`
+	// Date and time the event was given up
+	CreateTime           *timestamp.Timestamp `protobuf:"bytes,7,opt,name=createTime,proto3" json:"createTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *DeadLetter) Reset()         { *m = DeadLetter{} }
+func (m *DeadLetter) String() string { return proto.CompactTextString(m) }
+func (*DeadLetter) ProtoMessage()    {}
+func (*DeadLetter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92fc024f5e3d0ce8, []int{12}
+}
+
+func (m *DeadLetter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeadLetter.Unmarshal(m, b)
+}
+func (m *DeadLetter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeadLetter.Marshal(b, m, deterministic)
+}
+func (m *DeadLetter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeadLetter.Merge(m, src)
+}
+func (m *DeadLetter) XXX_Size() int {
+	return xxx_messageInfo_DeadLetter.Size(m)
+}
+func (m *DeadLetter) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeadLetter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeadLetter proto.InternalMessageInfo
+
+func (m *DeadLetter) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *DeadLetter) GetWebhookId() int64 {
+	if m != nil {
+		return m.WebhookId
+	}
+	return 0
+}
+
+func (m *DeadLetter) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *DeadLetter) GetEvent() *Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (m *DeadLetter) GetAttempts() int32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *DeadLetter) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *DeadLetter) GetCreateTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreateTime
+	}
+	return nil
+}
+
+// Request data to read dead letters
+type ListDeadLettersRequest struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Unique integer identifier of the webhook, dead letters of all webhooks if 0
+	WebhookId            int64    `protobuf:"varint,2,opt,name=webhookId,proto3" json:"webhookId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListDeadLettersRequest) Reset()         { *m = ListDeadLettersRequest{} }
+func (m *ListDeadLettersRequest) String() string { return proto.CompactTextString(m) }
+func (*ListDeadLettersRequest) ProtoMessage()    {}
+func (*ListDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92fc024f5e3d0ce8, []int{13}
+}
+
+func (m *ListDeadLettersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDeadLettersRequest.Unmarshal(m, b)
+}
+func (m *ListDeadLettersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDeadLettersRequest.Marshal(b, m, deterministic)
+}
+func (m *ListDeadLettersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDeadLettersRequest.Merge(m, src)
+}
+func (m *ListDeadLettersRequest) XXX_Size() int {
+	return xxx_messageInfo_ListDeadLettersRequest.Size(m)
+}
+func (m *ListDeadLettersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDeadLettersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDeadLettersRequest proto.InternalMessageInfo
+
+func (m *ListDeadLettersRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *ListDeadLettersRequest) GetWebhookId() int64 {
+	if m != nil {
+		return m.WebhookId
+	}
+	return 0
+}
+
+// Contains dead letters
+type ListDeadLettersResponse struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// The most recent 1000 dead letters, newest first
+	DeadLetters          []*DeadLetter `protobuf:"bytes,2,rep,name=deadLetters,proto3" json:"deadLetters,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ListDeadLettersResponse) Reset()         { *m = ListDeadLettersResponse{} }
+func (m *ListDeadLettersResponse) String() string { return proto.CompactTextString(m) }
+func (*ListDeadLettersResponse) ProtoMessage()    {}
+func (*ListDeadLettersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92fc024f5e3d0ce8, []int{14}
+}
+
+func (m *ListDeadLettersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDeadLettersResponse.Unmarshal(m, b)
+}
+func (m *ListDeadLettersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDeadLettersResponse.Marshal(b, m, deterministic)
+}
+func (m *ListDeadLettersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDeadLettersResponse.Merge(m, src)
+}
+func (m *ListDeadLettersResponse) XXX_Size() int {
+	return xxx_messageInfo_ListDeadLettersResponse.Size(m)
+}
+func (m *ListDeadLettersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDeadLettersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDeadLettersResponse proto.InternalMessageInfo
+
+func (m *ListDeadLettersResponse) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *ListDeadLettersResponse) GetDeadLetters() []*DeadLetter {
+	if m != nil {
+		return m.DeadLetters
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*LogLevel)(nil), "v1.LogLevel")
+	proto.RegisterType((*GetLogLevelsRequest)(nil), "v1.GetLogLevelsRequest")
+	proto.RegisterType((*GetLogLevelsResponse)(nil), "v1.GetLogLevelsResponse")
+	proto.RegisterType((*SetLogLevelRequest)(nil), "v1.SetLogLevelRequest")
+	proto.RegisterType((*SetLogLevelResponse)(nil), "v1.SetLogLevelResponse")
+	proto.RegisterType((*Webhook)(nil), "v1.Webhook")
+	proto.RegisterType((*CreateWebhookRequest)(nil), "v1.CreateWebhookRequest")
+	proto.RegisterType((*CreateWebhookResponse)(nil), "v1.CreateWebhookResponse")
+	proto.RegisterType((*ListWebhooksRequest)(nil), "v1.ListWebhooksRequest")
+	proto.RegisterType((*ListWebhooksResponse)(nil), "v1.ListWebhooksResponse")
+	proto.RegisterType((*DeleteWebhookRequest)(nil), "v1.DeleteWebhookRequest")
+	proto.RegisterType((*DeleteWebhookResponse)(nil), "v1.DeleteWebhookResponse")
+	proto.RegisterType((*DeadLetter)(nil), "v1.DeadLetter")
+	proto.RegisterType((*ListDeadLettersRequest)(nil), "v1.ListDeadLettersRequest")
+	proto.RegisterType((*ListDeadLettersResponse)(nil), "v1.ListDeadLettersResponse")
+}
+
+func init() { proto.RegisterFile("admin-service.proto", fileDescriptor_92fc024f5e3d0ce8) }
+
+var fileDescriptor_92fc024f5e3d0ce8 = []byte{
+	// 845 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x41, 0x6f, 0xe3, 0x44,
+	0x14, 0xc6, 0x76, 0xd2, 0xa6, 0xaf, 0x49, 0x59, 0x4d, 0xdc, 0xc6, 0x35, 0x5d, 0x35, 0x32, 0x42,
+	0xad, 0x2a, 0x1a, 0x6f, 0x03, 0x07, 0xd4, 0x5b, 0xbb, 0xbb, 0x02, 0xa4, 0x48, 0x2c, 0xde, 0x4a,
+	0x48, 0x48, 0xab, 0x95, 0x13, 0x3f, 0xbc, 0xb3, 0x38, 0x1e, 0xe3, 0x99, 0x64, 0xb5, 0x20, 0x2e,
+	0x9c, 0x38, 0xc3, 0xbf, 0xe0, 0xce, 0x2f, 0xe1, 0x27, 0x80, 0xf8, 0x1d, 0xc8, 0xe3, 0x71, 0x1c,
+	0xc7, 0xce, 0x6a, 0x39, 0xec, 0x29, 0x9e, 0xf7, 0xbe, 0xf7, 0x7d, 0xcf, 0x6f, 0xbe, 0x19, 0x07,
+	0xfa, 0x7e, 0x30, 0xa7, 0xf1, 0x25, 0xc7, 0x74, 0x49, 0x67, 0x38, 0x4a, 0x52, 0x26, 0x18, 0xd1,
+	0x97, 0x57, 0xf6, 0x69, 0xc8, 0x58, 0x18, 0xa1, 0x2b, 0x23, 0xd3, 0xc5, 0x77, 0xae, 0xa0, 0x73,
+	0xe4, 0xc2, 0x9f, 0x27, 0x39, 0xc8, 0x3e, 0x51, 0x00, 0x3f, 0xa1, 0xae, 0x1f, 0xc7, 0x4c, 0xf8,
+	0x82, 0xb2, 0x98, 0xab, 0xec, 0xc7, 0xf2, 0x67, 0x76, 0x19, 0x62, 0x7c, 0xc9, 0x5f, 0xf9, 0x61,
+	0x88, 0xa9, 0xcb, 0x12, 0x89, 0x68, 0x40, 0xf7, 0x22, 0x16, 0xba, 0x11, 0x0b, 0xd5, 0x92, 0x08,
+	0x16, 0xb0, 0x6a, 0x4f, 0xce, 0xa7, 0xd0, 0x99, 0xb0, 0x70, 0x82, 0x4b, 0x8c, 0x08, 0x81, 0x56,
+	0xec, 0xcf, 0xd1, 0xd2, 0x86, 0xda, 0xf9, 0x9e, 0x27, 0x9f, 0x89, 0x09, 0xed, 0x28, 0x4b, 0x5a,
+	0xba, 0x0c, 0xe6, 0x0b, 0xe7, 0x0c, 0xfa, 0x9f, 0xa3, 0x28, 0x0a, 0xb9, 0x87, 0x3f, 0x2c, 0x90,
+	0x0b, 0x72, 0x0f, 0x0c, 0x3f, 0xa1, 0xaa, 0x3e, 0x7b, 0x74, 0x5e, 0x82, 0x59, 0x05, 0xf2, 0x84,
+	0xc5, 0x1c, 0xeb, 0xc8, 0x66, 0x21, 0x72, 0x01, 0x7b, 0x6c, 0x89, 0x69, 0x4a, 0x03, 0xe4, 0x96,
+	0x31, 0x34, 0xce, 0xf7, 0xc7, 0xdd, 0xd1, 0xf2, 0x6a, 0x54, 0x30, 0x7a, 0x65, 0xda, 0x79, 0x02,
+	0xe4, 0x69, 0xa9, 0xb5, 0xb5, 0x27, 0x72, 0x0e, 0x9d, 0x48, 0x81, 0xa4, 0xd8, 0x26, 0xe5, 0x2a,
+	0xeb, 0x50, 0xe8, 0x57, 0x18, 0xdf, 0x61, 0xf3, 0x7f, 0x68, 0xb0, 0xfb, 0x0d, 0x4e, 0x5f, 0x30,
+	0xf6, 0x3d, 0x39, 0x00, 0x9d, 0x06, 0x92, 0xde, 0xf0, 0x74, 0x1a, 0x64, 0x7a, 0x8b, 0xb4, 0xe0,
+	0xce, 0x1e, 0xc9, 0x09, 0xec, 0x70, 0x9c, 0xa5, 0x28, 0x2c, 0x23, 0x0b, 0xde, 0xb6, 0x7e, 0xfd,
+	0xd3, 0xd2, 0x3c, 0x15, 0x23, 0x1f, 0x42, 0x5b, 0xbc, 0x4e, 0x90, 0x5b, 0xad, 0xa1, 0x71, 0x7e,
+	0x30, 0xee, 0x65, 0x9a, 0x8f, 0x97, 0x18, 0x8b, 0xbb, 0xd7, 0x09, 0x7a, 0x79, 0x8e, 0x5c, 0x03,
+	0xcc, 0x52, 0xf4, 0x05, 0xde, 0xd1, 0x39, 0x5a, 0x6d, 0x39, 0x07, 0x7b, 0x94, 0x9b, 0x6f, 0x54,
+	0xb8, 0x73, 0x74, 0x57, 0xb8, 0xd3, 0x5b, 0x43, 0x3b, 0x5f, 0x81, 0xf9, 0x50, 0xae, 0x54, 0xc7,
+	0xdb, 0x67, 0xfd, 0x11, 0xec, 0xbe, 0xca, 0x31, 0x6a, 0xd4, 0xfb, 0x59, 0x33, 0x45, 0x59, 0x91,
+	0x73, 0x9e, 0xc0, 0xe1, 0x06, 0xe1, 0xd6, 0x51, 0xbf, 0x25, 0xe3, 0x19, 0xf4, 0x27, 0x94, 0x0b,
+	0x15, 0x7f, 0x83, 0x43, 0xbf, 0x06, 0xb3, 0x0a, 0xdc, 0xaa, 0x7c, 0x06, 0x1d, 0xc5, 0xce, 0x2d,
+	0x7d, 0x68, 0x6c, 0x4a, 0xaf, 0x92, 0xce, 0x67, 0x60, 0x3e, 0xc2, 0x08, 0xdf, 0x62, 0x3c, 0xf9,
+	0x4e, 0xeb, 0xc5, 0x4e, 0x3b, 0x0f, 0xe1, 0x70, 0xa3, 0x72, 0x6b, 0x37, 0x16, 0xec, 0x06, 0x12,
+	0x5a, 0xd4, 0x17, 0x4b, 0xe7, 0x6f, 0x0d, 0xe0, 0x11, 0xfa, 0xc1, 0x04, 0x85, 0xc0, 0xb4, 0xe6,
+	0xa6, 0x13, 0xd8, 0x53, 0x9d, 0x7e, 0x59, 0x94, 0x96, 0x81, 0xc2, 0x6b, 0x46, 0xe9, 0xb5, 0x53,
+	0x68, 0x63, 0x66, 0x1e, 0xab, 0x25, 0xc7, 0xbd, 0xb7, 0x72, 0x93, 0x97, 0xc7, 0x89, 0x0d, 0x1d,
+	0x5f, 0x08, 0x9c, 0x27, 0x82, 0x4b, 0x1f, 0xb5, 0xbd, 0xd5, 0x3a, 0x13, 0x8b, 0x7c, 0x2e, 0x1e,
+	0xa7, 0x29, 0x4b, 0xad, 0x1d, 0x49, 0x5a, 0x06, 0x36, 0x3c, 0xb8, 0xfb, 0xbf, 0x3c, 0xf8, 0x05,
+	0x1c, 0x65, 0xfb, 0x56, 0xbe, 0xe8, 0xf6, 0x3d, 0x7e, 0xf3, 0x2b, 0x3b, 0xcf, 0x60, 0x50, 0x63,
+	0xda, 0x3a, 0xf6, 0x07, 0xb0, 0x1f, 0x94, 0x40, 0xe5, 0x83, 0x83, 0x6c, 0x26, 0x65, 0xbd, 0xb7,
+	0x0e, 0x19, 0xff, 0xdb, 0x82, 0xee, 0x4d, 0xf6, 0x35, 0x78, 0x9a, 0x5f, 0xbc, 0xe4, 0x39, 0x74,
+	0xd7, 0xef, 0x44, 0x32, 0xc8, 0xaa, 0x1b, 0xae, 0x53, 0xdb, 0xaa, 0x27, 0xf2, 0xbe, 0x9c, 0x93,
+	0x5f, 0xfe, 0xfa, 0xe7, 0x77, 0xfd, 0x88, 0x98, 0xee, 0xf2, 0xca, 0x95, 0x9f, 0x1a, 0x57, 0x5e,
+	0xf5, 0x39, 0xe1, 0x73, 0xd8, 0x5f, 0xbb, 0xb6, 0xc8, 0x51, 0x46, 0x53, 0xbf, 0x19, 0xed, 0x41,
+	0x2d, 0xae, 0xd8, 0x4f, 0x25, 0xfb, 0xb1, 0xdd, 0xc8, 0x7e, 0xad, 0x5d, 0x90, 0x19, 0xf4, 0x2a,
+	0xc7, 0x95, 0xc8, 0x4e, 0x9b, 0xae, 0x04, 0xfb, 0xb8, 0x21, 0xa3, 0x64, 0xee, 0x4b, 0x99, 0x81,
+	0x43, 0x4a, 0x99, 0xe2, 0x08, 0x65, 0x22, 0xcf, 0xa0, 0xbb, 0x7e, 0x30, 0xf3, 0x31, 0x35, 0x9c,
+	0x69, 0xdb, 0xaa, 0x27, 0x94, 0x82, 0x2d, 0x15, 0x4c, 0xd2, 0xa0, 0x40, 0x10, 0x7a, 0x95, 0xa3,
+	0x96, 0xbf, 0x43, 0xd3, 0xb9, 0xb5, 0x8f, 0x1b, 0x32, 0xd5, 0x51, 0x5d, 0x0c, 0xea, 0x0a, 0xee,
+	0x4f, 0x34, 0xf8, 0x99, 0x50, 0x78, 0x7f, 0xc3, 0x5c, 0xc4, 0x2e, 0xfa, 0xad, 0x7b, 0xd7, 0xfe,
+	0xa0, 0x31, 0x57, 0x1d, 0x18, 0x39, 0x2c, 0xc5, 0xd6, 0x8c, 0x76, 0xcb, 0x7e, 0xbb, 0x89, 0x88,
+	0x09, 0x3d, 0x69, 0xb6, 0xa1, 0xfa, 0xcc, 0x8f, 0x8d, 0xab, 0xd1, 0x83, 0x0b, 0x4d, 0x1b, 0xdf,
+	0xf3, 0x93, 0x24, 0xa2, 0x33, 0xf9, 0xff, 0xc0, 0x7d, 0xc9, 0x59, 0x7c, 0x5d, 0x8b, 0x7c, 0x7b,
+	0x0a, 0xf7, 0x61, 0xe7, 0x16, 0xfd, 0x14, 0x53, 0xd2, 0xef, 0xe8, 0x76, 0xef, 0x66, 0x21, 0x5e,
+	0xb0, 0x94, 0xfe, 0x28, 0x31, 0x43, 0x7d, 0xda, 0x05, 0x58, 0x01, 0xde, 0x9b, 0xee, 0xc8, 0x23,
+	0xfa, 0xc9, 0x7f, 0x03, 0x00, 0xf5, 0xaf, 0xb4, 0xaf, 0xed, 0x08, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// AdminServiceClient is the client API for AdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AdminServiceClient interface {
+	// Read global log level and overrides
+	GetLogLevels(ctx context.Context, in *GetLogLevelsRequest, opts ...grpc.CallOption) (*GetLogLevelsResponse, error)
+	// Change global log level or override of a named logger
+	SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*SetLogLevelResponse, error)
+	// Register webhook receiving changes of todo tasks and fired reminders
+	CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*CreateWebhookResponse, error)
+	// Read all webhooks
+	ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error)
+	// Delete webhook, its dead letters are kept
+	DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error)
+	// Read events which could not be delivered to webhooks
+	ListDeadLetters(ctx context.Context, in *ListDeadLettersRequest, opts ...grpc.CallOption) (*ListDeadLettersResponse, error)
+}
+
+type adminServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAdminServiceClient(cc *grpc.ClientConn) AdminServiceClient {
+	return &adminServiceClient{cc}
+}
+
+func (c *adminServiceClient) GetLogLevels(ctx context.Context, in *GetLogLevelsRequest, opts ...grpc.CallOption) (*GetLogLevelsResponse, error) {
+	out := new(GetLogLevelsResponse)
+	err := c.cc.Invoke(ctx, "/v1.AdminService/GetLogLevels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*SetLogLevelResponse, error) {
+	out := new(SetLogLevelResponse)
+	err := c.cc.Invoke(ctx, "/v1.AdminService/SetLogLevel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*CreateWebhookResponse, error) {
+	out := new(CreateWebhookResponse)
+	err := c.cc.Invoke(ctx, "/v1.AdminService/CreateWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error) {
+	out := new(ListWebhooksResponse)
+	err := c.cc.Invoke(ctx, "/v1.AdminService/ListWebhooks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error) {
+	out := new(DeleteWebhookResponse)
+	err := c.cc.Invoke(ctx, "/v1.AdminService/DeleteWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListDeadLetters(ctx context.Context, in *ListDeadLettersRequest, opts ...grpc.CallOption) (*ListDeadLettersResponse, error) {
+	out := new(ListDeadLettersResponse)
+	err := c.cc.Invoke(ctx, "/v1.AdminService/ListDeadLetters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServiceServer is the server API for AdminService service.
+type AdminServiceServer interface {
+	// Read global log level and overrides
+	GetLogLevels(context.Context, *GetLogLevelsRequest) (*GetLogLevelsResponse, error)
+	// Change global log level or override of a named logger
+	SetLogLevel(context.Context, *SetLogLevelRequest) (*SetLogLevelResponse, error)
+	// Register webhook receiving changes of todo tasks and fired reminders
+	CreateWebhook(context.Context, *CreateWebhookRequest) (*CreateWebhookResponse, error)
+	// Read all webhooks
+	ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error)
+	// Delete webhook, its dead letters are kept
+	DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error)
+	// Read events which could not be delivered to webhooks
+	ListDeadLetters(context.Context, *ListDeadLettersRequest) (*ListDeadLettersResponse, error)
+}
+
+// UnimplementedAdminServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedAdminServiceServer struct {
+}
+
+func (*UnimplementedAdminServiceServer) GetLogLevels(ctx context.Context, req *GetLogLevelsRequest) (*GetLogLevelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLogLevels not implemented")
+}
+func (*UnimplementedAdminServiceServer) SetLogLevel(ctx context.Context, req *SetLogLevelRequest) (*SetLogLevelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLogLevel not implemented")
+}
+func (*UnimplementedAdminServiceServer) CreateWebhook(ctx context.Context, req *CreateWebhookRequest) (*CreateWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhook not implemented")
+}
+func (*UnimplementedAdminServiceServer) ListWebhooks(ctx context.Context, req *ListWebhooksRequest) (*ListWebhooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhooks not implemented")
+}
+func (*UnimplementedAdminServiceServer) DeleteWebhook(ctx context.Context, req *DeleteWebhookRequest) (*DeleteWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhook not implemented")
+}
+func (*UnimplementedAdminServiceServer) ListDeadLetters(ctx context.Context, req *ListDeadLettersRequest) (*ListDeadLettersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeadLetters not implemented")
+}
+
+func RegisterAdminServiceServer(s *grpc.Server, srv AdminServiceServer) {
+	s.RegisterService(&_AdminService_serviceDesc, srv)
+}
+
+func _AdminService_GetLogLevels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLogLevelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetLogLevels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.AdminService/GetLogLevels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetLogLevels(ctx, req.(*GetLogLevelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLogLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetLogLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.AdminService/SetLogLevel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetLogLevel(ctx, req.(*SetLogLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CreateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CreateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.AdminService/CreateWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CreateWebhook(ctx, req.(*CreateWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.AdminService/ListWebhooks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListWebhooks(ctx, req.(*ListWebhooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.AdminService/DeleteWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteWebhook(ctx, req.(*DeleteWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeadLettersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.AdminService/ListDeadLetters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListDeadLetters(ctx, req.(*ListDeadLettersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _AdminService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "v1.AdminService",
+	HandlerType: (*AdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetLogLevels",
+			Handler:    _AdminService_GetLogLevels_Handler,
+		},
+		{
+			MethodName: "SetLogLevel",
+			Handler:    _AdminService_SetLogLevel_Handler,
+		},
+		{
+			MethodName: "CreateWebhook",
+			Handler:    _AdminService_CreateWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhooks",
+			Handler:    _AdminService_ListWebhooks_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _AdminService_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "ListDeadLetters",
+			Handler:    _AdminService_ListDeadLetters_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "admin-service.proto",
+}
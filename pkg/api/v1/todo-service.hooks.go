@@ -0,0 +1,15 @@
+package v1
+
+import (
+	"context"
+	"sort"
+)
+
+// AfterToPB sets names of tags of task, tags must be preloaded
+func (m *ToDoORM) AfterToPB(ctx context.Context, td *ToDo) error {
+	for _, tag := range m.Tags {
+		td.Tags = append(td.Tags, tag.Name)
+	}
+	sort.Strings(td.Tags)
+	return nil
+}
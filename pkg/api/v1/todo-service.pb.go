@@ -10,10 +10,13 @@ import (
 	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	_ "github.com/grpc-ecosystem/grpc-gateway/protoc-gen-swagger/options"
 	_ "github.com/infobloxopen/protoc-gen-gorm/options"
+	_ "go.smartmachine.io/go-grpc-api/pkg/api/log"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
+	status "google.golang.org/genproto/googleapis/rpc/status"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
-	status "google.golang.org/grpc/status"
+	status1 "google.golang.org/grpc/status"
 	math "math"
 )
 
@@ -28,6 +31,138 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+// How batch handles items which cannot be applied
+type BatchMode int32
+
+const (
+	// Batch fails and no item is applied if any item cannot be applied
+	BatchMode_ALL_OR_NOTHING BatchMode = 0
+	// Items which can be applied are applied, result of every item is returned
+	BatchMode_PER_ITEM BatchMode = 1
+)
+
+var BatchMode_name = map[int32]string{
+	0: "ALL_OR_NOTHING",
+	1: "PER_ITEM",
+}
+
+var BatchMode_value = map[string]int32{
+	"ALL_OR_NOTHING": 0,
+	"PER_ITEM":       1,
+}
+
+func (x BatchMode) String() string {
+	return proto.EnumName(BatchMode_name, int32(x))
+}
+
+func (BatchMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{0}
+}
+
+// What import does with task of file
+type ImportAction int32
+
+const (
+	// Task is created
+	ImportAction_CREATE ImportAction = 0
+	// Task with the same external ID exists, or is earlier in the file, so the task is skipped
+	ImportAction_SKIP ImportAction = 1
+	// Task is invalid and is not created
+	ImportAction_REJECT ImportAction = 2
+)
+
+var ImportAction_name = map[int32]string{
+	0: "CREATE",
+	1: "SKIP",
+	2: "REJECT",
+}
+
+var ImportAction_value = map[string]int32{
+	"CREATE": 0,
+	"SKIP":   1,
+	"REJECT": 2,
+}
+
+func (x ImportAction) String() string {
+	return proto.EnumName(ImportAction_name, int32(x))
+}
+
+func (ImportAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{1}
+}
+
+// What happens to tasks of deleted project
+type ProjectDeleteMode int32
+
+const (
+	// Project which has tasks, not counting tasks in trash, is not deleted
+	ProjectDeleteMode_RESTRICT ProjectDeleteMode = 0
+	// Tasks of project are moved to trash with the project
+	ProjectDeleteMode_CASCADE ProjectDeleteMode = 1
+)
+
+var ProjectDeleteMode_name = map[int32]string{
+	0: "RESTRICT",
+	1: "CASCADE",
+}
+
+var ProjectDeleteMode_value = map[string]int32{
+	"RESTRICT": 0,
+	"CASCADE":  1,
+}
+
+func (x ProjectDeleteMode) String() string {
+	return proto.EnumName(ProjectDeleteMode_name, int32(x))
+}
+
+func (ProjectDeleteMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{2}
+}
+
+// Kind of change of todo task
+type EventType int32
+
+const (
+	// Unknown change, never sent
+	EventType_EVENT_TYPE_UNSPECIFIED EventType = 0
+	// Task was created
+	EventType_CREATED EventType = 1
+	// Task was updated
+	EventType_UPDATED EventType = 2
+	// Task was deleted
+	EventType_DELETED EventType = 3
+	// Reminder of task came due
+	EventType_REMINDED EventType = 4
+	// Task was restored from trash
+	EventType_UNDELETED EventType = 5
+)
+
+var EventType_name = map[int32]string{
+	0: "EVENT_TYPE_UNSPECIFIED",
+	1: "CREATED",
+	2: "UPDATED",
+	3: "DELETED",
+	4: "REMINDED",
+	5: "UNDELETED",
+}
+
+var EventType_value = map[string]int32{
+	"EVENT_TYPE_UNSPECIFIED": 0,
+	"CREATED":                1,
+	"UPDATED":                2,
+	"DELETED":                3,
+	"REMINDED":               4,
+	"UNDELETED":              5,
+}
+
+func (x EventType) String() string {
+	return proto.EnumName(EventType_name, int32(x))
+}
+
+func (EventType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{3}
+}
+
 // Task we have to do
 type ToDo struct {
 	// Unique integer identifier of the todo task
@@ -37,10 +172,26 @@ type ToDo struct {
 	// Detail description of the todo task
 	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
 	// Date and time to remind the todo task
-	Reminder             *timestamp.Timestamp `protobuf:"bytes,4,opt,name=reminder,proto3" json:"reminder,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	Reminder *timestamp.Timestamp `protobuf:"bytes,4,opt,name=reminder,proto3" json:"reminder,omitempty"`
+	// Stable identifier of the task in external system, e.g. UID of iCalendar component.
+	// Files importing task with known external ID again do not duplicate it
+	ExternalId string `protobuf:"bytes,5,opt,name=externalId,proto3" json:"externalId,omitempty"`
+	// Recurrence of the task as iCalendar RRULE, e.g. FREQ=WEEKLY;BYDAY=MO,TH.
+	// Reminder is the first occurrence, the rest are computed in UTC and keep its time of day.
+	// Completing recurring task creates task for its next occurrence
+	Recurrence string `protobuf:"bytes,6,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	// Task was completed
+	Done bool `protobuf:"varint,7,opt,name=done,proto3" json:"done,omitempty"`
+	// Date and time the task was moved to trash, not set for tasks which are not deleted.
+	// Tasks are purged from trash after retention configured on server
+	DeletedAt *timestamp.Timestamp `protobuf:"bytes,8,opt,name=deletedAt,proto3" json:"deletedAt,omitempty"`
+	// Names of tags labeling the task, tags are created when first used
+	Tags []string `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Unique integer identifier of the project the task belongs to, 0 if it belongs to no project
+	ProjectId            int64    `protobuf:"varint,10,opt,name=projectId,proto3" json:"projectId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ToDo) Reset()         { *m = ToDo{} }
@@ -96,6 +247,166 @@ func (m *ToDo) GetReminder() *timestamp.Timestamp {
 	return nil
 }
 
+func (m *ToDo) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
+	}
+	return ""
+}
+
+func (m *ToDo) GetRecurrence() string {
+	if m != nil {
+		return m.Recurrence
+	}
+	return ""
+}
+
+func (m *ToDo) GetDone() bool {
+	if m != nil {
+		return m.Done
+	}
+	return false
+}
+
+func (m *ToDo) GetDeletedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.DeletedAt
+	}
+	return nil
+}
+
+func (m *ToDo) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *ToDo) GetProjectId() int64 {
+	if m != nil {
+		return m.ProjectId
+	}
+	return 0
+}
+
+// Tag labeling todo tasks
+type Tag struct {
+	// Unique integer identifier of the tag
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Unique name of the tag
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Number of tasks labeled by the tag, tasks in trash are not counted
+	Count                int64    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Tag) Reset()         { *m = Tag{} }
+func (m *Tag) String() string { return proto.CompactTextString(m) }
+func (*Tag) ProtoMessage()    {}
+func (*Tag) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{1}
+}
+
+func (m *Tag) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Tag.Unmarshal(m, b)
+}
+func (m *Tag) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Tag.Marshal(b, m, deterministic)
+}
+func (m *Tag) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Tag.Merge(m, src)
+}
+func (m *Tag) XXX_Size() int {
+	return xxx_messageInfo_Tag.Size(m)
+}
+func (m *Tag) XXX_DiscardUnknown() {
+	xxx_messageInfo_Tag.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Tag proto.InternalMessageInfo
+
+func (m *Tag) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Tag) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Tag) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// Project grouping todo tasks
+type Project struct {
+	// Unique integer identifier of the project
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Unique name of the project
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Detail description of the project
+	Description          string   `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Project) Reset()         { *m = Project{} }
+func (m *Project) String() string { return proto.CompactTextString(m) }
+func (*Project) ProtoMessage()    {}
+func (*Project) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{2}
+}
+
+func (m *Project) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Project.Unmarshal(m, b)
+}
+func (m *Project) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Project.Marshal(b, m, deterministic)
+}
+func (m *Project) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Project.Merge(m, src)
+}
+func (m *Project) XXX_Size() int {
+	return xxx_messageInfo_Project.Size(m)
+}
+func (m *Project) XXX_DiscardUnknown() {
+	xxx_messageInfo_Project.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Project proto.InternalMessageInfo
+
+func (m *Project) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Project) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Project) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
 // Request data to create new todo task
 type CreateRequest struct {
 	// API versioning: it is my best practice to specify version explicitly
@@ -111,7 +422,7 @@ func (m *CreateRequest) Reset()         { *m = CreateRequest{} }
 func (m *CreateRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateRequest) ProtoMessage()    {}
 func (*CreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_80b701c7b1c502fe, []int{1}
+	return fileDescriptor_80b701c7b1c502fe, []int{3}
 }
 
 func (m *CreateRequest) XXX_Unmarshal(b []byte) error {
@@ -161,7 +472,7 @@ func (m *CreateResponse) Reset()         { *m = CreateResponse{} }
 func (m *CreateResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateResponse) ProtoMessage()    {}
 func (*CreateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_80b701c7b1c502fe, []int{2}
+	return fileDescriptor_80b701c7b1c502fe, []int{4}
 }
 
 func (m *CreateResponse) XXX_Unmarshal(b []byte) error {
@@ -201,7 +512,9 @@ type ReadRequest struct {
 	// API versioning: it is my best practice to specify version explicitly
 	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
 	// Unique integer identifier of the todo task
-	Id                   int64    `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Id int64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// Read task in trash too
+	ShowDeleted          bool     `protobuf:"varint,3,opt,name=showDeleted,proto3" json:"showDeleted,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -211,7 +524,7 @@ func (m *ReadRequest) Reset()         { *m = ReadRequest{} }
 func (m *ReadRequest) String() string { return proto.CompactTextString(m) }
 func (*ReadRequest) ProtoMessage()    {}
 func (*ReadRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_80b701c7b1c502fe, []int{3}
+	return fileDescriptor_80b701c7b1c502fe, []int{5}
 }
 
 func (m *ReadRequest) XXX_Unmarshal(b []byte) error {
@@ -246,6 +559,13 @@ func (m *ReadRequest) GetId() int64 {
 	return 0
 }
 
+func (m *ReadRequest) GetShowDeleted() bool {
+	if m != nil {
+		return m.ShowDeleted
+	}
+	return false
+}
+
 // Contains todo task data specified in by ID request
 type ReadResponse struct {
 	// API versioning: it is my best practice to specify version explicitly
@@ -261,7 +581,7 @@ func (m *ReadResponse) Reset()         { *m = ReadResponse{} }
 func (m *ReadResponse) String() string { return proto.CompactTextString(m) }
 func (*ReadResponse) ProtoMessage()    {}
 func (*ReadResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_80b701c7b1c502fe, []int{4}
+	return fileDescriptor_80b701c7b1c502fe, []int{6}
 }
 
 func (m *ReadResponse) XXX_Unmarshal(b []byte) error {
@@ -311,7 +631,7 @@ func (m *UpdateRequest) Reset()         { *m = UpdateRequest{} }
 func (m *UpdateRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateRequest) ProtoMessage()    {}
 func (*UpdateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_80b701c7b1c502fe, []int{5}
+	return fileDescriptor_80b701c7b1c502fe, []int{7}
 }
 
 func (m *UpdateRequest) XXX_Unmarshal(b []byte) error {
@@ -362,7 +682,7 @@ func (m *UpdateResponse) Reset()         { *m = UpdateResponse{} }
 func (m *UpdateResponse) String() string { return proto.CompactTextString(m) }
 func (*UpdateResponse) ProtoMessage()    {}
 func (*UpdateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_80b701c7b1c502fe, []int{6}
+	return fileDescriptor_80b701c7b1c502fe, []int{8}
 }
 
 func (m *UpdateResponse) XXX_Unmarshal(b []byte) error {
@@ -412,7 +732,7 @@ func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
 func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteRequest) ProtoMessage()    {}
 func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_80b701c7b1c502fe, []int{7}
+	return fileDescriptor_80b701c7b1c502fe, []int{9}
 }
 
 func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
@@ -463,7 +783,7 @@ func (m *DeleteResponse) Reset()         { *m = DeleteResponse{} }
 func (m *DeleteResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteResponse) ProtoMessage()    {}
 func (*DeleteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_80b701c7b1c502fe, []int{8}
+	return fileDescriptor_80b701c7b1c502fe, []int{10}
 }
 
 func (m *DeleteResponse) XXX_Unmarshal(b []byte) error {
@@ -498,367 +818,4297 @@ func (m *DeleteResponse) GetDeleted() int64 {
 	return 0
 }
 
-// Request data to read all todo task
-type ReadAllRequest struct {
+// Request data to restore todo task from trash
+type UndeleteRequest struct {
 	// API versioning: it is my best practice to specify version explicitly
-	Api                  string   `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Unique integer identifier of the todo task to restore
+	Id                   int64    `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ReadAllRequest) Reset()         { *m = ReadAllRequest{} }
-func (m *ReadAllRequest) String() string { return proto.CompactTextString(m) }
-func (*ReadAllRequest) ProtoMessage()    {}
-func (*ReadAllRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_80b701c7b1c502fe, []int{9}
+func (m *UndeleteRequest) Reset()         { *m = UndeleteRequest{} }
+func (m *UndeleteRequest) String() string { return proto.CompactTextString(m) }
+func (*UndeleteRequest) ProtoMessage()    {}
+func (*UndeleteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{11}
 }
 
-func (m *ReadAllRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReadAllRequest.Unmarshal(m, b)
+func (m *UndeleteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UndeleteRequest.Unmarshal(m, b)
 }
-func (m *ReadAllRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReadAllRequest.Marshal(b, m, deterministic)
+func (m *UndeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UndeleteRequest.Marshal(b, m, deterministic)
 }
-func (m *ReadAllRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReadAllRequest.Merge(m, src)
+func (m *UndeleteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UndeleteRequest.Merge(m, src)
 }
-func (m *ReadAllRequest) XXX_Size() int {
-	return xxx_messageInfo_ReadAllRequest.Size(m)
+func (m *UndeleteRequest) XXX_Size() int {
+	return xxx_messageInfo_UndeleteRequest.Size(m)
 }
-func (m *ReadAllRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReadAllRequest.DiscardUnknown(m)
+func (m *UndeleteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UndeleteRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReadAllRequest proto.InternalMessageInfo
+var xxx_messageInfo_UndeleteRequest proto.InternalMessageInfo
 
-func (m *ReadAllRequest) GetApi() string {
+func (m *UndeleteRequest) GetApi() string {
 	if m != nil {
 		return m.Api
 	}
 	return ""
 }
 
-// Contains list of all todo tasks
-type ReadAllResponse struct {
+func (m *UndeleteRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// Contains restored todo task
+type UndeleteResponse struct {
 	// API versioning: it is my best practice to specify version explicitly
 	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
-	// List of all todo tasks
-	ToDos                []*ToDo  `protobuf:"bytes,2,rep,name=toDos,proto3" json:"toDos,omitempty"`
+	// Restored task
+	ToDo                 *ToDo    `protobuf:"bytes,2,opt,name=toDo,proto3" json:"toDo,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ReadAllResponse) Reset()         { *m = ReadAllResponse{} }
-func (m *ReadAllResponse) String() string { return proto.CompactTextString(m) }
-func (*ReadAllResponse) ProtoMessage()    {}
-func (*ReadAllResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_80b701c7b1c502fe, []int{10}
+func (m *UndeleteResponse) Reset()         { *m = UndeleteResponse{} }
+func (m *UndeleteResponse) String() string { return proto.CompactTextString(m) }
+func (*UndeleteResponse) ProtoMessage()    {}
+func (*UndeleteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{12}
 }
 
-func (m *ReadAllResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReadAllResponse.Unmarshal(m, b)
+func (m *UndeleteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UndeleteResponse.Unmarshal(m, b)
 }
-func (m *ReadAllResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReadAllResponse.Marshal(b, m, deterministic)
+func (m *UndeleteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UndeleteResponse.Marshal(b, m, deterministic)
 }
-func (m *ReadAllResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReadAllResponse.Merge(m, src)
+func (m *UndeleteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UndeleteResponse.Merge(m, src)
 }
-func (m *ReadAllResponse) XXX_Size() int {
-	return xxx_messageInfo_ReadAllResponse.Size(m)
+func (m *UndeleteResponse) XXX_Size() int {
+	return xxx_messageInfo_UndeleteResponse.Size(m)
 }
-func (m *ReadAllResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReadAllResponse.DiscardUnknown(m)
+func (m *UndeleteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UndeleteResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReadAllResponse proto.InternalMessageInfo
+var xxx_messageInfo_UndeleteResponse proto.InternalMessageInfo
 
-func (m *ReadAllResponse) GetApi() string {
+func (m *UndeleteResponse) GetApi() string {
 	if m != nil {
 		return m.Api
 	}
 	return ""
 }
 
-func (m *ReadAllResponse) GetToDos() []*ToDo {
+func (m *UndeleteResponse) GetToDo() *ToDo {
 	if m != nil {
-		return m.ToDos
+		return m.ToDo
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*ToDo)(nil), "v1.ToDo")
-	proto.RegisterType((*CreateRequest)(nil), "v1.CreateRequest")
-	proto.RegisterType((*CreateResponse)(nil), "v1.CreateResponse")
-	proto.RegisterType((*ReadRequest)(nil), "v1.ReadRequest")
-	proto.RegisterType((*ReadResponse)(nil), "v1.ReadResponse")
-	proto.RegisterType((*UpdateRequest)(nil), "v1.UpdateRequest")
-	proto.RegisterType((*UpdateResponse)(nil), "v1.UpdateResponse")
-	proto.RegisterType((*DeleteRequest)(nil), "v1.DeleteRequest")
-	proto.RegisterType((*DeleteResponse)(nil), "v1.DeleteResponse")
-	proto.RegisterType((*ReadAllRequest)(nil), "v1.ReadAllRequest")
-	proto.RegisterType((*ReadAllResponse)(nil), "v1.ReadAllResponse")
+// Request data to list todo tasks in trash
+type ListDeletedRequest struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api                  string   `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() { proto.RegisterFile("todo-service.proto", fileDescriptor_80b701c7b1c502fe) }
-
-var fileDescriptor_80b701c7b1c502fe = []byte{
-	// 712 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcf, 0x6e, 0xd3, 0x30,
-	0x18, 0x57, 0xd2, 0xae, 0xeb, 0xbe, 0xae, 0xdd, 0xf0, 0x86, 0x28, 0xd5, 0x04, 0x51, 0x4e, 0x53,
-	0x45, 0x92, 0xb5, 0x4c, 0x3b, 0x94, 0x89, 0xfd, 0xab, 0x10, 0xe7, 0x30, 0x2e, 0xdc, 0xb2, 0xc4,
-	0xa4, 0x1e, 0x49, 0x1c, 0x6c, 0xa7, 0x43, 0x42, 0xbb, 0x70, 0x40, 0x82, 0x03, 0x42, 0x70, 0xe3,
-	0xc2, 0x73, 0xc0, 0x6b, 0xf0, 0x0a, 0x3c, 0x08, 0x8a, 0x9d, 0x74, 0xcb, 0x58, 0x27, 0x24, 0x4e,
-	0xad, 0x3f, 0xff, 0xbe, 0xdf, 0x1f, 0xfb, 0x8b, 0x01, 0x09, 0x1a, 0x50, 0x8b, 0x63, 0x36, 0x25,
-	0x3e, 0xb6, 0x53, 0x46, 0x05, 0x45, 0xfa, 0x74, 0xd0, 0xbb, 0x1f, 0x52, 0x1a, 0x46, 0xd8, 0x91,
-	0x95, 0x93, 0xec, 0xa5, 0x23, 0x48, 0x8c, 0xb9, 0xf0, 0xe2, 0x54, 0x81, 0x7a, 0x1b, 0x05, 0xc0,
-	0x4b, 0x89, 0xe3, 0x25, 0x09, 0x15, 0x9e, 0x20, 0x34, 0xe1, 0xc5, 0xee, 0x03, 0xf9, 0xe3, 0x5b,
-	0x21, 0x4e, 0x2c, 0x7e, 0xe6, 0x85, 0x21, 0x66, 0x0e, 0x4d, 0x25, 0xe2, 0x1a, 0xb4, 0x79, 0x09,
-	0x1d, 0x52, 0x16, 0xcf, 0xa0, 0xf9, 0x42, 0x61, 0xcc, 0x4f, 0x1a, 0xd4, 0x8f, 0xe9, 0x98, 0xa2,
-	0x0e, 0xe8, 0x24, 0xe8, 0x6a, 0x86, 0xb6, 0x59, 0x73, 0x75, 0x12, 0xa0, 0x75, 0x58, 0x10, 0x44,
-	0x44, 0xb8, 0xab, 0x1b, 0xda, 0xe6, 0x92, 0xab, 0x16, 0xc8, 0x80, 0x56, 0x80, 0xb9, 0xcf, 0x88,
-	0x64, 0xea, 0xd6, 0xe4, 0xde, 0xe5, 0x12, 0xda, 0x81, 0x26, 0xc3, 0x31, 0x49, 0x02, 0xcc, 0xba,
-	0x75, 0x43, 0xdb, 0x6c, 0x0d, 0x7b, 0xb6, 0xca, 0x64, 0x97, 0xa1, 0xed, 0xe3, 0x32, 0xb4, 0x3b,
-	0xc3, 0x8e, 0x1a, 0x3f, 0x7f, 0xdc, 0xd5, 0x9b, 0x9a, 0xb9, 0x07, 0xed, 0x23, 0x86, 0x3d, 0x81,
-	0x5d, 0xfc, 0x3a, 0xc3, 0x5c, 0xa0, 0x55, 0xa8, 0x79, 0x29, 0x91, 0xce, 0x96, 0xdc, 0xfc, 0x2f,
-	0xda, 0x80, 0xba, 0xa0, 0x63, 0x2a, 0x9d, 0xb5, 0x86, 0x4d, 0x7b, 0x3a, 0xb0, 0xf3, 0x08, 0xae,
-	0xac, 0x9a, 0x43, 0xe8, 0x94, 0x04, 0x3c, 0xa5, 0x09, 0xc7, 0xd7, 0x30, 0xa8, 0xb0, 0x7a, 0x19,
-	0xd6, 0x74, 0xa0, 0xe5, 0x62, 0x2f, 0x98, 0x2f, 0x79, 0xb5, 0xe1, 0x31, 0x2c, 0xab, 0x86, 0xb9,
-	0x12, 0x37, 0x9b, 0xdc, 0x83, 0xf6, 0xf3, 0x34, 0xf8, 0x8f, 0x94, 0xbb, 0xd0, 0x29, 0x09, 0xe6,
-	0x5a, 0xe8, 0xc2, 0x62, 0x26, 0x31, 0xa5, 0xf3, 0x72, 0x69, 0x0e, 0xa0, 0x3d, 0xc6, 0x11, 0xbe,
-	0x49, 0xfe, 0x6a, 0xe2, 0x5d, 0xe8, 0x94, 0x2d, 0x37, 0x09, 0x06, 0x12, 0x33, 0x13, 0x2c, 0x96,
-	0xa6, 0x09, 0x9d, 0xfc, 0xbc, 0x0e, 0xa2, 0x68, 0xae, 0xa2, 0x79, 0x04, 0x2b, 0x33, 0xcc, 0x5c,
-	0x89, 0x7b, 0xb0, 0x90, 0xe7, 0xe7, 0x5d, 0xdd, 0xa8, 0x55, 0x8e, 0x45, 0x95, 0x87, 0x9f, 0x6b,
-	0xd0, 0xca, 0xd7, 0xcf, 0xd4, 0xa7, 0x87, 0x9e, 0xc2, 0x62, 0x41, 0x8a, 0x50, 0x8e, 0xad, 0xba,
-	0xe8, 0xad, 0x55, 0x6a, 0x4a, 0xd5, 0x5c, 0x7f, 0xf7, 0xeb, 0xf7, 0x57, 0xbd, 0x83, 0x96, 0x9d,
-	0xe9, 0xc0, 0xc9, 0x3f, 0x64, 0xc7, 0x8b, 0x22, 0x34, 0x86, 0x86, 0x9a, 0x2b, 0x74, 0x2b, 0x6f,
-	0xaa, 0x0c, 0x69, 0x0f, 0x5d, 0x2e, 0x15, 0x34, 0x6b, 0x92, 0xa6, 0x6d, 0x36, 0x4b, 0x9a, 0x91,
-	0xd6, 0x47, 0xfb, 0x50, 0xcf, 0xe5, 0xd0, 0x4a, 0x29, 0x5c, 0x32, 0xac, 0x5e, 0x14, 0x8a, 0xfe,
-	0xdb, 0xb2, 0x7f, 0x05, 0xb5, 0x67, 0x36, 0xde, 0x92, 0xe0, 0x1c, 0x85, 0xd0, 0x50, 0x37, 0xaf,
-	0x7c, 0x54, 0xc6, 0x48, 0xf9, 0xa8, 0x0e, 0x86, 0xb9, 0x23, 0x79, 0xb6, 0x7a, 0xe8, 0x82, 0x27,
-	0x3f, 0x2b, 0x9b, 0x04, 0xe7, 0x23, 0xad, 0xff, 0xe2, 0xce, 0xf0, 0xfa, 0x0d, 0xf4, 0x04, 0x1a,
-	0xea, 0xc6, 0x95, 0x50, 0x65, 0x60, 0x94, 0x50, 0x75, 0x20, 0x4a, 0xc3, 0xfd, 0xaa, 0xe1, 0xc3,
-	0x8f, 0xfa, 0x97, 0x83, 0xf7, 0x3a, 0xfa, 0xae, 0xc1, 0x72, 0x7e, 0x33, 0x46, 0xf1, 0x2a, 0x9a,
-	0x1f, 0x34, 0x70, 0x42, 0x6a, 0x85, 0x2c, 0xf5, 0xad, 0x89, 0x10, 0xa9, 0xc5, 0x30, 0x17, 0x56,
-	0x4c, 0x7c, 0x46, 0x0b, 0x88, 0x25, 0x32, 0x41, 0x19, 0xf1, 0x22, 0x23, 0x65, 0xf4, 0x14, 0xfb,
-	0x02, 0x1d, 0xe6, 0x40, 0x3e, 0x72, 0x9c, 0x90, 0x88, 0x49, 0x76, 0x62, 0xfb, 0x34, 0x76, 0xbc,
-	0x98, 0xd3, 0x57, 0x34, 0xfa, 0x57, 0xae, 0x1e, 0x8a, 0x71, 0x40, 0xb2, 0x78, 0xbf, 0xe8, 0xcb,
-	0x39, 0x86, 0xb5, 0x81, 0xbd, 0xd5, 0xd7, 0xb4, 0xe1, 0xaa, 0x97, 0xa6, 0x11, 0xf1, 0xe5, 0x33,
-	0xea, 0x9c, 0x72, 0x9a, 0x8c, 0xfe, 0xaa, 0xb8, 0x8f, 0xa0, 0xb6, 0xbd, 0xb5, 0x8d, 0xb6, 0xa1,
-	0xef, 0x62, 0x91, 0xb1, 0x04, 0x07, 0xc6, 0xd9, 0x04, 0x27, 0x86, 0x98, 0x60, 0x83, 0x61, 0x4e,
-	0x33, 0xe6, 0x63, 0x23, 0xa0, 0x98, 0x1b, 0x09, 0x15, 0x06, 0x7e, 0x43, 0xb8, 0xb0, 0x51, 0x03,
-	0xea, 0xdf, 0x74, 0x6d, 0xf1, 0xa4, 0x21, 0x1f, 0xc1, 0x87, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff,
-	0xbc, 0x6e, 0xf1, 0xa4, 0x21, 0x06, 0x00, 0x00,
+func (m *ListDeletedRequest) Reset()         { *m = ListDeletedRequest{} }
+func (m *ListDeletedRequest) String() string { return proto.CompactTextString(m) }
+func (*ListDeletedRequest) ProtoMessage()    {}
+func (*ListDeletedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{13}
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *ListDeletedRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDeletedRequest.Unmarshal(m, b)
+}
+func (m *ListDeletedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDeletedRequest.Marshal(b, m, deterministic)
+}
+func (m *ListDeletedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDeletedRequest.Merge(m, src)
+}
+func (m *ListDeletedRequest) XXX_Size() int {
+	return xxx_messageInfo_ListDeletedRequest.Size(m)
+}
+func (m *ListDeletedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDeletedRequest.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_ListDeletedRequest proto.InternalMessageInfo
 
-// ToDoServiceClient is the client API for ToDoService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+func (m *ListDeletedRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+// Contains todo tasks in trash, the most recently deleted first
+type ListDeletedResponse struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Deleted tasks
+	ToDos                []*ToDo  `protobuf:"bytes,2,rep,name=toDos,proto3" json:"toDos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListDeletedResponse) Reset()         { *m = ListDeletedResponse{} }
+func (m *ListDeletedResponse) String() string { return proto.CompactTextString(m) }
+func (*ListDeletedResponse) ProtoMessage()    {}
+func (*ListDeletedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{14}
+}
+
+func (m *ListDeletedResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDeletedResponse.Unmarshal(m, b)
+}
+func (m *ListDeletedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDeletedResponse.Marshal(b, m, deterministic)
+}
+func (m *ListDeletedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDeletedResponse.Merge(m, src)
+}
+func (m *ListDeletedResponse) XXX_Size() int {
+	return xxx_messageInfo_ListDeletedResponse.Size(m)
+}
+func (m *ListDeletedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDeletedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDeletedResponse proto.InternalMessageInfo
+
+func (m *ListDeletedResponse) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *ListDeletedResponse) GetToDos() []*ToDo {
+	if m != nil {
+		return m.ToDos
+	}
+	return nil
+}
+
+// Request data to read all todo task
+type ReadAllRequest struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Read tasks in trash too
+	ShowDeleted bool `protobuf:"varint,2,opt,name=showDeleted,proto3" json:"showDeleted,omitempty"`
+	// Read tasks labeled by all of the tags only
+	Tags []string `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Read tasks of the project only, 0 reads tasks of all projects
+	ProjectId            int64    `protobuf:"varint,4,opt,name=projectId,proto3" json:"projectId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReadAllRequest) Reset()         { *m = ReadAllRequest{} }
+func (m *ReadAllRequest) String() string { return proto.CompactTextString(m) }
+func (*ReadAllRequest) ProtoMessage()    {}
+func (*ReadAllRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{15}
+}
+
+func (m *ReadAllRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadAllRequest.Unmarshal(m, b)
+}
+func (m *ReadAllRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadAllRequest.Marshal(b, m, deterministic)
+}
+func (m *ReadAllRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadAllRequest.Merge(m, src)
+}
+func (m *ReadAllRequest) XXX_Size() int {
+	return xxx_messageInfo_ReadAllRequest.Size(m)
+}
+func (m *ReadAllRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadAllRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadAllRequest proto.InternalMessageInfo
+
+func (m *ReadAllRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *ReadAllRequest) GetShowDeleted() bool {
+	if m != nil {
+		return m.ShowDeleted
+	}
+	return false
+}
+
+func (m *ReadAllRequest) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *ReadAllRequest) GetProjectId() int64 {
+	if m != nil {
+		return m.ProjectId
+	}
+	return 0
+}
+
+// Contains list of all todo tasks
+type ReadAllResponse struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// List of all todo tasks
+	ToDos                []*ToDo  `protobuf:"bytes,2,rep,name=toDos,proto3" json:"toDos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReadAllResponse) Reset()         { *m = ReadAllResponse{} }
+func (m *ReadAllResponse) String() string { return proto.CompactTextString(m) }
+func (*ReadAllResponse) ProtoMessage()    {}
+func (*ReadAllResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{16}
+}
+
+func (m *ReadAllResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadAllResponse.Unmarshal(m, b)
+}
+func (m *ReadAllResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadAllResponse.Marshal(b, m, deterministic)
+}
+func (m *ReadAllResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadAllResponse.Merge(m, src)
+}
+func (m *ReadAllResponse) XXX_Size() int {
+	return xxx_messageInfo_ReadAllResponse.Size(m)
+}
+func (m *ReadAllResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadAllResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadAllResponse proto.InternalMessageInfo
+
+func (m *ReadAllResponse) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *ReadAllResponse) GetToDos() []*ToDo {
+	if m != nil {
+		return m.ToDos
+	}
+	return nil
+}
+
+// Result of batch item
+type BatchResult struct {
+	// Unique integer identifier of the todo task
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Status of the item, code is OK if the item was applied
+	Status               *status.Status `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *BatchResult) Reset()         { *m = BatchResult{} }
+func (m *BatchResult) String() string { return proto.CompactTextString(m) }
+func (*BatchResult) ProtoMessage()    {}
+func (*BatchResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{17}
+}
+
+func (m *BatchResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchResult.Unmarshal(m, b)
+}
+func (m *BatchResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchResult.Marshal(b, m, deterministic)
+}
+func (m *BatchResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchResult.Merge(m, src)
+}
+func (m *BatchResult) XXX_Size() int {
+	return xxx_messageInfo_BatchResult.Size(m)
+}
+func (m *BatchResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchResult proto.InternalMessageInfo
+
+func (m *BatchResult) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *BatchResult) GetStatus() *status.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+// Request data to create todo tasks in one transaction
+type BatchCreateRequest struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Task entities to add
+	ToDos []*ToDo `protobuf:"bytes,2,rep,name=toDos,proto3" json:"toDos,omitempty"`
+	// How tasks which cannot be created are handled
+	Mode                 BatchMode `protobuf:"varint,3,opt,name=mode,proto3,enum=v1.BatchMode" json:"mode,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *BatchCreateRequest) Reset()         { *m = BatchCreateRequest{} }
+func (m *BatchCreateRequest) String() string { return proto.CompactTextString(m) }
+func (*BatchCreateRequest) ProtoMessage()    {}
+func (*BatchCreateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{18}
+}
+
+func (m *BatchCreateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchCreateRequest.Unmarshal(m, b)
+}
+func (m *BatchCreateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchCreateRequest.Marshal(b, m, deterministic)
+}
+func (m *BatchCreateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchCreateRequest.Merge(m, src)
+}
+func (m *BatchCreateRequest) XXX_Size() int {
+	return xxx_messageInfo_BatchCreateRequest.Size(m)
+}
+func (m *BatchCreateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchCreateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchCreateRequest proto.InternalMessageInfo
+
+func (m *BatchCreateRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *BatchCreateRequest) GetToDos() []*ToDo {
+	if m != nil {
+		return m.ToDos
+	}
+	return nil
+}
+
+func (m *BatchCreateRequest) GetMode() BatchMode {
+	if m != nil {
+		return m.Mode
+	}
+	return BatchMode_ALL_OR_NOTHING
+}
+
+// Contains IDs of created todo tasks
+type BatchCreateResponse struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Contains number of entities have been created
+	Created int64 `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	// Result of every task in request order, ID is set for created tasks
+	Results              []*BatchResult `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *BatchCreateResponse) Reset()         { *m = BatchCreateResponse{} }
+func (m *BatchCreateResponse) String() string { return proto.CompactTextString(m) }
+func (*BatchCreateResponse) ProtoMessage()    {}
+func (*BatchCreateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{19}
+}
+
+func (m *BatchCreateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchCreateResponse.Unmarshal(m, b)
+}
+func (m *BatchCreateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchCreateResponse.Marshal(b, m, deterministic)
+}
+func (m *BatchCreateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchCreateResponse.Merge(m, src)
+}
+func (m *BatchCreateResponse) XXX_Size() int {
+	return xxx_messageInfo_BatchCreateResponse.Size(m)
+}
+func (m *BatchCreateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchCreateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchCreateResponse proto.InternalMessageInfo
+
+func (m *BatchCreateResponse) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *BatchCreateResponse) GetCreated() int64 {
+	if m != nil {
+		return m.Created
+	}
+	return 0
+}
+
+func (m *BatchCreateResponse) GetResults() []*BatchResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+// Request data to update todo tasks in one transaction
+type BatchUpdateRequest struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Task entities to update, every task must exist
+	ToDos []*ToDo `protobuf:"bytes,2,rep,name=toDos,proto3" json:"toDos,omitempty"`
+	// How tasks which cannot be updated are handled
+	Mode                 BatchMode `protobuf:"varint,3,opt,name=mode,proto3,enum=v1.BatchMode" json:"mode,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *BatchUpdateRequest) Reset()         { *m = BatchUpdateRequest{} }
+func (m *BatchUpdateRequest) String() string { return proto.CompactTextString(m) }
+func (*BatchUpdateRequest) ProtoMessage()    {}
+func (*BatchUpdateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{20}
+}
+
+func (m *BatchUpdateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchUpdateRequest.Unmarshal(m, b)
+}
+func (m *BatchUpdateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchUpdateRequest.Marshal(b, m, deterministic)
+}
+func (m *BatchUpdateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchUpdateRequest.Merge(m, src)
+}
+func (m *BatchUpdateRequest) XXX_Size() int {
+	return xxx_messageInfo_BatchUpdateRequest.Size(m)
+}
+func (m *BatchUpdateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchUpdateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchUpdateRequest proto.InternalMessageInfo
+
+func (m *BatchUpdateRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *BatchUpdateRequest) GetToDos() []*ToDo {
+	if m != nil {
+		return m.ToDos
+	}
+	return nil
+}
+
+func (m *BatchUpdateRequest) GetMode() BatchMode {
+	if m != nil {
+		return m.Mode
+	}
+	return BatchMode_ALL_OR_NOTHING
+}
+
+// Contains status of batch update operation
+type BatchUpdateResponse struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Contains number of entities have been updated
+	Updated int64 `protobuf:"varint,2,opt,name=updated,proto3" json:"updated,omitempty"`
+	// Result of every task in request order
+	Results              []*BatchResult `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *BatchUpdateResponse) Reset()         { *m = BatchUpdateResponse{} }
+func (m *BatchUpdateResponse) String() string { return proto.CompactTextString(m) }
+func (*BatchUpdateResponse) ProtoMessage()    {}
+func (*BatchUpdateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{21}
+}
+
+func (m *BatchUpdateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchUpdateResponse.Unmarshal(m, b)
+}
+func (m *BatchUpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchUpdateResponse.Marshal(b, m, deterministic)
+}
+func (m *BatchUpdateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchUpdateResponse.Merge(m, src)
+}
+func (m *BatchUpdateResponse) XXX_Size() int {
+	return xxx_messageInfo_BatchUpdateResponse.Size(m)
+}
+func (m *BatchUpdateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchUpdateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchUpdateResponse proto.InternalMessageInfo
+
+func (m *BatchUpdateResponse) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *BatchUpdateResponse) GetUpdated() int64 {
+	if m != nil {
+		return m.Updated
+	}
+	return 0
+}
+
+func (m *BatchUpdateResponse) GetResults() []*BatchResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+// Request data to delete todo tasks in one transaction
+type BatchDeleteRequest struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Unique integer identifiers of the todo tasks to delete, every task must exist
+	Ids []int64 `protobuf:"varint,2,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	// How tasks which cannot be deleted are handled
+	Mode                 BatchMode `protobuf:"varint,3,opt,name=mode,proto3,enum=v1.BatchMode" json:"mode,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *BatchDeleteRequest) Reset()         { *m = BatchDeleteRequest{} }
+func (m *BatchDeleteRequest) String() string { return proto.CompactTextString(m) }
+func (*BatchDeleteRequest) ProtoMessage()    {}
+func (*BatchDeleteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{22}
+}
+
+func (m *BatchDeleteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchDeleteRequest.Unmarshal(m, b)
+}
+func (m *BatchDeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchDeleteRequest.Marshal(b, m, deterministic)
+}
+func (m *BatchDeleteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchDeleteRequest.Merge(m, src)
+}
+func (m *BatchDeleteRequest) XXX_Size() int {
+	return xxx_messageInfo_BatchDeleteRequest.Size(m)
+}
+func (m *BatchDeleteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchDeleteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchDeleteRequest proto.InternalMessageInfo
+
+func (m *BatchDeleteRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *BatchDeleteRequest) GetIds() []int64 {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
+func (m *BatchDeleteRequest) GetMode() BatchMode {
+	if m != nil {
+		return m.Mode
+	}
+	return BatchMode_ALL_OR_NOTHING
+}
+
+// Contains status of batch delete operation
+type BatchDeleteResponse struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Contains number of entities have been deleted
+	Deleted int64 `protobuf:"varint,2,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	// Result of every task in request order
+	Results              []*BatchResult `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *BatchDeleteResponse) Reset()         { *m = BatchDeleteResponse{} }
+func (m *BatchDeleteResponse) String() string { return proto.CompactTextString(m) }
+func (*BatchDeleteResponse) ProtoMessage()    {}
+func (*BatchDeleteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{23}
+}
+
+func (m *BatchDeleteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchDeleteResponse.Unmarshal(m, b)
+}
+func (m *BatchDeleteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchDeleteResponse.Marshal(b, m, deterministic)
+}
+func (m *BatchDeleteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchDeleteResponse.Merge(m, src)
+}
+func (m *BatchDeleteResponse) XXX_Size() int {
+	return xxx_messageInfo_BatchDeleteResponse.Size(m)
+}
+func (m *BatchDeleteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchDeleteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchDeleteResponse proto.InternalMessageInfo
+
+func (m *BatchDeleteResponse) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *BatchDeleteResponse) GetDeleted() int64 {
+	if m != nil {
+		return m.Deleted
+	}
+	return 0
+}
+
+func (m *BatchDeleteResponse) GetResults() []*BatchResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+// Task of bulk import stream
+type ImportRequest struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Task entity to add
+	ToDo                 *ToDo    `protobuf:"bytes,2,opt,name=toDo,proto3" json:"toDo,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportRequest) Reset()         { *m = ImportRequest{} }
+func (m *ImportRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportRequest) ProtoMessage()    {}
+func (*ImportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{24}
+}
+
+func (m *ImportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportRequest.Unmarshal(m, b)
+}
+func (m *ImportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportRequest.Marshal(b, m, deterministic)
+}
+func (m *ImportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportRequest.Merge(m, src)
+}
+func (m *ImportRequest) XXX_Size() int {
+	return xxx_messageInfo_ImportRequest.Size(m)
+}
+func (m *ImportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportRequest proto.InternalMessageInfo
+
+func (m *ImportRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *ImportRequest) GetToDo() *ToDo {
+	if m != nil {
+		return m.ToDo
+	}
+	return nil
+}
+
+// Error of task which was not imported
+type ImportError struct {
+	// Position of the task in import stream, starting at 0
+	Row int64 `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	// Reason the task was not imported
+	Status               *status.Status `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ImportError) Reset()         { *m = ImportError{} }
+func (m *ImportError) String() string { return proto.CompactTextString(m) }
+func (*ImportError) ProtoMessage()    {}
+func (*ImportError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{25}
+}
+
+func (m *ImportError) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportError.Unmarshal(m, b)
+}
+func (m *ImportError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportError.Marshal(b, m, deterministic)
+}
+func (m *ImportError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportError.Merge(m, src)
+}
+func (m *ImportError) XXX_Size() int {
+	return xxx_messageInfo_ImportError.Size(m)
+}
+func (m *ImportError) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportError.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportError proto.InternalMessageInfo
+
+func (m *ImportError) GetRow() int64 {
+	if m != nil {
+		return m.Row
+	}
+	return 0
+}
+
+func (m *ImportError) GetStatus() *status.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+// Contains summary of bulk import
+type ImportResponse struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Contains number of entities have been inserted
+	Inserted int64 `protobuf:"varint,2,opt,name=inserted,proto3" json:"inserted,omitempty"`
+	// Contains number of entities have failed
+	Failed int64 `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	// Errors of failed tasks in stream order, only the first 1000 errors are listed
+	Errors               []*ImportError `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ImportResponse) Reset()         { *m = ImportResponse{} }
+func (m *ImportResponse) String() string { return proto.CompactTextString(m) }
+func (*ImportResponse) ProtoMessage()    {}
+func (*ImportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{26}
+}
+
+func (m *ImportResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportResponse.Unmarshal(m, b)
+}
+func (m *ImportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportResponse.Marshal(b, m, deterministic)
+}
+func (m *ImportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportResponse.Merge(m, src)
+}
+func (m *ImportResponse) XXX_Size() int {
+	return xxx_messageInfo_ImportResponse.Size(m)
+}
+func (m *ImportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportResponse proto.InternalMessageInfo
+
+func (m *ImportResponse) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *ImportResponse) GetInserted() int64 {
+	if m != nil {
+		return m.Inserted
+	}
+	return 0
+}
+
+func (m *ImportResponse) GetFailed() int64 {
+	if m != nil {
+		return m.Failed
+	}
+	return 0
+}
+
+func (m *ImportResponse) GetErrors() []*ImportError {
+	if m != nil {
+		return m.Errors
+	}
+	return nil
+}
+
+// Request data to export todo tasks
+type ExportRequest struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// File format: csv (default), jsonl (JSON Lines) or ics (iCalendar)
+	Format string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	// IDs of tasks to export, all tasks if empty
+	Ids []int64 `protobuf:"varint,3,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	// Export only tasks with reminder at or after this time
+	ReminderAfter *timestamp.Timestamp `protobuf:"bytes,4,opt,name=reminderAfter,proto3" json:"reminderAfter,omitempty"`
+	// Export only tasks with reminder before this time
+	ReminderBefore       *timestamp.Timestamp `protobuf:"bytes,5,opt,name=reminderBefore,proto3" json:"reminderBefore,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ExportRequest) Reset()         { *m = ExportRequest{} }
+func (m *ExportRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportRequest) ProtoMessage()    {}
+func (*ExportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{27}
+}
+
+func (m *ExportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportRequest.Unmarshal(m, b)
+}
+func (m *ExportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportRequest.Marshal(b, m, deterministic)
+}
+func (m *ExportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportRequest.Merge(m, src)
+}
+func (m *ExportRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportRequest.Size(m)
+}
+func (m *ExportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportRequest proto.InternalMessageInfo
+
+func (m *ExportRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *ExportRequest) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+func (m *ExportRequest) GetIds() []int64 {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
+func (m *ExportRequest) GetReminderAfter() *timestamp.Timestamp {
+	if m != nil {
+		return m.ReminderAfter
+	}
+	return nil
+}
+
+func (m *ExportRequest) GetReminderBefore() *timestamp.Timestamp {
+	if m != nil {
+		return m.ReminderBefore
+	}
+	return nil
+}
+
+// Column names of CSV file, every column defaults to the field name,
+// e.g. "title". Header names are matched case-insensitively
+type CSVColumns struct {
+	// Column with title of the task
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// Column with description of the task
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Column with reminder of the task, RFC 3339 date and time or date only
+	Reminder string `protobuf:"bytes,3,opt,name=reminder,proto3" json:"reminder,omitempty"`
+	// Column with stable identifier of the task in external system
+	ExternalId           string   `protobuf:"bytes,4,opt,name=externalId,proto3" json:"externalId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CSVColumns) Reset()         { *m = CSVColumns{} }
+func (m *CSVColumns) String() string { return proto.CompactTextString(m) }
+func (*CSVColumns) ProtoMessage()    {}
+func (*CSVColumns) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{28}
+}
+
+func (m *CSVColumns) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CSVColumns.Unmarshal(m, b)
+}
+func (m *CSVColumns) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CSVColumns.Marshal(b, m, deterministic)
+}
+func (m *CSVColumns) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CSVColumns.Merge(m, src)
+}
+func (m *CSVColumns) XXX_Size() int {
+	return xxx_messageInfo_CSVColumns.Size(m)
+}
+func (m *CSVColumns) XXX_DiscardUnknown() {
+	xxx_messageInfo_CSVColumns.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CSVColumns proto.InternalMessageInfo
+
+func (m *CSVColumns) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *CSVColumns) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *CSVColumns) GetReminder() string {
+	if m != nil {
+		return m.Reminder
+	}
+	return ""
+}
+
+func (m *CSVColumns) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
+	}
+	return ""
+}
+
+// Request data to import todo tasks from file
+type ImportFileRequest struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// File format: csv or ics (iCalendar), taken from content type of file if empty
+	Format string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	// Uploaded file. iCalendar VTODO and VEVENT components are imported,
+	// DTSTART (or DUE of VTODO) is the reminder and UID is the external ID
+	File *httpbody.HttpBody `protobuf:"bytes,3,opt,name=file,proto3" json:"file,omitempty"`
+	// Column names of CSV file
+	Columns *CSVColumns `protobuf:"bytes,4,opt,name=columns,proto3" json:"columns,omitempty"`
+	// Validate file and report what would be imported without creating tasks
+	DryRun               bool     `protobuf:"varint,5,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportFileRequest) Reset()         { *m = ImportFileRequest{} }
+func (m *ImportFileRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportFileRequest) ProtoMessage()    {}
+func (*ImportFileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{29}
+}
+
+func (m *ImportFileRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportFileRequest.Unmarshal(m, b)
+}
+func (m *ImportFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportFileRequest.Marshal(b, m, deterministic)
+}
+func (m *ImportFileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportFileRequest.Merge(m, src)
+}
+func (m *ImportFileRequest) XXX_Size() int {
+	return xxx_messageInfo_ImportFileRequest.Size(m)
+}
+func (m *ImportFileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportFileRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportFileRequest proto.InternalMessageInfo
+
+func (m *ImportFileRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *ImportFileRequest) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+func (m *ImportFileRequest) GetFile() *httpbody.HttpBody {
+	if m != nil {
+		return m.File
+	}
+	return nil
+}
+
+func (m *ImportFileRequest) GetColumns() *CSVColumns {
+	if m != nil {
+		return m.Columns
+	}
+	return nil
+}
+
+func (m *ImportFileRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+// Result of task of imported file
+type ImportFileResult struct {
+	// Position of the task in file, starting at 0
+	Row int64 `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	// What import does with the task
+	Action ImportAction `protobuf:"varint,2,opt,name=action,proto3,enum=v1.ImportAction" json:"action,omitempty"`
+	// ID of created task or of existing task with the same external ID, not set in dry run
+	Id int64 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	// Stable identifier of the task in external system
+	ExternalId string `protobuf:"bytes,4,opt,name=externalId,proto3" json:"externalId,omitempty"`
+	// Reason the task was rejected
+	Status               *status.Status `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ImportFileResult) Reset()         { *m = ImportFileResult{} }
+func (m *ImportFileResult) String() string { return proto.CompactTextString(m) }
+func (*ImportFileResult) ProtoMessage()    {}
+func (*ImportFileResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{30}
+}
+
+func (m *ImportFileResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportFileResult.Unmarshal(m, b)
+}
+func (m *ImportFileResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportFileResult.Marshal(b, m, deterministic)
+}
+func (m *ImportFileResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportFileResult.Merge(m, src)
+}
+func (m *ImportFileResult) XXX_Size() int {
+	return xxx_messageInfo_ImportFileResult.Size(m)
+}
+func (m *ImportFileResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportFileResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportFileResult proto.InternalMessageInfo
+
+func (m *ImportFileResult) GetRow() int64 {
+	if m != nil {
+		return m.Row
+	}
+	return 0
+}
+
+func (m *ImportFileResult) GetAction() ImportAction {
+	if m != nil {
+		return m.Action
+	}
+	return ImportAction_CREATE
+}
+
+func (m *ImportFileResult) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ImportFileResult) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
+	}
+	return ""
+}
+
+func (m *ImportFileResult) GetStatus() *status.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+// Contains summary of file import
+type ImportFileResponse struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// True if nothing was imported because of dry run
+	DryRun bool `protobuf:"varint,2,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+	// Contains number of entities have been created, or would be created in dry run
+	Created int64 `protobuf:"varint,3,opt,name=created,proto3" json:"created,omitempty"`
+	// Contains number of entities have been skipped as duplicates
+	Skipped int64 `protobuf:"varint,4,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	// Contains number of entities have been rejected
+	Rejected int64 `protobuf:"varint,5,opt,name=rejected,proto3" json:"rejected,omitempty"`
+	// Result of every task in file order
+	Results              []*ImportFileResult `protobuf:"bytes,6,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ImportFileResponse) Reset()         { *m = ImportFileResponse{} }
+func (m *ImportFileResponse) String() string { return proto.CompactTextString(m) }
+func (*ImportFileResponse) ProtoMessage()    {}
+func (*ImportFileResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{31}
+}
+
+func (m *ImportFileResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportFileResponse.Unmarshal(m, b)
+}
+func (m *ImportFileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportFileResponse.Marshal(b, m, deterministic)
+}
+func (m *ImportFileResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportFileResponse.Merge(m, src)
+}
+func (m *ImportFileResponse) XXX_Size() int {
+	return xxx_messageInfo_ImportFileResponse.Size(m)
+}
+func (m *ImportFileResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportFileResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportFileResponse proto.InternalMessageInfo
+
+func (m *ImportFileResponse) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *ImportFileResponse) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *ImportFileResponse) GetCreated() int64 {
+	if m != nil {
+		return m.Created
+	}
+	return 0
+}
+
+func (m *ImportFileResponse) GetSkipped() int64 {
+	if m != nil {
+		return m.Skipped
+	}
+	return 0
+}
+
+func (m *ImportFileResponse) GetRejected() int64 {
+	if m != nil {
+		return m.Rejected
+	}
+	return 0
+}
+
+func (m *ImportFileResponse) GetResults() []*ImportFileResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+// Request data to complete todo task
+type CompleteRequest struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Unique integer identifier of the todo task to complete
+	Id                   int64    `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CompleteRequest) Reset()         { *m = CompleteRequest{} }
+func (m *CompleteRequest) String() string { return proto.CompactTextString(m) }
+func (*CompleteRequest) ProtoMessage()    {}
+func (*CompleteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{32}
+}
+
+func (m *CompleteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CompleteRequest.Unmarshal(m, b)
+}
+func (m *CompleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CompleteRequest.Marshal(b, m, deterministic)
+}
+func (m *CompleteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompleteRequest.Merge(m, src)
+}
+func (m *CompleteRequest) XXX_Size() int {
+	return xxx_messageInfo_CompleteRequest.Size(m)
+}
+func (m *CompleteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompleteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompleteRequest proto.InternalMessageInfo
+
+func (m *CompleteRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *CompleteRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// Contains completed todo task and its next occurrence
+type CompleteResponse struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Completed task
+	ToDo *ToDo `protobuf:"bytes,2,opt,name=toDo,proto3" json:"toDo,omitempty"`
+	// Task created for the next occurrence of recurring task, not set if the task does not recur
+	// or its recurrence ended
+	Next                 *ToDo    `protobuf:"bytes,3,opt,name=next,proto3" json:"next,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CompleteResponse) Reset()         { *m = CompleteResponse{} }
+func (m *CompleteResponse) String() string { return proto.CompactTextString(m) }
+func (*CompleteResponse) ProtoMessage()    {}
+func (*CompleteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{33}
+}
+
+func (m *CompleteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CompleteResponse.Unmarshal(m, b)
+}
+func (m *CompleteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CompleteResponse.Marshal(b, m, deterministic)
+}
+func (m *CompleteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompleteResponse.Merge(m, src)
+}
+func (m *CompleteResponse) XXX_Size() int {
+	return xxx_messageInfo_CompleteResponse.Size(m)
+}
+func (m *CompleteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompleteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompleteResponse proto.InternalMessageInfo
+
+func (m *CompleteResponse) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *CompleteResponse) GetToDo() *ToDo {
+	if m != nil {
+		return m.ToDo
+	}
+	return nil
+}
+
+func (m *CompleteResponse) GetNext() *ToDo {
+	if m != nil {
+		return m.Next
+	}
+	return nil
+}
+
+// Request data to list occurrences of todo tasks in time window
+type ListOccurrencesRequest struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Start of the window, inclusive, now if not set
+	Start *timestamp.Timestamp `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	// End of the window, exclusive
+	End *timestamp.Timestamp `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	// IDs of tasks to list, all tasks if empty
+	Ids                  []int64  `protobuf:"varint,4,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListOccurrencesRequest) Reset()         { *m = ListOccurrencesRequest{} }
+func (m *ListOccurrencesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListOccurrencesRequest) ProtoMessage()    {}
+func (*ListOccurrencesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{34}
+}
+
+func (m *ListOccurrencesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListOccurrencesRequest.Unmarshal(m, b)
+}
+func (m *ListOccurrencesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListOccurrencesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListOccurrencesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListOccurrencesRequest.Merge(m, src)
+}
+func (m *ListOccurrencesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListOccurrencesRequest.Size(m)
+}
+func (m *ListOccurrencesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListOccurrencesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListOccurrencesRequest proto.InternalMessageInfo
+
+func (m *ListOccurrencesRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *ListOccurrencesRequest) GetStart() *timestamp.Timestamp {
+	if m != nil {
+		return m.Start
+	}
+	return nil
+}
+
+func (m *ListOccurrencesRequest) GetEnd() *timestamp.Timestamp {
+	if m != nil {
+		return m.End
+	}
+	return nil
+}
+
+func (m *ListOccurrencesRequest) GetIds() []int64 {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
+// Occurrence of todo task
+type Occurrence struct {
+	// Unique integer identifier of the todo task
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Title of the task
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// Date and time of the occurrence
+	Time                 *timestamp.Timestamp `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Occurrence) Reset()         { *m = Occurrence{} }
+func (m *Occurrence) String() string { return proto.CompactTextString(m) }
+func (*Occurrence) ProtoMessage()    {}
+func (*Occurrence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{35}
+}
+
+func (m *Occurrence) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Occurrence.Unmarshal(m, b)
+}
+func (m *Occurrence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Occurrence.Marshal(b, m, deterministic)
+}
+func (m *Occurrence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Occurrence.Merge(m, src)
+}
+func (m *Occurrence) XXX_Size() int {
+	return xxx_messageInfo_Occurrence.Size(m)
+}
+func (m *Occurrence) XXX_DiscardUnknown() {
+	xxx_messageInfo_Occurrence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Occurrence proto.InternalMessageInfo
+
+func (m *Occurrence) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Occurrence) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *Occurrence) GetTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+// Contains occurrences of todo tasks ordered by time
+type ListOccurrencesResponse struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Occurrences of tasks which are not done
+	Occurrences []*Occurrence `protobuf:"bytes,2,rep,name=occurrences,proto3" json:"occurrences,omitempty"`
+	// Window has more occurrences than listed
+	Truncated            bool     `protobuf:"varint,3,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListOccurrencesResponse) Reset()         { *m = ListOccurrencesResponse{} }
+func (m *ListOccurrencesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListOccurrencesResponse) ProtoMessage()    {}
+func (*ListOccurrencesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{36}
+}
+
+func (m *ListOccurrencesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListOccurrencesResponse.Unmarshal(m, b)
+}
+func (m *ListOccurrencesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListOccurrencesResponse.Marshal(b, m, deterministic)
+}
+func (m *ListOccurrencesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListOccurrencesResponse.Merge(m, src)
+}
+func (m *ListOccurrencesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListOccurrencesResponse.Size(m)
+}
+func (m *ListOccurrencesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListOccurrencesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListOccurrencesResponse proto.InternalMessageInfo
+
+func (m *ListOccurrencesResponse) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *ListOccurrencesResponse) GetOccurrences() []*Occurrence {
+	if m != nil {
+		return m.Occurrences
+	}
+	return nil
+}
+
+func (m *ListOccurrencesResponse) GetTruncated() bool {
+	if m != nil {
+		return m.Truncated
+	}
+	return false
+}
+
+// Request data to list tags
+type ListTagsRequest struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api                  string   `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListTagsRequest) Reset()         { *m = ListTagsRequest{} }
+func (m *ListTagsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListTagsRequest) ProtoMessage()    {}
+func (*ListTagsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{37}
+}
+
+func (m *ListTagsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListTagsRequest.Unmarshal(m, b)
+}
+func (m *ListTagsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListTagsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListTagsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTagsRequest.Merge(m, src)
+}
+func (m *ListTagsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListTagsRequest.Size(m)
+}
+func (m *ListTagsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTagsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListTagsRequest proto.InternalMessageInfo
+
+func (m *ListTagsRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+// Contains tags ordered by name
+type ListTagsResponse struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Tags with number of tasks they label
+	Tags                 []*Tag   `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListTagsResponse) Reset()         { *m = ListTagsResponse{} }
+func (m *ListTagsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListTagsResponse) ProtoMessage()    {}
+func (*ListTagsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{38}
+}
+
+func (m *ListTagsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListTagsResponse.Unmarshal(m, b)
+}
+func (m *ListTagsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListTagsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListTagsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTagsResponse.Merge(m, src)
+}
+func (m *ListTagsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListTagsResponse.Size(m)
+}
+func (m *ListTagsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTagsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListTagsResponse proto.InternalMessageInfo
+
+func (m *ListTagsResponse) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *ListTagsResponse) GetTags() []*Tag {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+// Request data to create new project
+type CreateProjectRequest struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Project entity to add
+	Project              *Project `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateProjectRequest) Reset()         { *m = CreateProjectRequest{} }
+func (m *CreateProjectRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateProjectRequest) ProtoMessage()    {}
+func (*CreateProjectRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{39}
+}
+
+func (m *CreateProjectRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateProjectRequest.Unmarshal(m, b)
+}
+func (m *CreateProjectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateProjectRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateProjectRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateProjectRequest.Merge(m, src)
+}
+func (m *CreateProjectRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateProjectRequest.Size(m)
+}
+func (m *CreateProjectRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateProjectRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateProjectRequest proto.InternalMessageInfo
+
+func (m *CreateProjectRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *CreateProjectRequest) GetProject() *Project {
+	if m != nil {
+		return m.Project
+	}
+	return nil
+}
+
+// Contains created project
+type CreateProjectResponse struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Created project
+	Project              *Project `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateProjectResponse) Reset()         { *m = CreateProjectResponse{} }
+func (m *CreateProjectResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateProjectResponse) ProtoMessage()    {}
+func (*CreateProjectResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{40}
+}
+
+func (m *CreateProjectResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateProjectResponse.Unmarshal(m, b)
+}
+func (m *CreateProjectResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateProjectResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateProjectResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateProjectResponse.Merge(m, src)
+}
+func (m *CreateProjectResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateProjectResponse.Size(m)
+}
+func (m *CreateProjectResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateProjectResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateProjectResponse proto.InternalMessageInfo
+
+func (m *CreateProjectResponse) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *CreateProjectResponse) GetProject() *Project {
+	if m != nil {
+		return m.Project
+	}
+	return nil
+}
+
+// Request data to read project
+type ReadProjectRequest struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Unique integer identifier of the project
+	Id                   int64    `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReadProjectRequest) Reset()         { *m = ReadProjectRequest{} }
+func (m *ReadProjectRequest) String() string { return proto.CompactTextString(m) }
+func (*ReadProjectRequest) ProtoMessage()    {}
+func (*ReadProjectRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{41}
+}
+
+func (m *ReadProjectRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadProjectRequest.Unmarshal(m, b)
+}
+func (m *ReadProjectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadProjectRequest.Marshal(b, m, deterministic)
+}
+func (m *ReadProjectRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadProjectRequest.Merge(m, src)
+}
+func (m *ReadProjectRequest) XXX_Size() int {
+	return xxx_messageInfo_ReadProjectRequest.Size(m)
+}
+func (m *ReadProjectRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadProjectRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadProjectRequest proto.InternalMessageInfo
+
+func (m *ReadProjectRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *ReadProjectRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// Contains project specified by ID in request
+type ReadProjectResponse struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Project entity read by ID
+	Project              *Project `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReadProjectResponse) Reset()         { *m = ReadProjectResponse{} }
+func (m *ReadProjectResponse) String() string { return proto.CompactTextString(m) }
+func (*ReadProjectResponse) ProtoMessage()    {}
+func (*ReadProjectResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{42}
+}
+
+func (m *ReadProjectResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadProjectResponse.Unmarshal(m, b)
+}
+func (m *ReadProjectResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadProjectResponse.Marshal(b, m, deterministic)
+}
+func (m *ReadProjectResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadProjectResponse.Merge(m, src)
+}
+func (m *ReadProjectResponse) XXX_Size() int {
+	return xxx_messageInfo_ReadProjectResponse.Size(m)
+}
+func (m *ReadProjectResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadProjectResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadProjectResponse proto.InternalMessageInfo
+
+func (m *ReadProjectResponse) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *ReadProjectResponse) GetProject() *Project {
+	if m != nil {
+		return m.Project
+	}
+	return nil
+}
+
+// Request data to update project
+type UpdateProjectRequest struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Project entity to update
+	Project              *Project `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateProjectRequest) Reset()         { *m = UpdateProjectRequest{} }
+func (m *UpdateProjectRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateProjectRequest) ProtoMessage()    {}
+func (*UpdateProjectRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{43}
+}
+
+func (m *UpdateProjectRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateProjectRequest.Unmarshal(m, b)
+}
+func (m *UpdateProjectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateProjectRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateProjectRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateProjectRequest.Merge(m, src)
+}
+func (m *UpdateProjectRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateProjectRequest.Size(m)
+}
+func (m *UpdateProjectRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateProjectRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateProjectRequest proto.InternalMessageInfo
+
+func (m *UpdateProjectRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *UpdateProjectRequest) GetProject() *Project {
+	if m != nil {
+		return m.Project
+	}
+	return nil
+}
+
+// Contains updated project
+type UpdateProjectResponse struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Updated project
+	Project              *Project `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateProjectResponse) Reset()         { *m = UpdateProjectResponse{} }
+func (m *UpdateProjectResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateProjectResponse) ProtoMessage()    {}
+func (*UpdateProjectResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{44}
+}
+
+func (m *UpdateProjectResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateProjectResponse.Unmarshal(m, b)
+}
+func (m *UpdateProjectResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateProjectResponse.Marshal(b, m, deterministic)
+}
+func (m *UpdateProjectResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateProjectResponse.Merge(m, src)
+}
+func (m *UpdateProjectResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateProjectResponse.Size(m)
+}
+func (m *UpdateProjectResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateProjectResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateProjectResponse proto.InternalMessageInfo
+
+func (m *UpdateProjectResponse) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *UpdateProjectResponse) GetProject() *Project {
+	if m != nil {
+		return m.Project
+	}
+	return nil
+}
+
+// Request data to delete project
+type DeleteProjectRequest struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Unique integer identifier of the project to delete
+	Id int64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// What happens to tasks of the project
+	Mode                 ProjectDeleteMode `protobuf:"varint,3,opt,name=mode,proto3,enum=v1.ProjectDeleteMode" json:"mode,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *DeleteProjectRequest) Reset()         { *m = DeleteProjectRequest{} }
+func (m *DeleteProjectRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteProjectRequest) ProtoMessage()    {}
+func (*DeleteProjectRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{45}
+}
+
+func (m *DeleteProjectRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteProjectRequest.Unmarshal(m, b)
+}
+func (m *DeleteProjectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteProjectRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteProjectRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteProjectRequest.Merge(m, src)
+}
+func (m *DeleteProjectRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteProjectRequest.Size(m)
+}
+func (m *DeleteProjectRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteProjectRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteProjectRequest proto.InternalMessageInfo
+
+func (m *DeleteProjectRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *DeleteProjectRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *DeleteProjectRequest) GetMode() ProjectDeleteMode {
+	if m != nil {
+		return m.Mode
+	}
+	return ProjectDeleteMode_RESTRICT
+}
+
+// Contains status of delete operation
+type DeleteProjectResponse struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Contains number of projects have been deleted
+	// Equals 1 in case of successful delete
+	Deleted int64 `protobuf:"varint,2,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	// Number of tasks of the project moved to trash
+	Trashed              int64    `protobuf:"varint,3,opt,name=trashed,proto3" json:"trashed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteProjectResponse) Reset()         { *m = DeleteProjectResponse{} }
+func (m *DeleteProjectResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteProjectResponse) ProtoMessage()    {}
+func (*DeleteProjectResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{46}
+}
+
+func (m *DeleteProjectResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteProjectResponse.Unmarshal(m, b)
+}
+func (m *DeleteProjectResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteProjectResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteProjectResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteProjectResponse.Merge(m, src)
+}
+func (m *DeleteProjectResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteProjectResponse.Size(m)
+}
+func (m *DeleteProjectResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteProjectResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteProjectResponse proto.InternalMessageInfo
+
+func (m *DeleteProjectResponse) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *DeleteProjectResponse) GetDeleted() int64 {
+	if m != nil {
+		return m.Deleted
+	}
+	return 0
+}
+
+func (m *DeleteProjectResponse) GetTrashed() int64 {
+	if m != nil {
+		return m.Trashed
+	}
+	return 0
+}
+
+// Request data to list projects
+type ListProjectsRequest struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api                  string   `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListProjectsRequest) Reset()         { *m = ListProjectsRequest{} }
+func (m *ListProjectsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListProjectsRequest) ProtoMessage()    {}
+func (*ListProjectsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{47}
+}
+
+func (m *ListProjectsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListProjectsRequest.Unmarshal(m, b)
+}
+func (m *ListProjectsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListProjectsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListProjectsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListProjectsRequest.Merge(m, src)
+}
+func (m *ListProjectsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListProjectsRequest.Size(m)
+}
+func (m *ListProjectsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListProjectsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListProjectsRequest proto.InternalMessageInfo
+
+func (m *ListProjectsRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+// Contains projects ordered by name
+type ListProjectsResponse struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// List of all projects
+	Projects             []*Project `protobuf:"bytes,2,rep,name=projects,proto3" json:"projects,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ListProjectsResponse) Reset()         { *m = ListProjectsResponse{} }
+func (m *ListProjectsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListProjectsResponse) ProtoMessage()    {}
+func (*ListProjectsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{48}
+}
+
+func (m *ListProjectsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListProjectsResponse.Unmarshal(m, b)
+}
+func (m *ListProjectsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListProjectsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListProjectsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListProjectsResponse.Merge(m, src)
+}
+func (m *ListProjectsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListProjectsResponse.Size(m)
+}
+func (m *ListProjectsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListProjectsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListProjectsResponse proto.InternalMessageInfo
+
+func (m *ListProjectsResponse) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *ListProjectsResponse) GetProjects() []*Project {
+	if m != nil {
+		return m.Projects
+	}
+	return nil
+}
+
+// Revision of todo task, new revision is recorded by every change of the task
+type Revision struct {
+	// Unique integer identifier of the todo task
+	ToDoId int64 `protobuf:"varint,1,opt,name=toDoId,proto3" json:"toDoId,omitempty"`
+	// Number of the revision, revisions of task are numbered from 1
+	Revision int64 `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	// Task as it was in the revision
+	ToDo *ToDo `protobuf:"bytes,3,opt,name=toDo,proto3" json:"toDo,omitempty"`
+	// Date and time the revision was recorded
+	CreateTime           *timestamp.Timestamp `protobuf:"bytes,4,opt,name=createTime,proto3" json:"createTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Revision) Reset()         { *m = Revision{} }
+func (m *Revision) String() string { return proto.CompactTextString(m) }
+func (*Revision) ProtoMessage()    {}
+func (*Revision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{49}
+}
+
+func (m *Revision) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Revision.Unmarshal(m, b)
+}
+func (m *Revision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Revision.Marshal(b, m, deterministic)
+}
+func (m *Revision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Revision.Merge(m, src)
+}
+func (m *Revision) XXX_Size() int {
+	return xxx_messageInfo_Revision.Size(m)
+}
+func (m *Revision) XXX_DiscardUnknown() {
+	xxx_messageInfo_Revision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Revision proto.InternalMessageInfo
+
+func (m *Revision) GetToDoId() int64 {
+	if m != nil {
+		return m.ToDoId
+	}
+	return 0
+}
+
+func (m *Revision) GetRevision() int64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+func (m *Revision) GetToDo() *ToDo {
+	if m != nil {
+		return m.ToDo
+	}
+	return nil
+}
+
+func (m *Revision) GetCreateTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreateTime
+	}
+	return nil
+}
+
+// Request data to list revisions of todo task
+type ListRevisionsRequest struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Unique integer identifier of the todo task
+	Id                   int64    `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListRevisionsRequest) Reset()         { *m = ListRevisionsRequest{} }
+func (m *ListRevisionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListRevisionsRequest) ProtoMessage()    {}
+func (*ListRevisionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{50}
+}
+
+func (m *ListRevisionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRevisionsRequest.Unmarshal(m, b)
+}
+func (m *ListRevisionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRevisionsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListRevisionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRevisionsRequest.Merge(m, src)
+}
+func (m *ListRevisionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListRevisionsRequest.Size(m)
+}
+func (m *ListRevisionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRevisionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListRevisionsRequest proto.InternalMessageInfo
+
+func (m *ListRevisionsRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *ListRevisionsRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// Contains revisions of todo task, newest first
+type ListRevisionsResponse struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Revisions of the task
+	Revisions []*Revision `protobuf:"bytes,2,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	// Task has more revisions than listed
+	Truncated            bool     `protobuf:"varint,3,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListRevisionsResponse) Reset()         { *m = ListRevisionsResponse{} }
+func (m *ListRevisionsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListRevisionsResponse) ProtoMessage()    {}
+func (*ListRevisionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{51}
+}
+
+func (m *ListRevisionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRevisionsResponse.Unmarshal(m, b)
+}
+func (m *ListRevisionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRevisionsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListRevisionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRevisionsResponse.Merge(m, src)
+}
+func (m *ListRevisionsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListRevisionsResponse.Size(m)
+}
+func (m *ListRevisionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRevisionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListRevisionsResponse proto.InternalMessageInfo
+
+func (m *ListRevisionsResponse) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *ListRevisionsResponse) GetRevisions() []*Revision {
+	if m != nil {
+		return m.Revisions
+	}
+	return nil
+}
+
+func (m *ListRevisionsResponse) GetTruncated() bool {
+	if m != nil {
+		return m.Truncated
+	}
+	return false
+}
+
+// Request data to read revision of todo task
+type GetRevisionRequest struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Unique integer identifier of the todo task
+	Id int64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// Number of the revision
+	Revision             int64    `protobuf:"varint,3,opt,name=revision,proto3" json:"revision,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetRevisionRequest) Reset()         { *m = GetRevisionRequest{} }
+func (m *GetRevisionRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRevisionRequest) ProtoMessage()    {}
+func (*GetRevisionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{52}
+}
+
+func (m *GetRevisionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRevisionRequest.Unmarshal(m, b)
+}
+func (m *GetRevisionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRevisionRequest.Marshal(b, m, deterministic)
+}
+func (m *GetRevisionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRevisionRequest.Merge(m, src)
+}
+func (m *GetRevisionRequest) XXX_Size() int {
+	return xxx_messageInfo_GetRevisionRequest.Size(m)
+}
+func (m *GetRevisionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRevisionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRevisionRequest proto.InternalMessageInfo
+
+func (m *GetRevisionRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *GetRevisionRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *GetRevisionRequest) GetRevision() int64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+// Contains revision of todo task
+type GetRevisionResponse struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Revision of the task
+	Revision             *Revision `protobuf:"bytes,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *GetRevisionResponse) Reset()         { *m = GetRevisionResponse{} }
+func (m *GetRevisionResponse) String() string { return proto.CompactTextString(m) }
+func (*GetRevisionResponse) ProtoMessage()    {}
+func (*GetRevisionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{53}
+}
+
+func (m *GetRevisionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRevisionResponse.Unmarshal(m, b)
+}
+func (m *GetRevisionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRevisionResponse.Marshal(b, m, deterministic)
+}
+func (m *GetRevisionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRevisionResponse.Merge(m, src)
+}
+func (m *GetRevisionResponse) XXX_Size() int {
+	return xxx_messageInfo_GetRevisionResponse.Size(m)
+}
+func (m *GetRevisionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRevisionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRevisionResponse proto.InternalMessageInfo
+
+func (m *GetRevisionResponse) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *GetRevisionResponse) GetRevision() *Revision {
+	if m != nil {
+		return m.Revision
+	}
+	return nil
+}
+
+// Request data to revert todo task to its revision
+type RestoreRevisionRequest struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Unique integer identifier of the todo task
+	Id int64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// Number of the revision to revert to
+	Revision             int64    `protobuf:"varint,3,opt,name=revision,proto3" json:"revision,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreRevisionRequest) Reset()         { *m = RestoreRevisionRequest{} }
+func (m *RestoreRevisionRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreRevisionRequest) ProtoMessage()    {}
+func (*RestoreRevisionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{54}
+}
+
+func (m *RestoreRevisionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreRevisionRequest.Unmarshal(m, b)
+}
+func (m *RestoreRevisionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreRevisionRequest.Marshal(b, m, deterministic)
+}
+func (m *RestoreRevisionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreRevisionRequest.Merge(m, src)
+}
+func (m *RestoreRevisionRequest) XXX_Size() int {
+	return xxx_messageInfo_RestoreRevisionRequest.Size(m)
+}
+func (m *RestoreRevisionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreRevisionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreRevisionRequest proto.InternalMessageInfo
+
+func (m *RestoreRevisionRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *RestoreRevisionRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *RestoreRevisionRequest) GetRevision() int64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+// Contains todo task reverted to its revision
+type RestoreRevisionResponse struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Reverted task
+	ToDo *ToDo `protobuf:"bytes,2,opt,name=toDo,proto3" json:"toDo,omitempty"`
+	// Number of the new revision recording the revert
+	Revision             int64    `protobuf:"varint,3,opt,name=revision,proto3" json:"revision,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreRevisionResponse) Reset()         { *m = RestoreRevisionResponse{} }
+func (m *RestoreRevisionResponse) String() string { return proto.CompactTextString(m) }
+func (*RestoreRevisionResponse) ProtoMessage()    {}
+func (*RestoreRevisionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{55}
+}
+
+func (m *RestoreRevisionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreRevisionResponse.Unmarshal(m, b)
+}
+func (m *RestoreRevisionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreRevisionResponse.Marshal(b, m, deterministic)
+}
+func (m *RestoreRevisionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreRevisionResponse.Merge(m, src)
+}
+func (m *RestoreRevisionResponse) XXX_Size() int {
+	return xxx_messageInfo_RestoreRevisionResponse.Size(m)
+}
+func (m *RestoreRevisionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreRevisionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreRevisionResponse proto.InternalMessageInfo
+
+func (m *RestoreRevisionResponse) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *RestoreRevisionResponse) GetToDo() *ToDo {
+	if m != nil {
+		return m.ToDo
+	}
+	return nil
+}
+
+func (m *RestoreRevisionResponse) GetRevision() int64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+// Audit record of mutation of todo task
+type AuditEvent struct {
+	// Unique integer identifier of the audit record
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Unique integer identifier of the changed todo task
+	ToDoId int64 `protobuf:"varint,2,opt,name=toDoId,proto3" json:"toDoId,omitempty"`
+	// Authenticated identity of the caller who made the change, e.g. common name of verified
+	// TLS client certificate, empty if the caller was not authenticated
+	Actor string `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	// Name of the RPC which made the change, e.g. Update
+	Rpc string `protobuf:"bytes,4,opt,name=rpc,proto3" json:"rpc,omitempty"`
+	// Unique identifier of the request which made the change
+	RequestId string `protobuf:"bytes,5,opt,name=requestId,proto3" json:"requestId,omitempty"`
+	// Task before the change, not set if task was created
+	Before *ToDo `protobuf:"bytes,6,opt,name=before,proto3" json:"before,omitempty"`
+	// Task after the change, not set if task was deleted
+	After *ToDo `protobuf:"bytes,7,opt,name=after,proto3" json:"after,omitempty"`
+	// Date and time of the change
+	Time *timestamp.Timestamp `protobuf:"bytes,8,opt,name=time,proto3" json:"time,omitempty"`
+	// Identity the caller declared in x-caller-id header or metadata. It is not verified,
+	// so it is set only if the caller was not authenticated
+	UnverifiedActor      string   `protobuf:"bytes,9,opt,name=unverifiedActor,proto3" json:"unverifiedActor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuditEvent) Reset()         { *m = AuditEvent{} }
+func (m *AuditEvent) String() string { return proto.CompactTextString(m) }
+func (*AuditEvent) ProtoMessage()    {}
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{56}
+}
+
+func (m *AuditEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditEvent.Unmarshal(m, b)
+}
+func (m *AuditEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditEvent.Marshal(b, m, deterministic)
+}
+func (m *AuditEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditEvent.Merge(m, src)
+}
+func (m *AuditEvent) XXX_Size() int {
+	return xxx_messageInfo_AuditEvent.Size(m)
+}
+func (m *AuditEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditEvent proto.InternalMessageInfo
+
+func (m *AuditEvent) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *AuditEvent) GetToDoId() int64 {
+	if m != nil {
+		return m.ToDoId
+	}
+	return 0
+}
+
+func (m *AuditEvent) GetActor() string {
+	if m != nil {
+		return m.Actor
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetRpc() string {
+	if m != nil {
+		return m.Rpc
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetBefore() *ToDo {
+	if m != nil {
+		return m.Before
+	}
+	return nil
+}
+
+func (m *AuditEvent) GetAfter() *ToDo {
+	if m != nil {
+		return m.After
+	}
+	return nil
+}
+
+func (m *AuditEvent) GetTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+func (m *AuditEvent) GetUnverifiedActor() string {
+	if m != nil {
+		return m.UnverifiedActor
+	}
+	return ""
+}
+
+// Request data to list audit records
+type ListAuditEventsRequest struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Unique integer identifier of the todo task, all tasks if 0
+	ToDoId int64 `protobuf:"varint,2,opt,name=toDoId,proto3" json:"toDoId,omitempty"`
+	// Authenticated identity of the caller, all callers if empty
+	Actor string `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	// Start of the time range, inclusive, no limit if not set
+	Start *timestamp.Timestamp `protobuf:"bytes,4,opt,name=start,proto3" json:"start,omitempty"`
+	// End of the time range, exclusive, no limit if not set
+	End *timestamp.Timestamp `protobuf:"bytes,5,opt,name=end,proto3" json:"end,omitempty"`
+	// Unverified identity declared by the caller, all callers if empty
+	UnverifiedActor      string   `protobuf:"bytes,6,opt,name=unverifiedActor,proto3" json:"unverifiedActor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListAuditEventsRequest) Reset()         { *m = ListAuditEventsRequest{} }
+func (m *ListAuditEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListAuditEventsRequest) ProtoMessage()    {}
+func (*ListAuditEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{57}
+}
+
+func (m *ListAuditEventsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAuditEventsRequest.Unmarshal(m, b)
+}
+func (m *ListAuditEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAuditEventsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListAuditEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAuditEventsRequest.Merge(m, src)
+}
+func (m *ListAuditEventsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListAuditEventsRequest.Size(m)
+}
+func (m *ListAuditEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAuditEventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAuditEventsRequest proto.InternalMessageInfo
+
+func (m *ListAuditEventsRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *ListAuditEventsRequest) GetToDoId() int64 {
+	if m != nil {
+		return m.ToDoId
+	}
+	return 0
+}
+
+func (m *ListAuditEventsRequest) GetActor() string {
+	if m != nil {
+		return m.Actor
+	}
+	return ""
+}
+
+func (m *ListAuditEventsRequest) GetStart() *timestamp.Timestamp {
+	if m != nil {
+		return m.Start
+	}
+	return nil
+}
+
+func (m *ListAuditEventsRequest) GetEnd() *timestamp.Timestamp {
+	if m != nil {
+		return m.End
+	}
+	return nil
+}
+
+func (m *ListAuditEventsRequest) GetUnverifiedActor() string {
+	if m != nil {
+		return m.UnverifiedActor
+	}
+	return ""
+}
+
+// Contains audit records, newest first
+type ListAuditEventsResponse struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Audit records
+	Events []*AuditEvent `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+	// Time range has more records than listed
+	Truncated            bool     `protobuf:"varint,3,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListAuditEventsResponse) Reset()         { *m = ListAuditEventsResponse{} }
+func (m *ListAuditEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListAuditEventsResponse) ProtoMessage()    {}
+func (*ListAuditEventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{58}
+}
+
+func (m *ListAuditEventsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAuditEventsResponse.Unmarshal(m, b)
+}
+func (m *ListAuditEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAuditEventsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListAuditEventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAuditEventsResponse.Merge(m, src)
+}
+func (m *ListAuditEventsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListAuditEventsResponse.Size(m)
+}
+func (m *ListAuditEventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAuditEventsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAuditEventsResponse proto.InternalMessageInfo
+
+func (m *ListAuditEventsResponse) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *ListAuditEventsResponse) GetEvents() []*AuditEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+func (m *ListAuditEventsResponse) GetTruncated() bool {
+	if m != nil {
+		return m.Truncated
+	}
+	return false
+}
+
+// Change of todo task
+type Event struct {
+	// Sequence number of the event, it grows by one with every change.
+	// Sequence starts at 1 when server starts
+	Sequence int64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// Kind of change
+	Type EventType `protobuf:"varint,2,opt,name=type,proto3,enum=v1.EventType" json:"type,omitempty"`
+	// Task after change, only ID is set for deleted task
+	ToDo *ToDo `protobuf:"bytes,3,opt,name=toDo,proto3" json:"toDo,omitempty"`
+	// Date and time of change
+	Time                 *timestamp.Timestamp `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Event) Reset()         { *m = Event{} }
+func (m *Event) String() string { return proto.CompactTextString(m) }
+func (*Event) ProtoMessage()    {}
+func (*Event) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{59}
+}
+
+func (m *Event) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Event.Unmarshal(m, b)
+}
+func (m *Event) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Event.Marshal(b, m, deterministic)
+}
+func (m *Event) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Event.Merge(m, src)
+}
+func (m *Event) XXX_Size() int {
+	return xxx_messageInfo_Event.Size(m)
+}
+func (m *Event) XXX_DiscardUnknown() {
+	xxx_messageInfo_Event.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Event proto.InternalMessageInfo
+
+func (m *Event) GetSequence() int64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *Event) GetType() EventType {
+	if m != nil {
+		return m.Type
+	}
+	return EventType_EVENT_TYPE_UNSPECIFIED
+}
+
+func (m *Event) GetToDo() *ToDo {
+	if m != nil {
+		return m.ToDo
+	}
+	return nil
+}
+
+func (m *Event) GetTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+// Request data to watch changes of todo tasks
+type WatchRequest struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Kinds of changes to watch, all kinds if empty
+	Types []EventType `protobuf:"varint,2,rep,packed,name=types,proto3,enum=v1.EventType" json:"types,omitempty"`
+	// IDs of tasks to watch, all tasks if empty
+	Ids []int64 `protobuf:"varint,3,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	// Resume watching after event with this sequence number, 0 means new events only.
+	// Over HTTP/REST the Last-Event-ID header of reconnecting server-sent events client is used if not set
+	AfterSequence        int64    `protobuf:"varint,4,opt,name=afterSequence,proto3" json:"afterSequence,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchRequest) Reset()         { *m = WatchRequest{} }
+func (m *WatchRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchRequest) ProtoMessage()    {}
+func (*WatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{60}
+}
+
+func (m *WatchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchRequest.Unmarshal(m, b)
+}
+func (m *WatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchRequest.Marshal(b, m, deterministic)
+}
+func (m *WatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchRequest.Merge(m, src)
+}
+func (m *WatchRequest) XXX_Size() int {
+	return xxx_messageInfo_WatchRequest.Size(m)
+}
+func (m *WatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchRequest proto.InternalMessageInfo
+
+func (m *WatchRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *WatchRequest) GetTypes() []EventType {
+	if m != nil {
+		return m.Types
+	}
+	return nil
+}
+
+func (m *WatchRequest) GetIds() []int64 {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
+func (m *WatchRequest) GetAfterSequence() int64 {
+	if m != nil {
+		return m.AfterSequence
+	}
+	return 0
+}
+
+// Contains change of todo task
+type WatchResponse struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Change of todo task
+	Event                *Event   `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchResponse) Reset()         { *m = WatchResponse{} }
+func (m *WatchResponse) String() string { return proto.CompactTextString(m) }
+func (*WatchResponse) ProtoMessage()    {}
+func (*WatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{61}
+}
+
+func (m *WatchResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchResponse.Unmarshal(m, b)
+}
+func (m *WatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchResponse.Marshal(b, m, deterministic)
+}
+func (m *WatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchResponse.Merge(m, src)
+}
+func (m *WatchResponse) XXX_Size() int {
+	return xxx_messageInfo_WatchResponse.Size(m)
+}
+func (m *WatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchResponse proto.InternalMessageInfo
+
+func (m *WatchResponse) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *WatchResponse) GetEvent() *Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("v1.BatchMode", BatchMode_name, BatchMode_value)
+	proto.RegisterEnum("v1.ImportAction", ImportAction_name, ImportAction_value)
+	proto.RegisterEnum("v1.ProjectDeleteMode", ProjectDeleteMode_name, ProjectDeleteMode_value)
+	proto.RegisterEnum("v1.EventType", EventType_name, EventType_value)
+	proto.RegisterType((*ToDo)(nil), "v1.ToDo")
+	proto.RegisterType((*Tag)(nil), "v1.Tag")
+	proto.RegisterType((*Project)(nil), "v1.Project")
+	proto.RegisterType((*CreateRequest)(nil), "v1.CreateRequest")
+	proto.RegisterType((*CreateResponse)(nil), "v1.CreateResponse")
+	proto.RegisterType((*ReadRequest)(nil), "v1.ReadRequest")
+	proto.RegisterType((*ReadResponse)(nil), "v1.ReadResponse")
+	proto.RegisterType((*UpdateRequest)(nil), "v1.UpdateRequest")
+	proto.RegisterType((*UpdateResponse)(nil), "v1.UpdateResponse")
+	proto.RegisterType((*DeleteRequest)(nil), "v1.DeleteRequest")
+	proto.RegisterType((*DeleteResponse)(nil), "v1.DeleteResponse")
+	proto.RegisterType((*UndeleteRequest)(nil), "v1.UndeleteRequest")
+	proto.RegisterType((*UndeleteResponse)(nil), "v1.UndeleteResponse")
+	proto.RegisterType((*ListDeletedRequest)(nil), "v1.ListDeletedRequest")
+	proto.RegisterType((*ListDeletedResponse)(nil), "v1.ListDeletedResponse")
+	proto.RegisterType((*ReadAllRequest)(nil), "v1.ReadAllRequest")
+	proto.RegisterType((*ReadAllResponse)(nil), "v1.ReadAllResponse")
+	proto.RegisterType((*BatchResult)(nil), "v1.BatchResult")
+	proto.RegisterType((*BatchCreateRequest)(nil), "v1.BatchCreateRequest")
+	proto.RegisterType((*BatchCreateResponse)(nil), "v1.BatchCreateResponse")
+	proto.RegisterType((*BatchUpdateRequest)(nil), "v1.BatchUpdateRequest")
+	proto.RegisterType((*BatchUpdateResponse)(nil), "v1.BatchUpdateResponse")
+	proto.RegisterType((*BatchDeleteRequest)(nil), "v1.BatchDeleteRequest")
+	proto.RegisterType((*BatchDeleteResponse)(nil), "v1.BatchDeleteResponse")
+	proto.RegisterType((*ImportRequest)(nil), "v1.ImportRequest")
+	proto.RegisterType((*ImportError)(nil), "v1.ImportError")
+	proto.RegisterType((*ImportResponse)(nil), "v1.ImportResponse")
+	proto.RegisterType((*ExportRequest)(nil), "v1.ExportRequest")
+	proto.RegisterType((*CSVColumns)(nil), "v1.CSVColumns")
+	proto.RegisterType((*ImportFileRequest)(nil), "v1.ImportFileRequest")
+	proto.RegisterType((*ImportFileResult)(nil), "v1.ImportFileResult")
+	proto.RegisterType((*ImportFileResponse)(nil), "v1.ImportFileResponse")
+	proto.RegisterType((*CompleteRequest)(nil), "v1.CompleteRequest")
+	proto.RegisterType((*CompleteResponse)(nil), "v1.CompleteResponse")
+	proto.RegisterType((*ListOccurrencesRequest)(nil), "v1.ListOccurrencesRequest")
+	proto.RegisterType((*Occurrence)(nil), "v1.Occurrence")
+	proto.RegisterType((*ListOccurrencesResponse)(nil), "v1.ListOccurrencesResponse")
+	proto.RegisterType((*ListTagsRequest)(nil), "v1.ListTagsRequest")
+	proto.RegisterType((*ListTagsResponse)(nil), "v1.ListTagsResponse")
+	proto.RegisterType((*CreateProjectRequest)(nil), "v1.CreateProjectRequest")
+	proto.RegisterType((*CreateProjectResponse)(nil), "v1.CreateProjectResponse")
+	proto.RegisterType((*ReadProjectRequest)(nil), "v1.ReadProjectRequest")
+	proto.RegisterType((*ReadProjectResponse)(nil), "v1.ReadProjectResponse")
+	proto.RegisterType((*UpdateProjectRequest)(nil), "v1.UpdateProjectRequest")
+	proto.RegisterType((*UpdateProjectResponse)(nil), "v1.UpdateProjectResponse")
+	proto.RegisterType((*DeleteProjectRequest)(nil), "v1.DeleteProjectRequest")
+	proto.RegisterType((*DeleteProjectResponse)(nil), "v1.DeleteProjectResponse")
+	proto.RegisterType((*ListProjectsRequest)(nil), "v1.ListProjectsRequest")
+	proto.RegisterType((*ListProjectsResponse)(nil), "v1.ListProjectsResponse")
+	proto.RegisterType((*Revision)(nil), "v1.Revision")
+	proto.RegisterType((*ListRevisionsRequest)(nil), "v1.ListRevisionsRequest")
+	proto.RegisterType((*ListRevisionsResponse)(nil), "v1.ListRevisionsResponse")
+	proto.RegisterType((*GetRevisionRequest)(nil), "v1.GetRevisionRequest")
+	proto.RegisterType((*GetRevisionResponse)(nil), "v1.GetRevisionResponse")
+	proto.RegisterType((*RestoreRevisionRequest)(nil), "v1.RestoreRevisionRequest")
+	proto.RegisterType((*RestoreRevisionResponse)(nil), "v1.RestoreRevisionResponse")
+	proto.RegisterType((*AuditEvent)(nil), "v1.AuditEvent")
+	proto.RegisterType((*ListAuditEventsRequest)(nil), "v1.ListAuditEventsRequest")
+	proto.RegisterType((*ListAuditEventsResponse)(nil), "v1.ListAuditEventsResponse")
+	proto.RegisterType((*Event)(nil), "v1.Event")
+	proto.RegisterType((*WatchRequest)(nil), "v1.WatchRequest")
+	proto.RegisterType((*WatchResponse)(nil), "v1.WatchResponse")
+}
+
+func init() { proto.RegisterFile("todo-service.proto", fileDescriptor_80b701c7b1c502fe) }
+
+var fileDescriptor_80b701c7b1c502fe = []byte{
+	// 2946 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x1a, 0xcb, 0x72, 0x1b, 0xc7,
+	0xd1, 0x8b, 0x17, 0x81, 0x06, 0x41, 0x82, 0x43, 0x10, 0x58, 0xac, 0x14, 0x19, 0x5e, 0x3b, 0x36,
+	0x8d, 0x98, 0x00, 0x45, 0x2b, 0x2e, 0x17, 0xed, 0xb2, 0x45, 0x12, 0x90, 0xcd, 0x58, 0xa2, 0xe8,
+	0x25, 0xe4, 0x87, 0x5c, 0x0e, 0xb2, 0xc4, 0x8e, 0xc0, 0x95, 0x01, 0x2c, 0xbc, 0xbb, 0xa0, 0xa4,
+	0xa8, 0x54, 0x79, 0x1c, 0x52, 0x89, 0x8f, 0x4e, 0x2a, 0x95, 0x72, 0xb9, 0x2a, 0xf7, 0x5c, 0x72,
+	0xca, 0x81, 0x3c, 0xe4, 0x1f, 0x52, 0xf9, 0x83, 0x54, 0x8e, 0xf9, 0x88, 0xd4, 0xbc, 0xf6, 0x05,
+	0x80, 0x20, 0x69, 0x9d, 0x88, 0xe9, 0xee, 0xe9, 0xd7, 0xf4, 0xf4, 0x74, 0xf7, 0x12, 0x90, 0x6b,
+	0x19, 0xd6, 0x9a, 0x83, 0xed, 0x63, 0xb3, 0x83, 0x6b, 0x43, 0xdb, 0x72, 0x2d, 0x14, 0x3b, 0xbe,
+	0xae, 0xbc, 0xd8, 0xb5, 0xac, 0x6e, 0x0f, 0xd7, 0x29, 0xe4, 0x70, 0xf4, 0xa0, 0xee, 0x9a, 0x7d,
+	0xec, 0xb8, 0x7a, 0x7f, 0xc8, 0x88, 0x94, 0xab, 0x9c, 0x40, 0x1f, 0x9a, 0x75, 0x7d, 0x30, 0xb0,
+	0x5c, 0xdd, 0x35, 0xad, 0x81, 0xc3, 0xb1, 0xe5, 0x00, 0xf6, 0xc8, 0x75, 0x87, 0x87, 0x96, 0xf1,
+	0x84, 0xa3, 0x4a, 0x1c, 0x65, 0x0f, 0x3b, 0x75, 0xc7, 0xd5, 0xdd, 0x91, 0xd8, 0xf3, 0x06, 0xfd,
+	0xd3, 0x59, 0xeb, 0xe2, 0xc1, 0x9a, 0xf3, 0x48, 0xef, 0x76, 0xb1, 0x5d, 0xb7, 0x86, 0x94, 0xeb,
+	0x04, 0x09, 0x6a, 0x80, 0xba, 0x6b, 0xd9, 0x7d, 0x8f, 0x94, 0x2c, 0x38, 0x4d, 0xae, 0x67, 0x75,
+	0xeb, 0x3d, 0xab, 0xcb, 0x96, 0xea, 0xff, 0xe2, 0x90, 0x68, 0x59, 0x0d, 0x0b, 0x2d, 0x40, 0xcc,
+	0x34, 0x64, 0xa9, 0x22, 0xad, 0xc6, 0xb5, 0x98, 0x69, 0x20, 0x05, 0x92, 0xae, 0xe9, 0xf6, 0xb0,
+	0x1c, 0xab, 0x48, 0xab, 0x99, 0xed, 0xc4, 0xef, 0xff, 0x21, 0x4b, 0x1a, 0x03, 0xa1, 0x57, 0x21,
+	0x6b, 0x60, 0xa7, 0x63, 0x9b, 0x94, 0xbd, 0x1c, 0x0f, 0x50, 0x04, 0x11, 0xe8, 0x2d, 0x48, 0xdb,
+	0xb8, 0x6f, 0x0e, 0x0c, 0x6c, 0xcb, 0x89, 0x8a, 0xb4, 0x9a, 0xdd, 0x50, 0x6a, 0xcc, 0xd2, 0x9a,
+	0xf0, 0x61, 0xad, 0x25, 0x7c, 0xa8, 0x79, 0xb4, 0xe8, 0x3d, 0x00, 0xfc, 0xd8, 0xc5, 0xf6, 0x40,
+	0xef, 0xed, 0x1a, 0x72, 0x92, 0xb2, 0xbf, 0x76, 0x7a, 0x52, 0x56, 0x40, 0xd6, 0x8a, 0xa6, 0xf1,
+	0xb8, 0xed, 0x5a, 0x6d, 0xc3, 0x72, 0xda, 0x82, 0xa8, 0x6d, 0x1a, 0x5a, 0x60, 0x07, 0xba, 0x06,
+	0x60, 0xe3, 0xce, 0xc8, 0xb6, 0xf1, 0xa0, 0x83, 0xe5, 0x14, 0xd9, 0xaf, 0x05, 0x20, 0x08, 0x41,
+	0xc2, 0xb0, 0x06, 0x58, 0x9e, 0xab, 0x48, 0xab, 0x69, 0x8d, 0xfe, 0x46, 0x77, 0x20, 0x63, 0xe0,
+	0x1e, 0x76, 0xb1, 0xb1, 0xe5, 0xca, 0xe9, 0x59, 0xca, 0x6e, 0x2f, 0x9f, 0x9e, 0x94, 0x17, 0x21,
+	0x07, 0x59, 0xb6, 0xa9, 0x4d, 0x42, 0x41, 0xf3, 0x39, 0x20, 0x05, 0x12, 0xae, 0xde, 0x75, 0xe4,
+	0x4c, 0x25, 0xbe, 0x9a, 0xd9, 0x4e, 0x9d, 0x9e, 0x94, 0x63, 0x79, 0x49, 0xa3, 0x30, 0xf4, 0x0e,
+	0x64, 0x86, 0xb6, 0xf5, 0x10, 0x77, 0xdc, 0x5d, 0x43, 0x06, 0xe2, 0xf1, 0xed, 0x1f, 0x9d, 0x9e,
+	0x94, 0xcb, 0x50, 0xd2, 0x56, 0x02, 0xd6, 0x71, 0x1a, 0x62, 0x9c, 0x4f, 0xbf, 0xf9, 0xee, 0xe9,
+	0x49, 0xf9, 0xed, 0xb4, 0x84, 0x6e, 0x40, 0xe6, 0x8b, 0x2f, 0xab, 0x2d, 0xbd, 0x7b, 0x57, 0xbb,
+	0x83, 0x12, 0x2d, 0xbd, 0xeb, 0x28, 0x2f, 0x7d, 0x23, 0x01, 0xdd, 0xdb, 0x26, 0x62, 0xbe, 0x95,
+	0xd2, 0x6c, 0x61, 0x1a, 0xdf, 0x49, 0x29, 0x57, 0xef, 0xb6, 0x4d, 0x43, 0x3d, 0x82, 0x78, 0x4b,
+	0xef, 0x8e, 0x1d, 0xf6, 0x4f, 0x20, 0x31, 0xd0, 0xfb, 0xe2, 0xac, 0x4b, 0xa7, 0x27, 0xe5, 0x65,
+	0x58, 0xba, 0x29, 0xdd, 0xcf, 0x51, 0x75, 0xf4, 0xae, 0xd3, 0x26, 0x68, 0x8d, 0x12, 0xa1, 0xab,
+	0x90, 0xec, 0x58, 0xa3, 0x81, 0x4b, 0xcf, 0x3d, 0xee, 0xd9, 0xc6, 0x80, 0x9b, 0x74, 0x99, 0x96,
+	0xd4, 0x5f, 0xc2, 0xdc, 0x3e, 0x53, 0x7a, 0x4c, 0x5a, 0x3d, 0x24, 0xed, 0xca, 0xe9, 0x49, 0xb9,
+	0x04, 0x2b, 0x37, 0xa5, 0xfb, 0x4b, 0x44, 0x1a, 0xb7, 0x34, 0x24, 0xf1, 0x9c, 0xf1, 0xe6, 0xc9,
+	0x7e, 0x1f, 0x72, 0x3b, 0x36, 0xd6, 0x5d, 0xac, 0xe1, 0xaf, 0x47, 0xd8, 0x71, 0x51, 0x1e, 0xe2,
+	0xfa, 0xd0, 0xa4, 0x2a, 0x64, 0x34, 0xf2, 0x13, 0x5d, 0x85, 0x84, 0x6b, 0x35, 0x2c, 0xaa, 0x43,
+	0x76, 0x23, 0x5d, 0x3b, 0xbe, 0x5e, 0x23, 0xd7, 0x40, 0xa3, 0x50, 0x75, 0x03, 0x16, 0x04, 0x03,
+	0x67, 0x68, 0x0d, 0x1c, 0x3c, 0x81, 0x03, 0xb3, 0x2a, 0x26, 0xac, 0x52, 0x3f, 0x86, 0xac, 0x86,
+	0x75, 0x63, 0xba, 0xc8, 0xc8, 0x06, 0x54, 0x81, 0xac, 0x73, 0x64, 0x3d, 0x6a, 0xb0, 0x98, 0xa1,
+	0x56, 0xa5, 0xb5, 0x20, 0x48, 0x7d, 0x0f, 0xe6, 0x19, 0xcb, 0xa9, 0x4a, 0x9c, 0x6d, 0xc6, 0xfb,
+	0x90, 0xbb, 0x37, 0x34, 0x7e, 0x80, 0x1f, 0xde, 0x85, 0x05, 0xc1, 0x60, 0xaa, 0x0a, 0x32, 0xcc,
+	0x8d, 0x28, 0x8d, 0xb0, 0x4d, 0x2c, 0xd5, 0xeb, 0x90, 0x63, 0x96, 0x9c, 0xdb, 0x27, 0x44, 0xa0,
+	0xd8, 0x72, 0x96, 0x40, 0x7e, 0xcf, 0x84, 0x40, 0xbe, 0x54, 0xdf, 0x84, 0xc5, 0x7b, 0x03, 0xe3,
+	0x82, 0x22, 0xb7, 0x21, 0xef, 0x6f, 0xba, 0xa4, 0xa3, 0x5f, 0x05, 0x74, 0xdb, 0x74, 0x5c, 0x7e,
+	0x6e, 0x53, 0x65, 0xab, 0x1f, 0xc0, 0x72, 0x88, 0x6e, 0xaa, 0xb8, 0x6b, 0x90, 0x24, 0x8c, 0x1d,
+	0x39, 0x56, 0x89, 0x87, 0xe4, 0x31, 0xb0, 0x7a, 0x0c, 0x0b, 0x24, 0x32, 0xb6, 0x7a, 0xbd, 0xe9,
+	0x86, 0x46, 0xe2, 0x2b, 0x36, 0x16, 0x5f, 0x24, 0x0f, 0xd2, 0x24, 0x15, 0x27, 0x49, 0x8a, 0x27,
+	0xa7, 0xab, 0xc1, 0xe4, 0x94, 0xa0, 0x5e, 0xf2, 0x01, 0xea, 0x0e, 0x2c, 0x7a, 0x72, 0x2f, 0xad,
+	0xfc, 0x2e, 0x64, 0xb7, 0x75, 0xb7, 0x73, 0xa4, 0x61, 0x67, 0xd4, 0x1b, 0x4f, 0x0f, 0x55, 0x48,
+	0xb1, 0x37, 0x90, 0x3b, 0x1b, 0x89, 0x34, 0x6c, 0x0f, 0x3b, 0xb5, 0x03, 0x8a, 0xd1, 0x38, 0x85,
+	0x6a, 0x02, 0xa2, 0xac, 0x66, 0x5d, 0xf7, 0x19, 0x2a, 0xa1, 0x97, 0x20, 0xd1, 0xb7, 0x0c, 0x4c,
+	0x2f, 0xe1, 0xc2, 0x46, 0x8e, 0xa0, 0x29, 0xdf, 0x3b, 0x96, 0x81, 0x35, 0x8a, 0x52, 0x7b, 0xb0,
+	0x1c, 0x12, 0x75, 0x56, 0x7c, 0x76, 0x28, 0x8d, 0x17, 0x9f, 0x7c, 0x89, 0x5e, 0x87, 0x39, 0x9b,
+	0xda, 0xcc, 0x5c, 0x9e, 0xdd, 0x58, 0xf4, 0x04, 0x31, 0x5f, 0x68, 0x02, 0xef, 0x19, 0x36, 0xeb,
+	0xfe, 0x3e, 0x47, 0xc3, 0x2e, 0x7f, 0xd3, 0x2f, 0x62, 0xd8, 0x17, 0xdc, 0xb0, 0x59, 0x99, 0x21,
+	0x0f, 0x71, 0xd3, 0x60, 0x66, 0xc5, 0x35, 0xf2, 0xf3, 0x22, 0xa6, 0x5c, 0x3e, 0x87, 0x5c, 0xc4,
+	0x94, 0xf7, 0x21, 0xb7, 0xdb, 0x1f, 0x5a, 0xb6, 0x7b, 0xd9, 0xf4, 0xfa, 0x11, 0x64, 0x19, 0x83,
+	0xa6, 0x6d, 0x5b, 0x36, 0xd9, 0x6e, 0x5b, 0x8f, 0xf8, 0x4d, 0x20, 0x3f, 0x2f, 0x74, 0x15, 0x7e,
+	0x05, 0x0b, 0x42, 0x9b, 0xa9, 0x66, 0x2b, 0x90, 0x36, 0x07, 0x0e, 0xb6, 0x7d, 0xbb, 0xbd, 0x35,
+	0x2a, 0x42, 0xea, 0x81, 0x6e, 0xf6, 0xf8, 0x4b, 0x14, 0xd7, 0xf8, 0x0a, 0xbd, 0x06, 0x29, 0x4c,
+	0xd4, 0x73, 0xe4, 0x84, 0xef, 0x8f, 0x80, 0xda, 0x1a, 0x47, 0xab, 0xff, 0x92, 0x20, 0xd7, 0x7c,
+	0x7c, 0xb6, 0x3f, 0x88, 0x10, 0xcb, 0xee, 0xeb, 0x2e, 0x7b, 0xfc, 0x35, 0xbe, 0x12, 0xa7, 0x1d,
+	0xf7, 0x4f, 0xfb, 0x26, 0xe4, 0x44, 0x3d, 0xb8, 0xf5, 0xc0, 0x3d, 0x57, 0x01, 0x19, 0xde, 0x80,
+	0xb6, 0x61, 0x41, 0x00, 0xb6, 0xf1, 0x03, 0xcb, 0xc6, 0x72, 0x72, 0x26, 0x8b, 0xc8, 0x0e, 0xf5,
+	0xd7, 0x12, 0xc0, 0xce, 0xc1, 0x27, 0x3b, 0x56, 0x6f, 0xd4, 0x1f, 0x38, 0xa8, 0x20, 0x8a, 0x62,
+	0x66, 0x12, 0x5b, 0x90, 0x44, 0x1b, 0x2c, 0x4f, 0x98, 0x65, 0x41, 0x10, 0xf1, 0xbb, 0x60, 0xcc,
+	0xaa, 0x97, 0x40, 0xb1, 0x7b, 0x2d, 0x54, 0xec, 0x26, 0x28, 0x36, 0x00, 0x51, 0xff, 0x2e, 0xc1,
+	0x12, 0x73, 0xf7, 0x2d, 0xb3, 0x87, 0x2f, 0xee, 0xda, 0x1a, 0x24, 0x1e, 0x98, 0x3d, 0x76, 0x6d,
+	0xb2, 0x1b, 0x05, 0x61, 0xbc, 0x3e, 0x34, 0x6b, 0x1f, 0xba, 0xee, 0x70, 0xdb, 0x32, 0x9e, 0xf0,
+	0x5a, 0x8a, 0xd2, 0xa1, 0x55, 0x98, 0xeb, 0x30, 0x73, 0xb9, 0xcb, 0x17, 0xc8, 0x81, 0xfb, 0x4e,
+	0xd0, 0x04, 0x9a, 0x48, 0x34, 0xec, 0x27, 0xda, 0x68, 0x40, 0x1d, 0x9b, 0xd6, 0xf8, 0x4a, 0xfd,
+	0x9b, 0x04, 0xf9, 0xa0, 0xc6, 0x34, 0xcb, 0x8f, 0x07, 0xf7, 0x2a, 0xa4, 0xf4, 0x8e, 0xe7, 0xb1,
+	0x85, 0x8d, 0xbc, 0x1f, 0x58, 0x5b, 0x14, 0xae, 0x71, 0x3c, 0x7f, 0x21, 0xe2, 0xde, 0x0b, 0x31,
+	0xc3, 0x65, 0x81, 0x6b, 0x93, 0x9c, 0x79, 0x6d, 0xfe, 0x29, 0x01, 0x0a, 0x29, 0x3b, 0xed, 0xee,
+	0xf8, 0xd6, 0xc6, 0x82, 0xd6, 0x06, 0xd3, 0x7d, 0x3c, 0x9c, 0xee, 0x65, 0x98, 0x73, 0xbe, 0x32,
+	0x87, 0x43, 0x2c, 0x1e, 0x52, 0xb1, 0x64, 0xf1, 0x40, 0x9e, 0x54, 0xcc, 0xda, 0x9b, 0xb8, 0xe6,
+	0xad, 0x51, 0xcd, 0x4f, 0x40, 0x29, 0x7a, 0xe1, 0x0a, 0xbe, 0x5f, 0x7c, 0x7f, 0xfa, 0x59, 0xe8,
+	0x4d, 0x58, 0xdc, 0xb1, 0xfa, 0xc3, 0x8b, 0x15, 0x3d, 0xbf, 0x80, 0xbc, 0xbf, 0xe9, 0x72, 0x45,
+	0x0f, 0xc1, 0x0e, 0xf0, 0x63, 0x57, 0x8e, 0x47, 0xb1, 0x04, 0xaa, 0x7e, 0x2f, 0x41, 0x91, 0xd4,
+	0x3a, 0x77, 0x3b, 0xa2, 0xed, 0x72, 0xa6, 0xab, 0xb7, 0x0e, 0x49, 0xc7, 0xd5, 0x6d, 0x57, 0x8e,
+	0xcd, 0xbc, 0xa1, 0x8c, 0x10, 0xbd, 0x01, 0x71, 0x3c, 0x30, 0xe4, 0xf8, 0x4c, 0x7a, 0x42, 0x26,
+	0xd2, 0x4b, 0xc2, 0x4b, 0x2f, 0xea, 0x11, 0x80, 0xaf, 0xd9, 0x85, 0x9a, 0xdf, 0x1a, 0x24, 0x48,
+	0xb3, 0x77, 0x0e, 0xd1, 0x94, 0x4e, 0x7d, 0x0a, 0xa5, 0x31, 0x3f, 0x4c, 0xf5, 0xf8, 0x3a, 0x64,
+	0x2d, 0x9f, 0x90, 0x3f, 0xea, 0xf4, 0x02, 0xfa, 0xfb, 0xb5, 0x20, 0x09, 0xa9, 0xd7, 0x5c, 0x7b,
+	0x34, 0xe8, 0xe8, 0x7e, 0x0f, 0xe1, 0x03, 0xd4, 0x97, 0x61, 0x91, 0x08, 0x27, 0xed, 0xe1, 0xf4,
+	0xaa, 0x74, 0x0b, 0xf2, 0x3e, 0xd1, 0x54, 0xd5, 0xae, 0xf0, 0x62, 0x91, 0xe9, 0x34, 0x47, 0x8f,
+	0x5b, 0xef, 0xb2, 0xaa, 0x51, 0xbd, 0x0b, 0x05, 0x56, 0x17, 0xf1, 0x9e, 0x6f, 0xfa, 0x51, 0xff,
+	0x18, 0xe6, 0x78, 0x39, 0xc9, 0x0f, 0x3b, 0x4b, 0x38, 0x89, 0x6d, 0x02, 0xa7, 0xee, 0xc3, 0x4a,
+	0x84, 0xe1, 0x54, 0xc5, 0xce, 0xc9, 0xf1, 0x2d, 0x40, 0xa4, 0x74, 0x9d, 0xa9, 0x60, 0xf4, 0xaa,
+	0xec, 0xc1, 0x72, 0x68, 0xdf, 0x0f, 0xd5, 0xe3, 0x2e, 0x14, 0x58, 0xa5, 0xf5, 0x1c, 0x5d, 0x15,
+	0x61, 0xf8, 0x43, 0x55, 0xec, 0x40, 0x81, 0x55, 0x50, 0x17, 0x75, 0x16, 0x7a, 0x3d, 0x54, 0xa3,
+	0xad, 0x04, 0xb8, 0x33, 0x86, 0x81, 0x5a, 0xed, 0x4b, 0x58, 0x89, 0x08, 0xb9, 0x44, 0xb5, 0x26,
+	0xc3, 0x9c, 0x6b, 0xeb, 0xce, 0x91, 0x9f, 0x7c, 0xf9, 0x52, 0x7d, 0x8d, 0xb5, 0x5a, 0x9c, 0xf9,
+	0x19, 0xd1, 0xff, 0x31, 0x14, 0xc2, 0x84, 0x53, 0xd5, 0x78, 0x0d, 0xd2, 0x62, 0x3a, 0xc1, 0x6f,
+	0x41, 0xc8, 0x7d, 0x1e, 0x52, 0xfd, 0x8b, 0x04, 0x69, 0x0d, 0x1f, 0x9b, 0x0e, 0x79, 0xbc, 0x8a,
+	0x90, 0x22, 0xe9, 0x72, 0x57, 0xe4, 0x17, 0xbe, 0x62, 0x6f, 0x00, 0xa3, 0x11, 0xb5, 0x98, 0x58,
+	0x7b, 0x89, 0x37, 0x3e, 0x31, 0xf1, 0x6e, 0x02, 0xb0, 0x27, 0x86, 0xa4, 0x9a, 0x73, 0xd4, 0x45,
+	0x01, 0x6a, 0xf5, 0x6d, 0x66, 0xad, 0xd0, 0xce, 0x39, 0xff, 0x3d, 0x70, 0x60, 0x25, 0xb2, 0x73,
+	0xaa, 0xa3, 0xaa, 0x90, 0x11, 0xa6, 0x08, 0x4f, 0xcd, 0x13, 0x1b, 0xc4, 0x5e, 0xcd, 0x47, 0xcf,
+	0xc8, 0x5f, 0x1a, 0xa0, 0x0f, 0xb0, 0x27, 0xf3, 0xfc, 0x71, 0x18, 0x74, 0x6e, 0x3c, 0xec, 0x5c,
+	0xf5, 0x63, 0x58, 0x0e, 0xf1, 0x9c, 0x6a, 0xc6, 0x6a, 0xe4, 0x84, 0xa2, 0x56, 0xf8, 0x2c, 0x3f,
+	0x81, 0xa2, 0x86, 0x1d, 0xd7, 0xb2, 0xf1, 0xf3, 0x55, 0x15, 0x43, 0x69, 0x8c, 0xef, 0x25, 0x5f,
+	0xeb, 0xb3, 0xc4, 0xfc, 0x39, 0x06, 0xb0, 0x35, 0x32, 0x4c, 0xb7, 0x79, 0x8c, 0x07, 0xe3, 0x0d,
+	0xb9, 0x1f, 0xc1, 0xb1, 0x50, 0x04, 0x17, 0x20, 0xa9, 0x77, 0x5c, 0x4b, 0x94, 0xb4, 0x6c, 0x41,
+	0x0b, 0xbd, 0x61, 0x87, 0x57, 0x65, 0xe4, 0x27, 0x39, 0x62, 0x9b, 0xb9, 0x43, 0x4c, 0x73, 0x35,
+	0x1f, 0x80, 0x2a, 0x90, 0x3a, 0x64, 0xe5, 0x79, 0x2a, 0xa2, 0x38, 0x87, 0x93, 0x1e, 0x57, 0xa7,
+	0x2d, 0xc0, 0x5c, 0x84, 0x80, 0x81, 0xbd, 0x17, 0x39, 0x7d, 0xbe, 0x17, 0x19, 0xad, 0xc2, 0xe2,
+	0x68, 0x70, 0x8c, 0x6d, 0xf3, 0x81, 0x89, 0x8d, 0x2d, 0x6a, 0x41, 0x86, 0x6a, 0x15, 0x05, 0xab,
+	0xff, 0xe1, 0x45, 0x8c, 0xef, 0x1c, 0xe7, 0xcc, 0x02, 0xfc, 0x02, 0x6e, 0xf2, 0x4a, 0x9e, 0xc4,
+	0x05, 0x4b, 0x9e, 0xe4, 0xf9, 0x4a, 0x9e, 0x09, 0x46, 0xa6, 0x26, 0x1b, 0xf9, 0x35, 0x94, 0xc6,
+	0x6c, 0x9c, 0x1a, 0x64, 0xaf, 0x42, 0x0a, 0x53, 0x9a, 0x60, 0x6d, 0xe2, 0x6f, 0xd5, 0x38, 0x76,
+	0xc6, 0xb5, 0xfe, 0x93, 0x04, 0x49, 0x16, 0x6b, 0x0a, 0xa4, 0x1d, 0xe2, 0x51, 0x32, 0xa8, 0x67,
+	0x11, 0xe7, 0xad, 0x49, 0xc3, 0xef, 0x3e, 0x19, 0x62, 0x39, 0xe6, 0x37, 0xfc, 0x74, 0x53, 0xeb,
+	0xc9, 0x10, 0x6b, 0x14, 0x35, 0x23, 0x51, 0x8a, 0xc0, 0x48, 0x9c, 0xb3, 0x54, 0x7b, 0x06, 0xf3,
+	0x9f, 0xb2, 0x46, 0x7f, 0xda, 0x19, 0xbf, 0x0c, 0x49, 0x22, 0x97, 0x59, 0x3f, 0xa6, 0x13, 0xc3,
+	0x4d, 0x68, 0x66, 0x5f, 0x81, 0x1c, 0x0d, 0xd5, 0x03, 0x61, 0x2a, 0xeb, 0x07, 0xc2, 0x40, 0x75,
+	0x1b, 0x72, 0x5c, 0xfc, 0x54, 0xf7, 0xbf, 0x08, 0x49, 0xea, 0x60, 0x7e, 0xc9, 0x33, 0x9e, 0x7c,
+	0x8d, 0xc1, 0xab, 0x6b, 0x90, 0xf1, 0x86, 0x22, 0x08, 0xc1, 0xc2, 0xd6, 0xed, 0xdb, 0xed, 0xbb,
+	0x5a, 0x7b, 0xef, 0x6e, 0xeb, 0xc3, 0xdd, 0xbd, 0x0f, 0xf2, 0x2f, 0xa0, 0x79, 0x48, 0xef, 0x37,
+	0xb5, 0xf6, 0x6e, 0xab, 0x79, 0x27, 0x2f, 0x55, 0xd7, 0x61, 0x3e, 0xd8, 0x71, 0x21, 0x80, 0xd4,
+	0x8e, 0xd6, 0xdc, 0x6a, 0x35, 0xf3, 0x2f, 0xa0, 0x34, 0x24, 0x0e, 0x3e, 0xda, 0xdd, 0xcf, 0x4b,
+	0x04, 0xaa, 0x35, 0x7f, 0xd6, 0xdc, 0x69, 0xe5, 0x63, 0xd5, 0x1a, 0x2c, 0x8d, 0xbd, 0xe8, 0x84,
+	0xa9, 0xd6, 0x3c, 0x68, 0x69, 0xbb, 0x3b, 0xad, 0xfc, 0x0b, 0x28, 0x0b, 0x73, 0x3b, 0x5b, 0x07,
+	0x3b, 0x5b, 0x8d, 0x66, 0x5e, 0xaa, 0x7e, 0x05, 0x19, 0xcf, 0x41, 0x48, 0x81, 0x62, 0xf3, 0x93,
+	0xe6, 0x5e, 0xab, 0xdd, 0xfa, 0x7c, 0xbf, 0xd9, 0xbe, 0xb7, 0x77, 0xb0, 0xdf, 0xdc, 0xd9, 0xbd,
+	0xb5, 0xdb, 0x6c, 0xf0, 0x5d, 0x54, 0x74, 0x23, 0x2f, 0x91, 0xc5, 0xbd, 0xfd, 0x06, 0x5d, 0xc4,
+	0xc8, 0xa2, 0xd1, 0xbc, 0xdd, 0x24, 0x8b, 0x38, 0x13, 0x75, 0x67, 0x77, 0xaf, 0xd1, 0x6c, 0xe4,
+	0x13, 0x28, 0x07, 0x99, 0x7b, 0x7b, 0x02, 0x99, 0xdc, 0xf8, 0x7e, 0x19, 0xb2, 0xe4, 0xfc, 0x0f,
+	0xd8, 0xb7, 0x3b, 0xf4, 0x10, 0xe6, 0xf8, 0xb8, 0x12, 0x21, 0x96, 0xba, 0x83, 0x33, 0x53, 0x65,
+	0x39, 0x04, 0x63, 0x4e, 0x57, 0xdf, 0xfa, 0xed, 0xbf, 0xff, 0xfb, 0xc7, 0xd8, 0x3a, 0x9a, 0xaf,
+	0x1f, 0x5f, 0xaf, 0xbb, 0x96, 0x61, 0xd5, 0xf5, 0x5e, 0xef, 0x7e, 0x05, 0x5d, 0x23, 0x6b, 0xf1,
+	0xc4, 0xd7, 0x9f, 0x7a, 0x33, 0xd1, 0x67, 0x94, 0xc8, 0x41, 0x0d, 0x48, 0xb1, 0x8a, 0x15, 0x2d,
+	0xd1, 0x86, 0x39, 0x38, 0x91, 0x54, 0x50, 0x10, 0xc4, 0x05, 0x2d, 0x53, 0x41, 0x39, 0x35, 0x2d,
+	0x04, 0x6d, 0x4a, 0x55, 0x74, 0x13, 0x12, 0x44, 0x21, 0xb4, 0x28, 0x54, 0x13, 0x1c, 0xf2, 0x3e,
+	0x80, 0xef, 0x5f, 0xa1, 0xfb, 0x17, 0x51, 0xce, 0x53, 0xf4, 0xa9, 0x69, 0x3c, 0x43, 0x5d, 0x48,
+	0xb1, 0x72, 0x90, 0xe9, 0x11, 0x1a, 0x20, 0x2a, 0x28, 0x08, 0x0a, 0x1b, 0xac, 0x20, 0x9f, 0x0f,
+	0xb9, 0x3d, 0x35, 0xd3, 0x78, 0xb6, 0x29, 0x55, 0xef, 0x97, 0x36, 0x26, 0x23, 0xd0, 0x2d, 0x48,
+	0xb1, 0x10, 0x60, 0x82, 0x42, 0x03, 0x3d, 0x05, 0x05, 0x41, 0x61, 0x85, 0xab, 0x11, 0x85, 0x3f,
+	0x83, 0xb4, 0x18, 0xc0, 0x23, 0x7a, 0x22, 0x91, 0x19, 0xbe, 0x52, 0x08, 0x03, 0x39, 0xb7, 0x97,
+	0x28, 0xb7, 0x2b, 0x6a, 0x31, 0xc4, 0x6d, 0x73, 0xc4, 0xe9, 0x88, 0x86, 0x9f, 0x41, 0x36, 0x30,
+	0x6e, 0x47, 0x45, 0xc2, 0x67, 0x7c, 0x4e, 0xaf, 0x94, 0xc6, 0xe0, 0x5c, 0x84, 0x4c, 0x45, 0x20,
+	0x94, 0xf7, 0x4e, 0x48, 0xd4, 0x9d, 0x6d, 0x3e, 0xc2, 0xe6, 0x27, 0x5e, 0xf4, 0x66, 0x84, 0xe1,
+	0x63, 0x2f, 0x8d, 0xc1, 0x39, 0xe7, 0x17, 0x29, 0xe7, 0xb2, 0x5a, 0xf0, 0x38, 0x1f, 0xfa, 0x54,
+	0x44, 0x75, 0x21, 0x80, 0x1f, 0xa5, 0x2f, 0x20, 0x7c, 0x9e, 0xa5, 0x31, 0xf8, 0xd9, 0x02, 0x18,
+	0x55, 0x50, 0x00, 0x3f, 0x42, 0x5f, 0x40, 0xf8, 0x1c, 0x4b, 0x63, 0xf0, 0xb3, 0x05, 0x34, 0x3c,
+	0xe7, 0xdf, 0x81, 0x14, 0x4b, 0x2d, 0x2c, 0x3c, 0x42, 0x93, 0x52, 0x05, 0x05, 0x41, 0x9c, 0xa3,
+	0x42, 0x39, 0x16, 0xd4, 0x45, 0x8f, 0xa3, 0x49, 0x09, 0x36, 0xa5, 0xea, 0xaa, 0x84, 0x7e, 0x0e,
+	0xe0, 0xcf, 0x40, 0xd0, 0x4a, 0x74, 0x26, 0xc2, 0xd8, 0x16, 0xa3, 0xe0, 0x48, 0xac, 0x2c, 0x47,
+	0x58, 0x13, 0xa2, 0x4d, 0x36, 0xf6, 0xba, 0x0d, 0xa9, 0xe6, 0x63, 0x5f, 0xdd, 0xd0, 0x20, 0x53,
+	0x99, 0x38, 0x35, 0x53, 0x4b, 0x94, 0xeb, 0x12, 0xf2, 0x15, 0xc6, 0x74, 0xd7, 0xba, 0x44, 0x62,
+	0x5a, 0xcc, 0x57, 0x58, 0x4c, 0x47, 0x46, 0x34, 0x4a, 0x21, 0x0c, 0x3c, 0x3b, 0xa6, 0x3b, 0x9c,
+	0x8e, 0xb8, 0xf5, 0x88, 0x75, 0xf4, 0x81, 0x71, 0x02, 0x52, 0x44, 0xfc, 0x8e, 0xcf, 0x5a, 0x94,
+	0x2b, 0x13, 0x71, 0x5c, 0xdc, 0x55, 0x2a, 0xae, 0x88, 0xfc, 0x33, 0x0c, 0x4e, 0x16, 0x6e, 0x43,
+	0x5a, 0x8c, 0x05, 0x98, 0x0d, 0x91, 0x49, 0x82, 0x52, 0x08, 0x03, 0xa7, 0xa5, 0xa5, 0x4d, 0xfa,
+	0x5d, 0xe9, 0x4b, 0xf1, 0x4d, 0x56, 0x7c, 0x15, 0x96, 0xfd, 0x94, 0x18, 0x6e, 0x33, 0x95, 0xf2,
+	0x04, 0x0c, 0x67, 0xce, 0x5d, 0xae, 0xce, 0x07, 0x93, 0x31, 0x71, 0xcb, 0xe7, 0xec, 0xeb, 0xab,
+	0x60, 0x5e, 0x14, 0xd9, 0x32, 0xc2, 0xba, 0x34, 0x06, 0xe7, 0x8c, 0xcb, 0x94, 0xf1, 0x32, 0x5a,
+	0x0a, 0x67, 0x79, 0x92, 0x9f, 0xbe, 0x91, 0xc4, 0x67, 0xd4, 0x90, 0xea, 0x93, 0x9a, 0x78, 0xa5,
+	0x3c, 0x01, 0xc3, 0x25, 0x34, 0xa8, 0x84, 0xf7, 0x94, 0xf2, 0xc4, 0x77, 0x44, 0x64, 0xdb, 0x6b,
+	0x1b, 0x67, 0xe2, 0x51, 0x5b, 0x7c, 0x53, 0x0d, 0xe9, 0x32, 0xa9, 0x5b, 0x57, 0xca, 0x13, 0x30,
+	0x61, 0x6b, 0xab, 0x13, 0xac, 0xfd, 0x14, 0xe6, 0x83, 0xed, 0x30, 0xf2, 0x92, 0x63, 0xa4, 0x93,
+	0x56, 0xe4, 0x71, 0x04, 0xe7, 0x5e, 0xa0, 0xdc, 0x17, 0x50, 0xe8, 0x90, 0x10, 0x86, 0x5c, 0xa8,
+	0x7f, 0x44, 0x1e, 0x83, 0x68, 0x33, 0xaa, 0x94, 0x27, 0x60, 0xc2, 0x69, 0x07, 0x95, 0x42, 0x37,
+	0xa4, 0xee, 0xf7, 0x93, 0x0f, 0x21, 0x1b, 0xe8, 0xee, 0x58, 0x20, 0x8c, 0xb7, 0x90, 0x4a, 0x69,
+	0x0c, 0xce, 0x05, 0x54, 0xa9, 0x80, 0x57, 0x90, 0x3a, 0x45, 0x40, 0xfd, 0xa9, 0xf8, 0xf9, 0x0c,
+	0xfd, 0x46, 0x82, 0xc5, 0x48, 0x7f, 0xc6, 0x2e, 0xe3, 0xe4, 0x66, 0x50, 0xb9, 0x32, 0x11, 0xc7,
+	0x05, 0xff, 0x94, 0x0a, 0xae, 0xab, 0xd5, 0xd9, 0x82, 0x37, 0x6d, 0xc6, 0x83, 0x04, 0xc4, 0x21,
+	0xcb, 0x07, 0x81, 0xea, 0xdd, 0xcf, 0x07, 0xe3, 0x6d, 0x8b, 0x72, 0x65, 0x22, 0x8e, 0xab, 0x50,
+	0xa4, 0x2a, 0xe4, 0xd1, 0x82, 0x77, 0x75, 0x75, 0x42, 0x85, 0x6e, 0x41, 0x92, 0x16, 0xa6, 0x88,
+	0x16, 0x21, 0xc1, 0x12, 0x59, 0x59, 0x0a, 0x40, 0xa6, 0x72, 0x79, 0x44, 0xf0, 0xeb, 0xd2, 0xf6,
+	0x37, 0xb1, 0x6f, 0xb7, 0x7e, 0x17, 0x43, 0x7f, 0x95, 0x60, 0x9e, 0x54, 0x69, 0x15, 0xfe, 0x2f,
+	0x56, 0xea, 0x1f, 0x24, 0xa8, 0x77, 0xad, 0xb5, 0xae, 0x3d, 0xec, 0xac, 0x91, 0xff, 0x8c, 0x5a,
+	0x23, 0xf6, 0xad, 0xf5, 0xcd, 0x8e, 0x6d, 0x71, 0x92, 0x35, 0x77, 0xe4, 0x5a, 0xb6, 0xa9, 0xf7,
+	0x2a, 0x3c, 0x9e, 0xd0, 0x36, 0x21, 0x74, 0x36, 0xeb, 0xf5, 0xae, 0xe9, 0x1e, 0x8d, 0x0e, 0x6b,
+	0x1d, 0xab, 0x5f, 0xd7, 0xfb, 0x8e, 0xf5, 0x95, 0xd5, 0x3b, 0x2f, 0x2f, 0x05, 0xf5, 0xb1, 0x61,
+	0x8e, 0xfa, 0x37, 0xf9, 0x3e, 0xc2, 0x63, 0x23, 0x7e, 0xbd, 0xb6, 0x5e, 0x95, 0xa4, 0x8d, 0xbc,
+	0x3e, 0x1c, 0xf6, 0xcc, 0x0e, 0xfd, 0xff, 0xaa, 0xfa, 0x43, 0xc7, 0x1a, 0x6c, 0x8e, 0x41, 0xb4,
+	0x77, 0x20, 0x7e, 0x63, 0xfd, 0x06, 0xba, 0x01, 0x55, 0x0d, 0xbb, 0x23, 0x7b, 0x80, 0x8d, 0xca,
+	0xa3, 0x23, 0x3c, 0xa8, 0xb8, 0x47, 0xb8, 0x62, 0x63, 0xc7, 0x1a, 0xd9, 0x1d, 0x5c, 0x31, 0x2c,
+	0xec, 0x54, 0x06, 0x96, 0x5b, 0xc1, 0x8f, 0x4d, 0xc7, 0xad, 0xa1, 0x14, 0x24, 0xbe, 0x8b, 0x49,
+	0x73, 0x87, 0x29, 0xda, 0x86, 0xbc, 0xf9, 0xff, 0x01, 0x00, 0x1a, 0x40, 0xd1, 0xf7, 0x6e, 0x26,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ToDoServiceClient is the client API for ToDoService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type ToDoServiceClient interface {
 	// Read all todo tasks
-	ReadAll(ctx context.Context, in *ReadAllRequest, opts ...grpc.CallOption) (*ReadAllResponse, error)
+	ReadAll(ctx context.Context, in *ReadAllRequest, opts ...grpc.CallOption) (*ReadAllResponse, error)
+	// Create new todo task
+	Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*CreateResponse, error)
+	// Read todo task
+	Read(ctx context.Context, in *ReadRequest, opts ...grpc.CallOption) (*ReadResponse, error)
+	// Update todo task
+	Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error)
+	// Delete todo task, it is moved to trash
+	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
+	// Restore todo task from trash
+	Undelete(ctx context.Context, in *UndeleteRequest, opts ...grpc.CallOption) (*UndeleteResponse, error)
+	// List todo tasks in trash
+	ListDeleted(ctx context.Context, in *ListDeletedRequest, opts ...grpc.CallOption) (*ListDeletedResponse, error)
+	// Create todo tasks in one transaction
+	BatchCreate(ctx context.Context, in *BatchCreateRequest, opts ...grpc.CallOption) (*BatchCreateResponse, error)
+	// Update todo tasks in one transaction
+	BatchUpdate(ctx context.Context, in *BatchUpdateRequest, opts ...grpc.CallOption) (*BatchUpdateResponse, error)
+	// Delete todo tasks in one transaction
+	BatchDelete(ctx context.Context, in *BatchDeleteRequest, opts ...grpc.CallOption) (*BatchDeleteResponse, error)
+	// Import stream of todo tasks. Tasks are inserted in chunks of 500 tasks, every chunk in one transaction.
+	// Tasks which cannot be inserted are reported and skipped, chunks inserted before stream fails stay inserted.
+	// Over HTTP/REST tasks are sent as newline delimited JSON ImportRequest messages
+	Import(ctx context.Context, opts ...grpc.CallOption) (ToDoService_ImportClient, error)
+	// Import todo tasks from CSV or iCalendar file in one transaction.
+	// Over HTTP/REST the file is request body, text/csv and text/calendar uploads to /v1/todo:import are imported too
+	ImportFile(ctx context.Context, in *ImportFileRequest, opts ...grpc.CallOption) (*ImportFileResponse, error)
+	// Export todo tasks ordered by ID as file streamed in chunks.
+	// Content type of the file is set in every chunk, over HTTP/REST the file is returned as response body
+	Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (ToDoService_ExportClient, error)
+	// Complete todo task, recurring task is continued by task for its next occurrence
+	Complete(ctx context.Context, in *CompleteRequest, opts ...grpc.CallOption) (*CompleteResponse, error)
+	// List upcoming occurrences of todo tasks in time window
+	ListOccurrences(ctx context.Context, in *ListOccurrencesRequest, opts ...grpc.CallOption) (*ListOccurrencesResponse, error)
+	// List tags with number of tasks they label
+	ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error)
+	// Create new project
+	CreateProject(ctx context.Context, in *CreateProjectRequest, opts ...grpc.CallOption) (*CreateProjectResponse, error)
+	// Read project
+	ReadProject(ctx context.Context, in *ReadProjectRequest, opts ...grpc.CallOption) (*ReadProjectResponse, error)
+	// Update project
+	UpdateProject(ctx context.Context, in *UpdateProjectRequest, opts ...grpc.CallOption) (*UpdateProjectResponse, error)
+	// Delete project, its tasks restrict the delete or are moved to trash by mode
+	DeleteProject(ctx context.Context, in *DeleteProjectRequest, opts ...grpc.CallOption) (*DeleteProjectResponse, error)
+	// List all projects
+	ListProjects(ctx context.Context, in *ListProjectsRequest, opts ...grpc.CallOption) (*ListProjectsResponse, error)
+	// List revisions of todo task
+	ListRevisions(ctx context.Context, in *ListRevisionsRequest, opts ...grpc.CallOption) (*ListRevisionsResponse, error)
+	// Read revision of todo task
+	GetRevision(ctx context.Context, in *GetRevisionRequest, opts ...grpc.CallOption) (*GetRevisionResponse, error)
+	// Revert todo task to its revision, the revert is recorded as new revision
+	RestoreRevision(ctx context.Context, in *RestoreRevisionRequest, opts ...grpc.CallOption) (*RestoreRevisionResponse, error)
+	// List audit records of mutations of todo tasks
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
+	// Watch changes of todo tasks.
+	// Over HTTP/REST changes are sent as server-sent events if request accepts text/event-stream
+	Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (ToDoService_WatchClient, error)
+}
+
+type toDoServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewToDoServiceClient(cc *grpc.ClientConn) ToDoServiceClient {
+	return &toDoServiceClient{cc}
+}
+
+func (c *toDoServiceClient) ReadAll(ctx context.Context, in *ReadAllRequest, opts ...grpc.CallOption) (*ReadAllResponse, error) {
+	out := new(ReadAllResponse)
+	err := c.cc.Invoke(ctx, "/v1.ToDoService/ReadAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toDoServiceClient) Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*CreateResponse, error) {
+	out := new(CreateResponse)
+	err := c.cc.Invoke(ctx, "/v1.ToDoService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toDoServiceClient) Read(ctx context.Context, in *ReadRequest, opts ...grpc.CallOption) (*ReadResponse, error) {
+	out := new(ReadResponse)
+	err := c.cc.Invoke(ctx, "/v1.ToDoService/Read", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toDoServiceClient) Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error) {
+	out := new(UpdateResponse)
+	err := c.cc.Invoke(ctx, "/v1.ToDoService/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toDoServiceClient) Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error) {
+	out := new(DeleteResponse)
+	err := c.cc.Invoke(ctx, "/v1.ToDoService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toDoServiceClient) Undelete(ctx context.Context, in *UndeleteRequest, opts ...grpc.CallOption) (*UndeleteResponse, error) {
+	out := new(UndeleteResponse)
+	err := c.cc.Invoke(ctx, "/v1.ToDoService/Undelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toDoServiceClient) ListDeleted(ctx context.Context, in *ListDeletedRequest, opts ...grpc.CallOption) (*ListDeletedResponse, error) {
+	out := new(ListDeletedResponse)
+	err := c.cc.Invoke(ctx, "/v1.ToDoService/ListDeleted", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toDoServiceClient) BatchCreate(ctx context.Context, in *BatchCreateRequest, opts ...grpc.CallOption) (*BatchCreateResponse, error) {
+	out := new(BatchCreateResponse)
+	err := c.cc.Invoke(ctx, "/v1.ToDoService/BatchCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toDoServiceClient) BatchUpdate(ctx context.Context, in *BatchUpdateRequest, opts ...grpc.CallOption) (*BatchUpdateResponse, error) {
+	out := new(BatchUpdateResponse)
+	err := c.cc.Invoke(ctx, "/v1.ToDoService/BatchUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toDoServiceClient) BatchDelete(ctx context.Context, in *BatchDeleteRequest, opts ...grpc.CallOption) (*BatchDeleteResponse, error) {
+	out := new(BatchDeleteResponse)
+	err := c.cc.Invoke(ctx, "/v1.ToDoService/BatchDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toDoServiceClient) Import(ctx context.Context, opts ...grpc.CallOption) (ToDoService_ImportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ToDoService_serviceDesc.Streams[0], "/v1.ToDoService/Import", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &toDoServiceImportClient{stream}
+	return x, nil
+}
+
+type ToDoService_ImportClient interface {
+	Send(*ImportRequest) error
+	CloseAndRecv() (*ImportResponse, error)
+	grpc.ClientStream
+}
+
+type toDoServiceImportClient struct {
+	grpc.ClientStream
+}
+
+func (x *toDoServiceImportClient) Send(m *ImportRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *toDoServiceImportClient) CloseAndRecv() (*ImportResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *toDoServiceClient) ImportFile(ctx context.Context, in *ImportFileRequest, opts ...grpc.CallOption) (*ImportFileResponse, error) {
+	out := new(ImportFileResponse)
+	err := c.cc.Invoke(ctx, "/v1.ToDoService/ImportFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toDoServiceClient) Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (ToDoService_ExportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ToDoService_serviceDesc.Streams[1], "/v1.ToDoService/Export", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &toDoServiceExportClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ToDoService_ExportClient interface {
+	Recv() (*httpbody.HttpBody, error)
+	grpc.ClientStream
+}
+
+type toDoServiceExportClient struct {
+	grpc.ClientStream
+}
+
+func (x *toDoServiceExportClient) Recv() (*httpbody.HttpBody, error) {
+	m := new(httpbody.HttpBody)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *toDoServiceClient) Complete(ctx context.Context, in *CompleteRequest, opts ...grpc.CallOption) (*CompleteResponse, error) {
+	out := new(CompleteResponse)
+	err := c.cc.Invoke(ctx, "/v1.ToDoService/Complete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toDoServiceClient) ListOccurrences(ctx context.Context, in *ListOccurrencesRequest, opts ...grpc.CallOption) (*ListOccurrencesResponse, error) {
+	out := new(ListOccurrencesResponse)
+	err := c.cc.Invoke(ctx, "/v1.ToDoService/ListOccurrences", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toDoServiceClient) ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error) {
+	out := new(ListTagsResponse)
+	err := c.cc.Invoke(ctx, "/v1.ToDoService/ListTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toDoServiceClient) CreateProject(ctx context.Context, in *CreateProjectRequest, opts ...grpc.CallOption) (*CreateProjectResponse, error) {
+	out := new(CreateProjectResponse)
+	err := c.cc.Invoke(ctx, "/v1.ToDoService/CreateProject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toDoServiceClient) ReadProject(ctx context.Context, in *ReadProjectRequest, opts ...grpc.CallOption) (*ReadProjectResponse, error) {
+	out := new(ReadProjectResponse)
+	err := c.cc.Invoke(ctx, "/v1.ToDoService/ReadProject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toDoServiceClient) UpdateProject(ctx context.Context, in *UpdateProjectRequest, opts ...grpc.CallOption) (*UpdateProjectResponse, error) {
+	out := new(UpdateProjectResponse)
+	err := c.cc.Invoke(ctx, "/v1.ToDoService/UpdateProject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toDoServiceClient) DeleteProject(ctx context.Context, in *DeleteProjectRequest, opts ...grpc.CallOption) (*DeleteProjectResponse, error) {
+	out := new(DeleteProjectResponse)
+	err := c.cc.Invoke(ctx, "/v1.ToDoService/DeleteProject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toDoServiceClient) ListProjects(ctx context.Context, in *ListProjectsRequest, opts ...grpc.CallOption) (*ListProjectsResponse, error) {
+	out := new(ListProjectsResponse)
+	err := c.cc.Invoke(ctx, "/v1.ToDoService/ListProjects", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toDoServiceClient) ListRevisions(ctx context.Context, in *ListRevisionsRequest, opts ...grpc.CallOption) (*ListRevisionsResponse, error) {
+	out := new(ListRevisionsResponse)
+	err := c.cc.Invoke(ctx, "/v1.ToDoService/ListRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toDoServiceClient) GetRevision(ctx context.Context, in *GetRevisionRequest, opts ...grpc.CallOption) (*GetRevisionResponse, error) {
+	out := new(GetRevisionResponse)
+	err := c.cc.Invoke(ctx, "/v1.ToDoService/GetRevision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toDoServiceClient) RestoreRevision(ctx context.Context, in *RestoreRevisionRequest, opts ...grpc.CallOption) (*RestoreRevisionResponse, error) {
+	out := new(RestoreRevisionResponse)
+	err := c.cc.Invoke(ctx, "/v1.ToDoService/RestoreRevision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toDoServiceClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, "/v1.ToDoService/ListAuditEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toDoServiceClient) Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (ToDoService_WatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ToDoService_serviceDesc.Streams[2], "/v1.ToDoService/Watch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &toDoServiceWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ToDoService_WatchClient interface {
+	Recv() (*WatchResponse, error)
+	grpc.ClientStream
+}
+
+type toDoServiceWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *toDoServiceWatchClient) Recv() (*WatchResponse, error) {
+	m := new(WatchResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ToDoServiceServer is the server API for ToDoService service.
+type ToDoServiceServer interface {
+	// Read all todo tasks
+	ReadAll(context.Context, *ReadAllRequest) (*ReadAllResponse, error)
 	// Create new todo task
-	Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*CreateResponse, error)
+	Create(context.Context, *CreateRequest) (*CreateResponse, error)
 	// Read todo task
-	Read(ctx context.Context, in *ReadRequest, opts ...grpc.CallOption) (*ReadResponse, error)
+	Read(context.Context, *ReadRequest) (*ReadResponse, error)
 	// Update todo task
-	Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error)
-	// Delete todo task
-	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
+	Update(context.Context, *UpdateRequest) (*UpdateResponse, error)
+	// Delete todo task, it is moved to trash
+	Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
+	// Restore todo task from trash
+	Undelete(context.Context, *UndeleteRequest) (*UndeleteResponse, error)
+	// List todo tasks in trash
+	ListDeleted(context.Context, *ListDeletedRequest) (*ListDeletedResponse, error)
+	// Create todo tasks in one transaction
+	BatchCreate(context.Context, *BatchCreateRequest) (*BatchCreateResponse, error)
+	// Update todo tasks in one transaction
+	BatchUpdate(context.Context, *BatchUpdateRequest) (*BatchUpdateResponse, error)
+	// Delete todo tasks in one transaction
+	BatchDelete(context.Context, *BatchDeleteRequest) (*BatchDeleteResponse, error)
+	// Import stream of todo tasks. Tasks are inserted in chunks of 500 tasks, every chunk in one transaction.
+	// Tasks which cannot be inserted are reported and skipped, chunks inserted before stream fails stay inserted.
+	// Over HTTP/REST tasks are sent as newline delimited JSON ImportRequest messages
+	Import(ToDoService_ImportServer) error
+	// Import todo tasks from CSV or iCalendar file in one transaction.
+	// Over HTTP/REST the file is request body, text/csv and text/calendar uploads to /v1/todo:import are imported too
+	ImportFile(context.Context, *ImportFileRequest) (*ImportFileResponse, error)
+	// Export todo tasks ordered by ID as file streamed in chunks.
+	// Content type of the file is set in every chunk, over HTTP/REST the file is returned as response body
+	Export(*ExportRequest, ToDoService_ExportServer) error
+	// Complete todo task, recurring task is continued by task for its next occurrence
+	Complete(context.Context, *CompleteRequest) (*CompleteResponse, error)
+	// List upcoming occurrences of todo tasks in time window
+	ListOccurrences(context.Context, *ListOccurrencesRequest) (*ListOccurrencesResponse, error)
+	// List tags with number of tasks they label
+	ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error)
+	// Create new project
+	CreateProject(context.Context, *CreateProjectRequest) (*CreateProjectResponse, error)
+	// Read project
+	ReadProject(context.Context, *ReadProjectRequest) (*ReadProjectResponse, error)
+	// Update project
+	UpdateProject(context.Context, *UpdateProjectRequest) (*UpdateProjectResponse, error)
+	// Delete project, its tasks restrict the delete or are moved to trash by mode
+	DeleteProject(context.Context, *DeleteProjectRequest) (*DeleteProjectResponse, error)
+	// List all projects
+	ListProjects(context.Context, *ListProjectsRequest) (*ListProjectsResponse, error)
+	// List revisions of todo task
+	ListRevisions(context.Context, *ListRevisionsRequest) (*ListRevisionsResponse, error)
+	// Read revision of todo task
+	GetRevision(context.Context, *GetRevisionRequest) (*GetRevisionResponse, error)
+	// Revert todo task to its revision, the revert is recorded as new revision
+	RestoreRevision(context.Context, *RestoreRevisionRequest) (*RestoreRevisionResponse, error)
+	// List audit records of mutations of todo tasks
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
+	// Watch changes of todo tasks.
+	// Over HTTP/REST changes are sent as server-sent events if request accepts text/event-stream
+	Watch(*WatchRequest, ToDoService_WatchServer) error
 }
 
-type toDoServiceClient struct {
-	cc *grpc.ClientConn
+// UnimplementedToDoServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedToDoServiceServer struct {
+}
+
+func (*UnimplementedToDoServiceServer) ReadAll(ctx context.Context, req *ReadAllRequest) (*ReadAllResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method ReadAll not implemented")
+}
+func (*UnimplementedToDoServiceServer) Create(ctx context.Context, req *CreateRequest) (*CreateResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (*UnimplementedToDoServiceServer) Read(ctx context.Context, req *ReadRequest) (*ReadResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method Read not implemented")
+}
+func (*UnimplementedToDoServiceServer) Update(ctx context.Context, req *UpdateRequest) (*UpdateResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (*UnimplementedToDoServiceServer) Delete(ctx context.Context, req *DeleteRequest) (*DeleteResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (*UnimplementedToDoServiceServer) Undelete(ctx context.Context, req *UndeleteRequest) (*UndeleteResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method Undelete not implemented")
+}
+func (*UnimplementedToDoServiceServer) ListDeleted(ctx context.Context, req *ListDeletedRequest) (*ListDeletedResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method ListDeleted not implemented")
+}
+func (*UnimplementedToDoServiceServer) BatchCreate(ctx context.Context, req *BatchCreateRequest) (*BatchCreateResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method BatchCreate not implemented")
+}
+func (*UnimplementedToDoServiceServer) BatchUpdate(ctx context.Context, req *BatchUpdateRequest) (*BatchUpdateResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method BatchUpdate not implemented")
+}
+func (*UnimplementedToDoServiceServer) BatchDelete(ctx context.Context, req *BatchDeleteRequest) (*BatchDeleteResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method BatchDelete not implemented")
+}
+func (*UnimplementedToDoServiceServer) Import(srv ToDoService_ImportServer) error {
+	return status1.Errorf(codes.Unimplemented, "method Import not implemented")
+}
+func (*UnimplementedToDoServiceServer) ImportFile(ctx context.Context, req *ImportFileRequest) (*ImportFileResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method ImportFile not implemented")
+}
+func (*UnimplementedToDoServiceServer) Export(req *ExportRequest, srv ToDoService_ExportServer) error {
+	return status1.Errorf(codes.Unimplemented, "method Export not implemented")
+}
+func (*UnimplementedToDoServiceServer) Complete(ctx context.Context, req *CompleteRequest) (*CompleteResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method Complete not implemented")
+}
+func (*UnimplementedToDoServiceServer) ListOccurrences(ctx context.Context, req *ListOccurrencesRequest) (*ListOccurrencesResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method ListOccurrences not implemented")
+}
+func (*UnimplementedToDoServiceServer) ListTags(ctx context.Context, req *ListTagsRequest) (*ListTagsResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method ListTags not implemented")
+}
+func (*UnimplementedToDoServiceServer) CreateProject(ctx context.Context, req *CreateProjectRequest) (*CreateProjectResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method CreateProject not implemented")
+}
+func (*UnimplementedToDoServiceServer) ReadProject(ctx context.Context, req *ReadProjectRequest) (*ReadProjectResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method ReadProject not implemented")
+}
+func (*UnimplementedToDoServiceServer) UpdateProject(ctx context.Context, req *UpdateProjectRequest) (*UpdateProjectResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method UpdateProject not implemented")
+}
+func (*UnimplementedToDoServiceServer) DeleteProject(ctx context.Context, req *DeleteProjectRequest) (*DeleteProjectResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method DeleteProject not implemented")
+}
+func (*UnimplementedToDoServiceServer) ListProjects(ctx context.Context, req *ListProjectsRequest) (*ListProjectsResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method ListProjects not implemented")
+}
+func (*UnimplementedToDoServiceServer) ListRevisions(ctx context.Context, req *ListRevisionsRequest) (*ListRevisionsResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method ListRevisions not implemented")
+}
+func (*UnimplementedToDoServiceServer) GetRevision(ctx context.Context, req *GetRevisionRequest) (*GetRevisionResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method GetRevision not implemented")
+}
+func (*UnimplementedToDoServiceServer) RestoreRevision(ctx context.Context, req *RestoreRevisionRequest) (*RestoreRevisionResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method RestoreRevision not implemented")
+}
+func (*UnimplementedToDoServiceServer) ListAuditEvents(ctx context.Context, req *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
+func (*UnimplementedToDoServiceServer) Watch(req *WatchRequest, srv ToDoService_WatchServer) error {
+	return status1.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
+
+func RegisterToDoServiceServer(s *grpc.Server, srv ToDoServiceServer) {
+	s.RegisterService(&_ToDoService_serviceDesc, srv)
+}
+
+func _ToDoService_ReadAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadAllRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToDoServiceServer).ReadAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.ToDoService/ReadAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToDoServiceServer).ReadAll(ctx, req.(*ReadAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToDoService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToDoServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.ToDoService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToDoServiceServer).Create(ctx, req.(*CreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToDoService_Read_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToDoServiceServer).Read(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.ToDoService/Read",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToDoServiceServer).Read(ctx, req.(*ReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToDoService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToDoServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.ToDoService/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToDoServiceServer).Update(ctx, req.(*UpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToDoService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToDoServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.ToDoService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToDoServiceServer).Delete(ctx, req.(*DeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToDoService_Undelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToDoServiceServer).Undelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.ToDoService/Undelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToDoServiceServer).Undelete(ctx, req.(*UndeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToDoService_ListDeleted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToDoServiceServer).ListDeleted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.ToDoService/ListDeleted",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToDoServiceServer).ListDeleted(ctx, req.(*ListDeletedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func NewToDoServiceClient(cc *grpc.ClientConn) ToDoServiceClient {
-	return &toDoServiceClient{cc}
+func _ToDoService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToDoServiceServer).BatchCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.ToDoService/BatchCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToDoServiceServer).BatchCreate(ctx, req.(*BatchCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *toDoServiceClient) ReadAll(ctx context.Context, in *ReadAllRequest, opts ...grpc.CallOption) (*ReadAllResponse, error) {
-	out := new(ReadAllResponse)
-	err := c.cc.Invoke(ctx, "/v1.ToDoService/ReadAll", in, out, opts...)
-	if err != nil {
+func _ToDoService_BatchUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ToDoServiceServer).BatchUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.ToDoService/BatchUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToDoServiceServer).BatchUpdate(ctx, req.(*BatchUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *toDoServiceClient) Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*CreateResponse, error) {
-	out := new(CreateResponse)
-	err := c.cc.Invoke(ctx, "/v1.ToDoService/Create", in, out, opts...)
-	if err != nil {
+func _ToDoService_BatchDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ToDoServiceServer).BatchDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.ToDoService/BatchDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToDoServiceServer).BatchDelete(ctx, req.(*BatchDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *toDoServiceClient) Read(ctx context.Context, in *ReadRequest, opts ...grpc.CallOption) (*ReadResponse, error) {
-	out := new(ReadResponse)
-	err := c.cc.Invoke(ctx, "/v1.ToDoService/Read", in, out, opts...)
-	if err != nil {
+func _ToDoService_Import_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ToDoServiceServer).Import(&toDoServiceImportServer{stream})
+}
+
+type ToDoService_ImportServer interface {
+	SendAndClose(*ImportResponse) error
+	Recv() (*ImportRequest, error)
+	grpc.ServerStream
+}
+
+type toDoServiceImportServer struct {
+	grpc.ServerStream
+}
+
+func (x *toDoServiceImportServer) SendAndClose(m *ImportResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *toDoServiceImportServer) Recv() (*ImportRequest, error) {
+	m := new(ImportRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return out, nil
+	return m, nil
 }
 
-func (c *toDoServiceClient) Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error) {
-	out := new(UpdateResponse)
-	err := c.cc.Invoke(ctx, "/v1.ToDoService/Update", in, out, opts...)
-	if err != nil {
+func _ToDoService_ImportFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportFileRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ToDoServiceServer).ImportFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.ToDoService/ImportFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToDoServiceServer).ImportFile(ctx, req.(*ImportFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *toDoServiceClient) Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error) {
-	out := new(DeleteResponse)
-	err := c.cc.Invoke(ctx, "/v1.ToDoService/Delete", in, out, opts...)
-	if err != nil {
-		return nil, err
+func _ToDoService_Export_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return out, nil
+	return srv.(ToDoServiceServer).Export(m, &toDoServiceExportServer{stream})
 }
 
-// ToDoServiceServer is the server API for ToDoService service.
-type ToDoServiceServer interface {
-	// Read all todo tasks
-	ReadAll(context.Context, *ReadAllRequest) (*ReadAllResponse, error)
-	// Create new todo task
-	Create(context.Context, *CreateRequest) (*CreateResponse, error)
-	// Read todo task
-	Read(context.Context, *ReadRequest) (*ReadResponse, error)
-	// Update todo task
-	Update(context.Context, *UpdateRequest) (*UpdateResponse, error)
-	// Delete todo task
-	Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
+type ToDoService_ExportServer interface {
+	Send(*httpbody.HttpBody) error
+	grpc.ServerStream
 }
 
-// UnimplementedToDoServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedToDoServiceServer struct {
+type toDoServiceExportServer struct {
+	grpc.ServerStream
 }
 
-func (*UnimplementedToDoServiceServer) ReadAll(ctx context.Context, req *ReadAllRequest) (*ReadAllResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReadAll not implemented")
+func (x *toDoServiceExportServer) Send(m *httpbody.HttpBody) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (*UnimplementedToDoServiceServer) Create(ctx context.Context, req *CreateRequest) (*CreateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+
+func _ToDoService_Complete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToDoServiceServer).Complete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.ToDoService/Complete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToDoServiceServer).Complete(ctx, req.(*CompleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedToDoServiceServer) Read(ctx context.Context, req *ReadRequest) (*ReadResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Read not implemented")
+
+func _ToDoService_ListOccurrences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOccurrencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToDoServiceServer).ListOccurrences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.ToDoService/ListOccurrences",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToDoServiceServer).ListOccurrences(ctx, req.(*ListOccurrencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedToDoServiceServer) Update(ctx context.Context, req *UpdateRequest) (*UpdateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+
+func _ToDoService_ListTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToDoServiceServer).ListTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.ToDoService/ListTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToDoServiceServer).ListTags(ctx, req.(*ListTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedToDoServiceServer) Delete(ctx context.Context, req *DeleteRequest) (*DeleteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+
+func _ToDoService_CreateProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToDoServiceServer).CreateProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.ToDoService/CreateProject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToDoServiceServer).CreateProject(ctx, req.(*CreateProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterToDoServiceServer(s *grpc.Server, srv ToDoServiceServer) {
-	s.RegisterService(&_ToDoService_serviceDesc, srv)
+func _ToDoService_ReadProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToDoServiceServer).ReadProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.ToDoService/ReadProject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToDoServiceServer).ReadProject(ctx, req.(*ReadProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _ToDoService_ReadAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReadAllRequest)
+func _ToDoService_UpdateProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProjectRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ToDoServiceServer).ReadAll(ctx, in)
+		return srv.(ToDoServiceServer).UpdateProject(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.ToDoService/ReadAll",
+		FullMethod: "/v1.ToDoService/UpdateProject",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ToDoServiceServer).ReadAll(ctx, req.(*ReadAllRequest))
+		return srv.(ToDoServiceServer).UpdateProject(ctx, req.(*UpdateProjectRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ToDoService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateRequest)
+func _ToDoService_DeleteProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProjectRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ToDoServiceServer).Create(ctx, in)
+		return srv.(ToDoServiceServer).DeleteProject(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.ToDoService/Create",
+		FullMethod: "/v1.ToDoService/DeleteProject",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ToDoServiceServer).Create(ctx, req.(*CreateRequest))
+		return srv.(ToDoServiceServer).DeleteProject(ctx, req.(*DeleteProjectRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ToDoService_Read_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReadRequest)
+func _ToDoService_ListProjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProjectsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ToDoServiceServer).Read(ctx, in)
+		return srv.(ToDoServiceServer).ListProjects(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.ToDoService/Read",
+		FullMethod: "/v1.ToDoService/ListProjects",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ToDoServiceServer).Read(ctx, req.(*ReadRequest))
+		return srv.(ToDoServiceServer).ListProjects(ctx, req.(*ListProjectsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ToDoService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateRequest)
+func _ToDoService_ListRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRevisionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ToDoServiceServer).Update(ctx, in)
+		return srv.(ToDoServiceServer).ListRevisions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.ToDoService/Update",
+		FullMethod: "/v1.ToDoService/ListRevisions",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ToDoServiceServer).Update(ctx, req.(*UpdateRequest))
+		return srv.(ToDoServiceServer).ListRevisions(ctx, req.(*ListRevisionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ToDoService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteRequest)
+func _ToDoService_GetRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRevisionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ToDoServiceServer).Delete(ctx, in)
+		return srv.(ToDoServiceServer).GetRevision(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.ToDoService/Delete",
+		FullMethod: "/v1.ToDoService/GetRevision",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ToDoServiceServer).Delete(ctx, req.(*DeleteRequest))
+		return srv.(ToDoServiceServer).GetRevision(ctx, req.(*GetRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToDoService_RestoreRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToDoServiceServer).RestoreRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.ToDoService/RestoreRevision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToDoServiceServer).RestoreRevision(ctx, req.(*RestoreRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToDoService_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToDoServiceServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.ToDoService/ListAuditEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToDoServiceServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ToDoService_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ToDoServiceServer).Watch(m, &toDoServiceWatchServer{stream})
+}
+
+type ToDoService_WatchServer interface {
+	Send(*WatchResponse) error
+	grpc.ServerStream
+}
+
+type toDoServiceWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *toDoServiceWatchServer) Send(m *WatchResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _ToDoService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "v1.ToDoService",
 	HandlerType: (*ToDoServiceServer)(nil),
@@ -883,7 +5133,95 @@ var _ToDoService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _ToDoService_Delete_Handler,
 		},
+		{
+			MethodName: "Undelete",
+			Handler:    _ToDoService_Undelete_Handler,
+		},
+		{
+			MethodName: "ListDeleted",
+			Handler:    _ToDoService_ListDeleted_Handler,
+		},
+		{
+			MethodName: "BatchCreate",
+			Handler:    _ToDoService_BatchCreate_Handler,
+		},
+		{
+			MethodName: "BatchUpdate",
+			Handler:    _ToDoService_BatchUpdate_Handler,
+		},
+		{
+			MethodName: "BatchDelete",
+			Handler:    _ToDoService_BatchDelete_Handler,
+		},
+		{
+			MethodName: "ImportFile",
+			Handler:    _ToDoService_ImportFile_Handler,
+		},
+		{
+			MethodName: "Complete",
+			Handler:    _ToDoService_Complete_Handler,
+		},
+		{
+			MethodName: "ListOccurrences",
+			Handler:    _ToDoService_ListOccurrences_Handler,
+		},
+		{
+			MethodName: "ListTags",
+			Handler:    _ToDoService_ListTags_Handler,
+		},
+		{
+			MethodName: "CreateProject",
+			Handler:    _ToDoService_CreateProject_Handler,
+		},
+		{
+			MethodName: "ReadProject",
+			Handler:    _ToDoService_ReadProject_Handler,
+		},
+		{
+			MethodName: "UpdateProject",
+			Handler:    _ToDoService_UpdateProject_Handler,
+		},
+		{
+			MethodName: "DeleteProject",
+			Handler:    _ToDoService_DeleteProject_Handler,
+		},
+		{
+			MethodName: "ListProjects",
+			Handler:    _ToDoService_ListProjects_Handler,
+		},
+		{
+			MethodName: "ListRevisions",
+			Handler:    _ToDoService_ListRevisions_Handler,
+		},
+		{
+			MethodName: "GetRevision",
+			Handler:    _ToDoService_GetRevision_Handler,
+		},
+		{
+			MethodName: "RestoreRevision",
+			Handler:    _ToDoService_RestoreRevision_Handler,
+		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _ToDoService_ListAuditEvents_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Import",
+			Handler:       _ToDoService_Import_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "Export",
+			Handler:       _ToDoService_Export_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Watch",
+			Handler:       _ToDoService_Watch_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "todo-service.proto",
 }
@@ -9,6 +9,8 @@ It is generated from these files:
 
 It has these top-level messages:
 	ToDo
+	Tag
+	Project
 	CreateRequest
 	CreateResponse
 	ReadRequest
@@ -17,8 +19,57 @@ It has these top-level messages:
 	UpdateResponse
 	DeleteRequest
 	DeleteResponse
+	UndeleteRequest
+	UndeleteResponse
+	ListDeletedRequest
+	ListDeletedResponse
 	ReadAllRequest
 	ReadAllResponse
+	BatchResult
+	BatchCreateRequest
+	BatchCreateResponse
+	BatchUpdateRequest
+	BatchUpdateResponse
+	BatchDeleteRequest
+	BatchDeleteResponse
+	ImportRequest
+	ImportError
+	ImportResponse
+	ExportRequest
+	CSVColumns
+	ImportFileRequest
+	ImportFileResult
+	ImportFileResponse
+	CompleteRequest
+	CompleteResponse
+	ListOccurrencesRequest
+	Occurrence
+	ListOccurrencesResponse
+	ListTagsRequest
+	ListTagsResponse
+	CreateProjectRequest
+	CreateProjectResponse
+	ReadProjectRequest
+	ReadProjectResponse
+	UpdateProjectRequest
+	UpdateProjectResponse
+	DeleteProjectRequest
+	DeleteProjectResponse
+	ListProjectsRequest
+	ListProjectsResponse
+	Revision
+	ListRevisionsRequest
+	ListRevisionsResponse
+	GetRevisionRequest
+	GetRevisionResponse
+	RestoreRevisionRequest
+	RestoreRevisionResponse
+	AuditEvent
+	ListAuditEventsRequest
+	ListAuditEventsResponse
+	Event
+	WatchRequest
+	WatchResponse
 */
 package v1
 
@@ -35,16 +86,25 @@ import fmt "fmt"
 import math "math"
 import _ "github.com/golang/protobuf/ptypes/timestamp"
 import _ "google.golang.org/genproto/googleapis/api/annotations"
+import _ "google.golang.org/genproto/googleapis/api/httpbody"
+import _ "google.golang.org/genproto/googleapis/rpc/status"
 import _ "github.com/grpc-ecosystem/grpc-gateway/protoc-gen-swagger/options"
+import _ "go.smartmachine.io/go-grpc-api/pkg/api/log"
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = fmt.Errorf
 var _ = math.Inf
 
 type ToDoORM struct {
+	DeletedAt   *time.Time `gorm:"column:delete_time"`
 	Description string
+	Done        bool
+	ExternalId  string `gorm:"index:idx_to_dos_external_id"`
 	Id          int64
+	ProjectId   int64 `gorm:"index:idx_to_dos_project_id"`
+	Recurrence  string
 	Reminder    time.Time
+	Tags        []*TagORM `gorm:"many2many:to_do_tags;jointable_foreignkey:to_do_id;association_jointable_foreignkey:tag_id"`
 	Title       string
 }
 
@@ -73,6 +133,17 @@ func (m *ToDo) ToORM(ctx context.Context) (ToDoORM, error) {
 		}
 		to.Reminder = t
 	}
+	to.ExternalId = m.ExternalId
+	to.Recurrence = m.Recurrence
+	to.Done = m.Done
+	if m.DeletedAt != nil {
+		var t time.Time
+		if t, err = ptypes1.Timestamp(m.DeletedAt); err != nil {
+			return to, err
+		}
+		to.DeletedAt = &t
+	}
+	to.ProjectId = m.ProjectId
 	if posthook, ok := interface{}(m).(ToDoWithAfterToORM); ok {
 		err = posthook.AfterToORM(ctx, &to)
 	}
@@ -95,6 +166,15 @@ func (m *ToDoORM) ToPB(ctx context.Context) (ToDo, error) {
 	if to.Reminder, err = ptypes1.TimestampProto(m.Reminder); err != nil {
 		return to, err
 	}
+	to.ExternalId = m.ExternalId
+	to.Recurrence = m.Recurrence
+	to.Done = m.Done
+	if m.DeletedAt != nil {
+		if to.DeletedAt, err = ptypes1.TimestampProto(*m.DeletedAt); err != nil {
+			return to, err
+		}
+	}
+	to.ProjectId = m.ProjectId
 	if posthook, ok := interface{}(m).(ToDoWithAfterToPB); ok {
 		err = posthook.AfterToPB(ctx, &to)
 	}
@@ -124,6 +204,147 @@ type ToDoWithAfterToPB interface {
 	AfterToPB(context.Context, *ToDo) error
 }
 
+type TagORM struct {
+	Id   int64
+	Name string `gorm:"not null;unique_index:idx_tags_name"`
+}
+
+// TableName overrides the default tablename generated by GORM
+func (TagORM) TableName() string {
+	return "tags"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Tag) ToORM(ctx context.Context) (TagORM, error) {
+	to := TagORM{}
+	var err error
+	if prehook, ok := interface{}(m).(TagWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Name = m.Name
+	if posthook, ok := interface{}(m).(TagWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *TagORM) ToPB(ctx context.Context) (Tag, error) {
+	to := Tag{}
+	var err error
+	if prehook, ok := interface{}(m).(TagWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Name = m.Name
+	if posthook, ok := interface{}(m).(TagWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Tag the arg will be the target, the caller the one being converted from
+
+// TagBeforeToORM called before default ToORM code
+type TagWithBeforeToORM interface {
+	BeforeToORM(context.Context, *TagORM) error
+}
+
+// TagAfterToORM called after default ToORM code
+type TagWithAfterToORM interface {
+	AfterToORM(context.Context, *TagORM) error
+}
+
+// TagBeforeToPB called before default ToPB code
+type TagWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Tag) error
+}
+
+// TagAfterToPB called after default ToPB code
+type TagWithAfterToPB interface {
+	AfterToPB(context.Context, *Tag) error
+}
+
+type ProjectORM struct {
+	Description string
+	Id          int64
+	Name        string `gorm:"not null;unique_index:idx_projects_name"`
+}
+
+// TableName overrides the default tablename generated by GORM
+func (ProjectORM) TableName() string {
+	return "projects"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Project) ToORM(ctx context.Context) (ProjectORM, error) {
+	to := ProjectORM{}
+	var err error
+	if prehook, ok := interface{}(m).(ProjectWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Name = m.Name
+	to.Description = m.Description
+	if posthook, ok := interface{}(m).(ProjectWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *ProjectORM) ToPB(ctx context.Context) (Project, error) {
+	to := Project{}
+	var err error
+	if prehook, ok := interface{}(m).(ProjectWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Name = m.Name
+	to.Description = m.Description
+	if posthook, ok := interface{}(m).(ProjectWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Project the arg will be the target, the caller the one being converted from
+
+// ProjectBeforeToORM called before default ToORM code
+type ProjectWithBeforeToORM interface {
+	BeforeToORM(context.Context, *ProjectORM) error
+}
+
+// ProjectAfterToORM called after default ToORM code
+type ProjectWithAfterToORM interface {
+	AfterToORM(context.Context, *ProjectORM) error
+}
+
+// ProjectBeforeToPB called before default ToPB code
+type ProjectWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Project) error
+}
+
+// ProjectAfterToPB called after default ToPB code
+type ProjectWithAfterToPB interface {
+	AfterToPB(context.Context, *Project) error
+}
+
 // DefaultCreateToDo executes a basic gorm create call
 func DefaultCreateToDo(ctx context.Context, in *ToDo, db *gorm1.DB) (*ToDo, error) {
 	if in == nil {
@@ -402,6 +623,30 @@ func DefaultApplyFieldMaskToDo(ctx context.Context, patchee *ToDo, patcher *ToDo
 			patchee.Reminder = patcher.Reminder
 			continue
 		}
+		if f == prefix+"ExternalId" {
+			patchee.ExternalId = patcher.ExternalId
+			continue
+		}
+		if f == prefix+"Recurrence" {
+			patchee.Recurrence = patcher.Recurrence
+			continue
+		}
+		if f == prefix+"Done" {
+			patchee.Done = patcher.Done
+			continue
+		}
+		if f == prefix+"DeletedAt" {
+			patchee.DeletedAt = patcher.DeletedAt
+			continue
+		}
+		if f == prefix+"Tags" {
+			patchee.Tags = patcher.Tags
+			continue
+		}
+		if f == prefix+"ProjectId" {
+			patchee.ProjectId = patcher.ProjectId
+			continue
+		}
 	}
 	if err != nil {
 		return nil, err
@@ -461,3 +706,671 @@ type ToDoORMWithBeforeListFind interface {
 type ToDoORMWithAfterListFind interface {
 	AfterListFind(context.Context, *gorm1.DB, *[]ToDoORM) error
 }
+
+// DefaultCreateTag executes a basic gorm create call
+func DefaultCreateTag(ctx context.Context, in *Tag, db *gorm1.DB) (*Tag, error) {
+	if in == nil {
+		return nil, errors1.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TagORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TagORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TagORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+type TagORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm1.DB) error
+}
+
+// DefaultReadTag executes a basic gorm read call
+func DefaultReadTag(ctx context.Context, in *Tag, db *gorm1.DB) (*Tag, error) {
+	if in == nil {
+		return nil, errors1.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors1.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(TagORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm2.ApplyFieldSelection(ctx, db, nil, &TagORM{}); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TagORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := TagORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(TagORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TagORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+type TagORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+type TagORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm1.DB) error
+}
+
+func DefaultDeleteTag(ctx context.Context, in *Tag, db *gorm1.DB) error {
+	if in == nil {
+		return errors1.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors1.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(TagORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&TagORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(TagORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type TagORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+type TagORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm1.DB) error
+}
+
+func DefaultDeleteTagSet(ctx context.Context, in []*Tag, db *gorm1.DB) error {
+	if in == nil {
+		return errors1.NilArgumentError
+	}
+	var err error
+	keys := []int64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors1.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&TagORM{})).(TagORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&TagORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&TagORM{})).(TagORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type TagORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Tag, *gorm1.DB) (*gorm1.DB, error)
+}
+type TagORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Tag, *gorm1.DB) error
+}
+
+// DefaultStrictUpdateTag clears first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateTag(ctx context.Context, in *Tag, db *gorm1.DB) (*Tag, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateTag")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &TagORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(TagORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(TagORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TagORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type TagORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+type TagORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+type TagORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm1.DB) error
+}
+
+// DefaultPatchTag executes a basic gorm update call with patch behavior
+func DefaultPatchTag(ctx context.Context, in *Tag, updateMask *field_mask1.FieldMask, db *gorm1.DB) (*Tag, error) {
+	if in == nil {
+		return nil, errors1.NilArgumentError
+	}
+	var pbObj Tag
+	var err error
+	if hook, ok := interface{}(&pbObj).(TagWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadTag(ctx, &Tag{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(TagWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskTag(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(TagWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateTag(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(TagWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type TagWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Tag, *field_mask1.FieldMask, *gorm1.DB) (*gorm1.DB, error)
+}
+type TagWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Tag, *field_mask1.FieldMask, *gorm1.DB) (*gorm1.DB, error)
+}
+type TagWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Tag, *field_mask1.FieldMask, *gorm1.DB) (*gorm1.DB, error)
+}
+type TagWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Tag, *field_mask1.FieldMask, *gorm1.DB) error
+}
+
+// DefaultApplyFieldMaskTag patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskTag(ctx context.Context, patchee *Tag, patcher *Tag, updateMask *field_mask1.FieldMask, prefix string, db *gorm1.DB) (*Tag, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors1.NilArgumentError
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"Name" {
+			patchee.Name = patcher.Name
+			continue
+		}
+		if f == prefix+"Count" {
+			patchee.Count = patcher.Count
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListTag executes a gorm list call
+func DefaultListTag(ctx context.Context, db *gorm1.DB) ([]*Tag, error) {
+	in := Tag{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TagORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm2.ApplyCollectionOperators(ctx, db, &TagORM{}, &Tag{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TagORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []TagORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TagORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Tag{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type TagORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+type TagORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+type TagORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm1.DB, *[]TagORM) error
+}
+
+// DefaultCreateProject executes a basic gorm create call
+func DefaultCreateProject(ctx context.Context, in *Project, db *gorm1.DB) (*Project, error) {
+	if in == nil {
+		return nil, errors1.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ProjectORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ProjectORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type ProjectORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+type ProjectORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm1.DB) error
+}
+
+// DefaultReadProject executes a basic gorm read call
+func DefaultReadProject(ctx context.Context, in *Project, db *gorm1.DB) (*Project, error) {
+	if in == nil {
+		return nil, errors1.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors1.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(ProjectORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm2.ApplyFieldSelection(ctx, db, nil, &ProjectORM{}); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ProjectORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := ProjectORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(ProjectORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type ProjectORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+type ProjectORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+type ProjectORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm1.DB) error
+}
+
+func DefaultDeleteProject(ctx context.Context, in *Project, db *gorm1.DB) error {
+	if in == nil {
+		return errors1.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors1.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(ProjectORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&ProjectORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(ProjectORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type ProjectORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+type ProjectORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm1.DB) error
+}
+
+func DefaultDeleteProjectSet(ctx context.Context, in []*Project, db *gorm1.DB) error {
+	if in == nil {
+		return errors1.NilArgumentError
+	}
+	var err error
+	keys := []int64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors1.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&ProjectORM{})).(ProjectORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&ProjectORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&ProjectORM{})).(ProjectORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type ProjectORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Project, *gorm1.DB) (*gorm1.DB, error)
+}
+type ProjectORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Project, *gorm1.DB) error
+}
+
+// DefaultStrictUpdateProject clears first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateProject(ctx context.Context, in *Project, db *gorm1.DB) (*Project, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateProject")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &ProjectORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(ProjectORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(ProjectORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ProjectORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type ProjectORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+type ProjectORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+type ProjectORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm1.DB) error
+}
+
+// DefaultPatchProject executes a basic gorm update call with patch behavior
+func DefaultPatchProject(ctx context.Context, in *Project, updateMask *field_mask1.FieldMask, db *gorm1.DB) (*Project, error) {
+	if in == nil {
+		return nil, errors1.NilArgumentError
+	}
+	var pbObj Project
+	var err error
+	if hook, ok := interface{}(&pbObj).(ProjectWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadProject(ctx, &Project{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(ProjectWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskProject(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(ProjectWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateProject(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(ProjectWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type ProjectWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Project, *field_mask1.FieldMask, *gorm1.DB) (*gorm1.DB, error)
+}
+type ProjectWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Project, *field_mask1.FieldMask, *gorm1.DB) (*gorm1.DB, error)
+}
+type ProjectWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Project, *field_mask1.FieldMask, *gorm1.DB) (*gorm1.DB, error)
+}
+type ProjectWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Project, *field_mask1.FieldMask, *gorm1.DB) error
+}
+
+// DefaultApplyFieldMaskProject patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskProject(ctx context.Context, patchee *Project, patcher *Project, updateMask *field_mask1.FieldMask, prefix string, db *gorm1.DB) (*Project, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors1.NilArgumentError
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"Name" {
+			patchee.Name = patcher.Name
+			continue
+		}
+		if f == prefix+"Description" {
+			patchee.Description = patcher.Description
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListProject executes a gorm list call
+func DefaultListProject(ctx context.Context, db *gorm1.DB) ([]*Project, error) {
+	in := Project{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ProjectORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm2.ApplyCollectionOperators(ctx, db, &ProjectORM{}, &Project{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ProjectORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []ProjectORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ProjectORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Project{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type ProjectORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+type ProjectORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+type ProjectORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm1.DB, *[]ProjectORM) error
+}
@@ -0,0 +1,172 @@
+// Package audit records who changed todo tasks, what was changed and when.
+//
+// Every mutation of a task is recorded in the transaction of the mutation,
+// so the audit log contains a record of every committed change and nothing else.
+package audit
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/grpc-ecosystem/go-grpc-middleware/tags"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/grpc"
+
+	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
+	"go.smartmachine.io/go-grpc-api/pkg/logger"
+)
+
+// Event is audit record of mutation of todo task
+type Event struct {
+	// ID is unique identifier of the record
+	ID int64 `gorm:"primary_key"`
+	// ToDoID is identifier of changed task
+	ToDoID int64 `gorm:"index"`
+	// Actor is authenticated identity of the caller, empty if the caller was not authenticated
+	Actor string `gorm:"index"`
+	// RPC is name of the RPC which made the change, e.g. Update
+	RPC string
+	// RequestID is unique identifier of the request which made the change
+	RequestID string
+	// Before is task before the change in JSON, empty if task was created
+	Before string `gorm:"type:text"`
+	// After is task after the change in JSON, empty if task was deleted
+	After string `gorm:"type:text"`
+	// Time is time of the change
+	Time time.Time `gorm:"index"`
+	// UnverifiedActor is identity declared by the caller which was not authenticated
+	UnverifiedActor string `gorm:"index"`
+}
+
+// TableName returns table of audit records
+func (Event) TableName() string {
+	return "audit_events"
+}
+
+// Migrate creates schema of audit log
+func Migrate(db *gorm.DB) error {
+	return db.AutoMigrate(&Event{}).Error
+}
+
+// Record writes audit record of change of task made by the call of ctx in transaction tx.
+// Before is nil for created task, after is nil for deleted one.
+func Record(ctx context.Context, tx *gorm.DB, before, after *v1.ToDo) error {
+	e := &Event{Time: time.Now().UTC()}
+	if after != nil {
+		e.ToDoID = after.Id
+	} else if before != nil {
+		e.ToDoID = before.Id
+	}
+	if method, ok := grpc.Method(ctx); ok {
+		e.RPC = path.Base(method)
+	}
+	values := grpc_ctxtags.Extract(ctx).Values()
+	// identity declared by the caller is not trusted as actor
+	e.Actor, _ = values[logger.FieldPrincipal].(string)
+	if len(e.Actor) == 0 {
+		e.UnverifiedActor, _ = values[logger.FieldCallerID].(string)
+	}
+	e.RequestID, _ = values[logger.FieldRequestID].(string)
+
+	var err error
+	if e.Before, err = marshal(before); err != nil {
+		return err
+	}
+	if e.After, err = marshal(after); err != nil {
+		return err
+	}
+	if err := tx.Create(e).Error; err != nil {
+		return fmt.Errorf("failed to write audit record: %v", err)
+	}
+	return nil
+}
+
+// Filter selects audit records, zero fields match all records
+type Filter struct {
+	// ToDoID is identifier of changed task
+	ToDoID int64
+	// Actor is authenticated identity of the caller
+	Actor string
+	// UnverifiedActor is identity declared by the caller
+	UnverifiedActor string
+	// Start is start of the time range, inclusive
+	Start time.Time
+	// End is end of the time range, exclusive
+	End time.Time
+}
+
+// List returns at most limit audit records matching filter, newest first
+func List(db *gorm.DB, f Filter, limit int) ([]*Event, error) {
+	if f.ToDoID != 0 {
+		db = db.Where("to_do_id = ?", f.ToDoID)
+	}
+	if len(f.Actor) > 0 {
+		db = db.Where("actor = ?", f.Actor)
+	}
+	if len(f.UnverifiedActor) > 0 {
+		db = db.Where("unverified_actor = ?", f.UnverifiedActor)
+	}
+	if !f.Start.IsZero() {
+		db = db.Where("time >= ?", f.Start.UTC())
+	}
+	if !f.End.IsZero() {
+		db = db.Where("time < ?", f.End.UTC())
+	}
+	var events []*Event
+	if err := db.Order("id desc").Limit(limit).Find(&events).Error; err != nil {
+		return nil, fmt.Errorf("failed to read audit records: %v", err)
+	}
+	return events, nil
+}
+
+// ToPB converts audit record to its API representation
+func (e *Event) ToPB() (*v1.AuditEvent, error) {
+	ts, err := ptypes.TimestampProto(e.Time)
+	if err != nil {
+		return nil, err
+	}
+	pb := &v1.AuditEvent{
+		Id:              e.ID,
+		ToDoId:          e.ToDoID,
+		Actor:           e.Actor,
+		UnverifiedActor: e.UnverifiedActor,
+		Rpc:             e.RPC,
+		RequestId:       e.RequestID,
+		Time:            ts,
+	}
+	if pb.Before, err = unmarshal(e.Before); err != nil {
+		return nil, err
+	}
+	if pb.After, err = unmarshal(e.After); err != nil {
+		return nil, err
+	}
+	return pb, nil
+}
+
+// marshal returns task snapshot in JSON, empty for nil task
+func marshal(td *v1.ToDo) (string, error) {
+	if td == nil {
+		return "", nil
+	}
+	s, err := (&jsonpb.Marshaler{}).MarshalToString(td)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal task snapshot: %v", err)
+	}
+	return s, nil
+}
+
+// unmarshal returns task from JSON snapshot, nil for empty snapshot
+func unmarshal(s string) (*v1.ToDo, error) {
+	if len(s) == 0 {
+		return nil, nil
+	}
+	td := new(v1.ToDo)
+	if err := jsonpb.UnmarshalString(s, td); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal task snapshot: %v", err)
+	}
+	return td, nil
+}
@@ -0,0 +1,312 @@
+// Package client creates gRPC clients of ToDo service with retries of idempotent calls,
+// default deadlines, keepalive, round robin load balancing and optional TLS/token credentials.
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"time"
+
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/resolver"
+	"google.golang.org/grpc/resolver/manual"
+
+	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
+)
+
+const (
+	// DefaultTimeout is deadline of calls made without deadline
+	DefaultTimeout = 5 * time.Second
+	// DefaultRetryMaxAttempts is number of attempts of idempotent calls including the first one
+	DefaultRetryMaxAttempts = 4
+	// DefaultRetryInitialBackoff is backoff before first retry
+	DefaultRetryInitialBackoff = 100 * time.Millisecond
+	// DefaultRetryMaxBackoff is the longest backoff between retries
+	DefaultRetryMaxBackoff = 2 * time.Second
+	// DefaultKeepaliveTime is idle time after which client pings server
+	DefaultKeepaliveTime = 30 * time.Second
+	// DefaultKeepaliveTimeout is time client waits for ping ack before closing connection
+	DefaultKeepaliveTimeout = 10 * time.Second
+
+	// retryBackoffMultiplier is growth factor of backoff between retries
+	retryBackoffMultiplier = 2
+)
+
+// idempotentMethods are methods safe to retry
+var idempotentMethods = []string{
+	"/v1.ToDoService/Read",
+	"/v1.ToDoService/ReadAll",
+}
+
+// Config is configuration of ToDo service client, zero values mean defaults
+type Config struct {
+	// Target is gRPC server address, e.g. localhost:1234, or target with resolver scheme,
+	// e.g. dns:///todo.example.com:1234 resolves all addresses of host
+	Target string
+	// Addresses are server addresses in format host:port, they are used instead of Target
+	Addresses []string
+
+	// Timeout is deadline of calls made with context without deadline
+	Timeout time.Duration
+
+	// RetryMaxAttempts is number of attempts of Read and ReadAll calls failed with Unavailable,
+	// 1 disables retries
+	RetryMaxAttempts int
+	// RetryInitialBackoff is backoff before first retry, it grows exponentially
+	RetryInitialBackoff time.Duration
+	// RetryMaxBackoff is the longest backoff between retries
+	RetryMaxBackoff time.Duration
+
+	// KeepaliveTime is idle time after which client pings server
+	KeepaliveTime time.Duration
+	// KeepaliveTimeout is time client waits for ping ack before closing connection
+	KeepaliveTimeout time.Duration
+
+	// TLS is client TLS configuration, nil means insecure connection
+	TLS *tls.Config
+	// Token is bearer token sent in authorization metadata with every call
+	Token string
+}
+
+// withDefaults returns copy of configuration with defaults set
+func (cfg Config) withDefaults() Config {
+	if cfg.Timeout == 0 {
+		cfg.Timeout = DefaultTimeout
+	}
+	if cfg.RetryMaxAttempts == 0 {
+		cfg.RetryMaxAttempts = DefaultRetryMaxAttempts
+	}
+	if cfg.RetryInitialBackoff == 0 {
+		cfg.RetryInitialBackoff = DefaultRetryInitialBackoff
+	}
+	if cfg.RetryMaxBackoff == 0 {
+		cfg.RetryMaxBackoff = DefaultRetryMaxBackoff
+	}
+	if cfg.KeepaliveTime == 0 {
+		cfg.KeepaliveTime = DefaultKeepaliveTime
+	}
+	if cfg.KeepaliveTimeout == 0 {
+		cfg.KeepaliveTimeout = DefaultKeepaliveTimeout
+	}
+	return cfg
+}
+
+// Client is ToDo service client
+type Client struct {
+	v1.ToDoServiceClient
+
+	conn    *grpc.ClientConn
+	cleanup func()
+}
+
+// Conn returns connection of client, e.g. to create clients of other services
+func (c *Client) Conn() *grpc.ClientConn {
+	return c.conn
+}
+
+// Close closes connection
+func (c *Client) Close() error {
+	err := c.conn.Close()
+	if c.cleanup != nil {
+		c.cleanup()
+	}
+	return err
+}
+
+// Dial creates ToDo service client, it does not wait for connection to be established
+func Dial(ctx context.Context, cfg Config) (*Client, error) {
+	cfg = cfg.withDefaults()
+
+	target := cfg.Target
+	var cleanup func()
+	if len(cfg.Addresses) > 0 {
+		// manual resolver lets round robin balance across given addresses
+		var r *manual.Resolver
+		r, cleanup = manual.GenerateAndRegisterManualResolver()
+		addrs := make([]resolver.Address, 0, len(cfg.Addresses))
+		for _, a := range cfg.Addresses {
+			addrs = append(addrs, resolver.Address{Addr: a})
+		}
+		r.InitialState(resolver.State{Addresses: addrs})
+		target = r.Scheme() + ":///todo"
+	}
+	if len(target) == 0 {
+		return nil, fmt.Errorf("server address is not set")
+	}
+
+	opts, err := dialOptions(cfg)
+	if err != nil {
+		if cleanup != nil {
+			cleanup()
+		}
+		return nil, err
+	}
+
+	conn, err := grpc.DialContext(ctx, target, opts...)
+	if err != nil {
+		if cleanup != nil {
+			cleanup()
+		}
+		return nil, fmt.Errorf("failed to dial '%s': %v", target, err)
+	}
+
+	return &Client{
+		ToDoServiceClient: v1.NewToDoServiceClient(conn),
+		conn:              conn,
+		cleanup:           cleanup,
+	}, nil
+}
+
+// dialOptions converts configuration to dial options
+func dialOptions(cfg Config) ([]grpc.DialOption, error) {
+	sc, err := serviceConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := []grpc.DialOption{
+		grpc.WithDefaultServiceConfig(sc),
+		grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:    cfg.KeepaliveTime,
+			Timeout: cfg.KeepaliveTimeout,
+		}),
+	}
+
+	if cfg.TLS != nil {
+		opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(cfg.TLS)))
+	} else {
+		opts = append(opts, grpc.WithInsecure())
+	}
+	if len(cfg.Token) > 0 {
+		opts = append(opts, grpc.WithPerRPCCredentials(tokenCredentials{token: cfg.Token, secure: cfg.TLS != nil}))
+	}
+
+	// deadline is set before retry interceptor, so it limits all attempts together
+	unary := []grpc.UnaryClientInterceptor{defaultDeadline(cfg.Timeout)}
+	if !retryEnabled() && cfg.RetryMaxAttempts > 1 {
+		unary = append(unary, retryInterceptor(cfg))
+	}
+	opts = append(opts, grpc.WithUnaryInterceptor(grpc_middleware.ChainUnaryClient(unary...)))
+	return opts, nil
+}
+
+// serviceConfig returns service config with round robin balancing and retry policy of idempotent methods
+func serviceConfig(cfg Config) (string, error) {
+	if cfg.RetryMaxAttempts < 1 {
+		return "", fmt.Errorf("invalid retry max attempts: %d", cfg.RetryMaxAttempts)
+	}
+	if cfg.RetryMaxAttempts == 1 {
+		return `{"loadBalancingPolicy":"round_robin"}`, nil
+	}
+
+	names := make([]string, 0, len(idempotentMethods))
+	for _, m := range idempotentMethods {
+		i := strings.LastIndex(m, "/")
+		names = append(names, fmt.Sprintf(`{"service":"%s","method":"%s"}`, m[1:i], m[i+1:]))
+	}
+	return fmt.Sprintf(`{
+	"loadBalancingPolicy": "round_robin",
+	"methodConfig": [{
+		"name": [%s],
+		"retryPolicy": {
+			"maxAttempts": %d,
+			"initialBackoff": "%.3fs",
+			"maxBackoff": "%.3fs",
+			"backoffMultiplier": %d,
+			"retryableStatusCodes": ["UNAVAILABLE"]
+		}
+	}]
+}`, strings.Join(names, ","), cfg.RetryMaxAttempts, cfg.RetryInitialBackoff.Seconds(), cfg.RetryMaxBackoff.Seconds(),
+		retryBackoffMultiplier), nil
+}
+
+// retryEnabled tells if gRPC applies retry policy of service config,
+// this version of gRPC does it only if GRPC_GO_RETRY environment variable is "on"
+func retryEnabled() bool {
+	return strings.EqualFold(os.Getenv("GRPC_GO_RETRY"), "on")
+}
+
+// retryInterceptor retries idempotent calls with the same policy as service config
+// while gRPC does not apply retry policy of service config itself
+func retryInterceptor(cfg Config) grpc.UnaryClientInterceptor {
+	retry := grpc_retry.UnaryClientInterceptor(
+		grpc_retry.WithMax(uint(cfg.RetryMaxAttempts)),
+		grpc_retry.WithCodes(codes.Unavailable),
+		grpc_retry.WithBackoff(func(attempt uint) time.Duration {
+			d := cfg.RetryInitialBackoff
+			for i := uint(1); i < attempt && d < cfg.RetryMaxBackoff; i++ {
+				d *= retryBackoffMultiplier
+			}
+			if d > cfg.RetryMaxBackoff {
+				d = cfg.RetryMaxBackoff
+			}
+			return d
+		}),
+	)
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		for _, m := range idempotentMethods {
+			if m == method {
+				return retry(ctx, method, req, reply, cc, invoker, opts...)
+			}
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// defaultDeadline sets deadline of calls made with context without deadline
+func defaultDeadline(timeout time.Duration) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if _, ok := ctx.Deadline(); !ok {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// tokenCredentials sends bearer token with every call
+type tokenCredentials struct {
+	token  string
+	secure bool
+}
+
+// GetRequestMetadata returns authorization metadata
+func (t tokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "bearer " + t.token}, nil
+}
+
+// RequireTransportSecurity tells if token may be sent over insecure connection
+func (t tokenCredentials) RequireTransportSecurity() bool {
+	return t.secure
+}
+
+// LoadTLSConfig returns client TLS configuration verifying server with CA certificates
+// from PEM file, system CA pool is used if caFile is empty
+func LoadTLSConfig(caFile, serverName string) (*tls.Config, error) {
+	cfg := &tls.Config{ServerName: serverName}
+	if len(caFile) > 0 {
+		b, err := ioutil.ReadFile(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA file: %v", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(b) {
+			return nil, fmt.Errorf("no certificates found in CA file '%s'", caFile)
+		}
+		cfg.RootCAs = pool
+	}
+	return cfg, nil
+}
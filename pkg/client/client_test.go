@@ -0,0 +1,189 @@
+package client
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
+)
+
+// fakeServer counts calls and fails first calls with Unavailable
+type fakeServer struct {
+	v1.ToDoServiceServer
+
+	mu          sync.Mutex
+	calls       int
+	failures    int
+	hasDeadline bool
+	auth        []string
+}
+
+func (s *fakeServer) call(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls++
+	_, s.hasDeadline = ctx.Deadline()
+	md, _ := metadata.FromIncomingContext(ctx)
+	s.auth = md.Get("authorization")
+	if s.failures > 0 {
+		s.failures--
+		return status.Error(codes.Unavailable, "try again")
+	}
+	return nil
+}
+
+// fail makes next calls fail with Unavailable
+func (s *fakeServer) fail(failures int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures = failures
+}
+
+// called tells if server was called
+func (s *fakeServer) called() bool {
+	return s.count() > 0
+}
+
+// count returns number of calls of server
+func (s *fakeServer) count() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.calls
+}
+
+func (s *fakeServer) Read(ctx context.Context, req *v1.ReadRequest) (*v1.ReadResponse, error) {
+	if err := s.call(ctx); err != nil {
+		return nil, err
+	}
+	return &v1.ReadResponse{Api: "v1", ToDo: &v1.ToDo{Id: req.Id}}, nil
+}
+
+func (s *fakeServer) Create(ctx context.Context, req *v1.CreateRequest) (*v1.CreateResponse, error) {
+	if err := s.call(ctx); err != nil {
+		return nil, err
+	}
+	return &v1.CreateResponse{Api: "v1", Id: 1}, nil
+}
+
+// startServer starts gRPC server on random port
+func startServer(t *testing.T, s *fakeServer) (string, func()) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := grpc.NewServer()
+	v1.RegisterToDoServiceServer(srv, s)
+	go func() {
+		_ = srv.Serve(lis)
+	}()
+	return lis.Addr().String(), srv.Stop
+}
+
+func TestDial(t *testing.T) {
+	s1, s2 := &fakeServer{}, &fakeServer{}
+	addr1, stop1 := startServer(t, s1)
+	defer stop1()
+	addr2, stop2 := startServer(t, s2)
+	defer stop2()
+
+	c, err := Dial(context.Background(), Config{
+		Addresses:           []string{addr1, addr2},
+		Token:               "token",
+		RetryInitialBackoff: time.Millisecond,
+	})
+	if err != nil {
+		t.Fatalf("Dial() error = %v", err)
+	}
+	defer c.Close()
+
+	t.Run("Round robin", func(t *testing.T) {
+		// wait for both connections to be ready, round robin skips connecting addresses
+		deadline := time.Now().Add(5 * time.Second)
+		for !s1.called() || !s2.called() {
+			if time.Now().After(deadline) {
+				t.Fatalf("calls are not balanced: %v, %v", s1.called(), s2.called())
+			}
+			if _, err := c.Read(context.Background(), &v1.ReadRequest{Api: "v1", Id: 1}); err != nil {
+				t.Fatalf("Read() error = %v", err)
+			}
+		}
+	})
+
+	t.Run("Default deadline and token", func(t *testing.T) {
+		if _, err := c.Create(context.Background(), &v1.CreateRequest{Api: "v1"}); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+		for _, s := range []*fakeServer{s1, s2} {
+			s.mu.Lock()
+			if !s.hasDeadline || len(s.auth) != 1 || s.auth[0] != "bearer token" {
+				t.Errorf("deadline = %v, authorization = %v", s.hasDeadline, s.auth)
+			}
+			s.mu.Unlock()
+		}
+	})
+
+	t.Run("Retry idempotent call", func(t *testing.T) {
+		s1.fail(1)
+		s2.fail(1)
+		if _, err := c.Read(context.Background(), &v1.ReadRequest{Api: "v1", Id: 1}); err != nil {
+			t.Errorf("Read() error = %v", err)
+		}
+	})
+
+	t.Run("No retry of Create", func(t *testing.T) {
+		s1.fail(1)
+		s2.fail(1)
+		if _, err := c.Create(context.Background(), &v1.CreateRequest{Api: "v1"}); status.Code(err) != codes.Unavailable {
+			t.Errorf("Create() error = %v, want Unavailable", err)
+		}
+		s1.fail(0)
+		s2.fail(0)
+	})
+}
+
+func TestDial_RetryMaxAttempts(t *testing.T) {
+	s := &fakeServer{}
+	addr, stop := startServer(t, s)
+	defer stop()
+
+	for _, attempts := range []int{2, DefaultRetryMaxAttempts} {
+		c, err := Dial(context.Background(), Config{
+			Addresses:           []string{addr},
+			RetryMaxAttempts:    attempts,
+			RetryInitialBackoff: time.Millisecond,
+		})
+		if err != nil {
+			t.Fatalf("Dial() error = %v", err)
+		}
+
+		// the last allowed attempt succeeds
+		s.fail(attempts - 1)
+		before := s.count()
+		if _, err := c.Read(context.Background(), &v1.ReadRequest{Api: "v1", Id: 1}); err != nil {
+			t.Errorf("%d attempts: Read() error = %v", attempts, err)
+		}
+		if got := s.count() - before; got != attempts {
+			t.Errorf("%d attempts: server called %d times", attempts, got)
+		}
+
+		// no attempt is made after the last one fails
+		s.fail(attempts)
+		before = s.count()
+		if _, err := c.Read(context.Background(), &v1.ReadRequest{Api: "v1", Id: 1}); status.Code(err) != codes.Unavailable {
+			t.Errorf("%d attempts: Read() error = %v, want Unavailable", attempts, err)
+		}
+		if got := s.count() - before; got != attempts {
+			t.Errorf("%d attempts: server called %d times", attempts, got)
+		}
+		s.fail(0)
+		c.Close()
+	}
+}
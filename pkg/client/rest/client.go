@@ -0,0 +1,565 @@
+// Package rest is Go client of ToDo service talking to HTTP/REST gateway.
+// It implements v1.ToDoServiceClient, so callers can switch from gRPC to HTTP/JSON
+// by replacing v1.NewToDoServiceClient with NewToDoServiceClient.
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
+)
+
+const (
+	// metadataHeaderPrefix is prefix of HTTP headers gateway maps to and from gRPC metadata
+	metadataHeaderPrefix = "Grpc-Metadata-"
+
+	// maxErrorBody is the largest error response body read
+	maxErrorBody = 64 << 10
+)
+
+// toDoServiceClient is implementation of v1.ToDoServiceClient over HTTP/REST gateway
+type toDoServiceClient struct {
+	baseURL     string
+	httpClient  *http.Client
+	marshaler   jsonpb.Marshaler
+	unmarshaler jsonpb.Unmarshaler
+}
+
+// NewToDoServiceClient creates ToDo service client calling HTTP/REST gateway at baseURL,
+// e.g. http://localhost:8080. http.DefaultClient is used if httpClient is nil.
+//
+// Outgoing gRPC metadata of call context is sent as HTTP headers and errors
+// are returned as gRPC status errors, the same as v1.NewToDoServiceClient does.
+// Call options are ignored except grpc.Header which receives response metadata.
+func NewToDoServiceClient(baseURL string, httpClient *http.Client) v1.ToDoServiceClient {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &toDoServiceClient{
+		baseURL:     strings.TrimRight(baseURL, "/"),
+		httpClient:  httpClient,
+		marshaler:   jsonpb.Marshaler{OrigName: true},
+		unmarshaler: jsonpb.Unmarshaler{AllowUnknownFields: true},
+	}
+}
+
+// Create new todo task
+func (c *toDoServiceClient) Create(ctx context.Context, in *v1.CreateRequest, opts ...grpc.CallOption) (*v1.CreateResponse, error) {
+	out := new(v1.CreateResponse)
+	err := c.invoke(ctx, http.MethodPost, "/v1/todo", nil, in, out, opts)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Read todo task
+func (c *toDoServiceClient) Read(ctx context.Context, in *v1.ReadRequest, opts ...grpc.CallOption) (*v1.ReadResponse, error) {
+	out := new(v1.ReadResponse)
+	err := c.invoke(ctx, http.MethodGet, "/v1/todo/"+strconv.FormatInt(in.Id, 10), showDeletedQuery(in.Api, in.ShowDeleted), nil, out, opts)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Update todo task
+func (c *toDoServiceClient) Update(ctx context.Context, in *v1.UpdateRequest, opts ...grpc.CallOption) (*v1.UpdateResponse, error) {
+	out := new(v1.UpdateResponse)
+	err := c.invoke(ctx, http.MethodPut, "/v1/todo/"+strconv.FormatInt(in.GetToDo().GetId(), 10), nil, in, out, opts)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Delete todo task
+func (c *toDoServiceClient) Delete(ctx context.Context, in *v1.DeleteRequest, opts ...grpc.CallOption) (*v1.DeleteResponse, error) {
+	out := new(v1.DeleteResponse)
+	err := c.invoke(ctx, http.MethodDelete, "/v1/todo/"+strconv.FormatInt(in.Id, 10), apiQuery(in.Api), nil, out, opts)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Complete todo task
+func (c *toDoServiceClient) Complete(ctx context.Context, in *v1.CompleteRequest, opts ...grpc.CallOption) (*v1.CompleteResponse, error) {
+	out := new(v1.CompleteResponse)
+	err := c.invoke(ctx, http.MethodPost, "/v1/todo/"+strconv.FormatInt(in.Id, 10)+":complete", nil, in, out, opts)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Undelete restores todo task from trash
+func (c *toDoServiceClient) Undelete(ctx context.Context, in *v1.UndeleteRequest, opts ...grpc.CallOption) (*v1.UndeleteResponse, error) {
+	out := new(v1.UndeleteResponse)
+	err := c.invoke(ctx, http.MethodPost, "/v1/todo/"+strconv.FormatInt(in.Id, 10)+":undelete", nil, in, out, opts)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ListDeleted lists todo tasks in trash
+func (c *toDoServiceClient) ListDeleted(ctx context.Context, in *v1.ListDeletedRequest, opts ...grpc.CallOption) (*v1.ListDeletedResponse, error) {
+	out := new(v1.ListDeletedResponse)
+	err := c.invoke(ctx, http.MethodGet, "/v1/todo:deleted", apiQuery(in.Api), nil, out, opts)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ListOccurrences lists occurrences of todo tasks in time window
+func (c *toDoServiceClient) ListOccurrences(ctx context.Context, in *v1.ListOccurrencesRequest, opts ...grpc.CallOption) (*v1.ListOccurrencesResponse, error) {
+	query := apiQuery(in.Api)
+	if query == nil {
+		query = url.Values{}
+	}
+	if in.Start != nil {
+		query.Set("start", ptypes.TimestampString(in.Start))
+	}
+	if in.End != nil {
+		query.Set("end", ptypes.TimestampString(in.End))
+	}
+	for _, id := range in.Ids {
+		query.Add("ids", strconv.FormatInt(id, 10))
+	}
+
+	out := new(v1.ListOccurrencesResponse)
+	err := c.invoke(ctx, http.MethodGet, "/v1/todo:occurrences", query, nil, out, opts)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ListTags lists tags with number of tasks they label
+func (c *toDoServiceClient) ListTags(ctx context.Context, in *v1.ListTagsRequest, opts ...grpc.CallOption) (*v1.ListTagsResponse, error) {
+	out := new(v1.ListTagsResponse)
+	err := c.invoke(ctx, http.MethodGet, "/v1/todo:tags", apiQuery(in.Api), nil, out, opts)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ListRevisions lists revisions of todo task
+func (c *toDoServiceClient) ListRevisions(ctx context.Context, in *v1.ListRevisionsRequest, opts ...grpc.CallOption) (*v1.ListRevisionsResponse, error) {
+	out := new(v1.ListRevisionsResponse)
+	err := c.invoke(ctx, http.MethodGet, "/v1/todo/"+strconv.FormatInt(in.Id, 10)+"/revisions", apiQuery(in.Api), nil, out, opts)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetRevision reads revision of todo task
+func (c *toDoServiceClient) GetRevision(ctx context.Context, in *v1.GetRevisionRequest, opts ...grpc.CallOption) (*v1.GetRevisionResponse, error) {
+	out := new(v1.GetRevisionResponse)
+	err := c.invoke(ctx, http.MethodGet, "/v1/todo/"+strconv.FormatInt(in.Id, 10)+"/revisions/"+strconv.FormatInt(in.Revision, 10),
+		apiQuery(in.Api), nil, out, opts)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RestoreRevision reverts todo task to its revision
+func (c *toDoServiceClient) RestoreRevision(ctx context.Context, in *v1.RestoreRevisionRequest, opts ...grpc.CallOption) (*v1.RestoreRevisionResponse, error) {
+	out := new(v1.RestoreRevisionResponse)
+	err := c.invoke(ctx, http.MethodPost, "/v1/todo/"+strconv.FormatInt(in.Id, 10)+"/revisions/"+strconv.FormatInt(in.Revision, 10)+":restore",
+		nil, in, out, opts)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ListAuditEvents lists audit records of mutations of todo tasks
+func (c *toDoServiceClient) ListAuditEvents(ctx context.Context, in *v1.ListAuditEventsRequest, opts ...grpc.CallOption) (*v1.ListAuditEventsResponse, error) {
+	query := apiQuery(in.Api)
+	if query == nil {
+		query = url.Values{}
+	}
+	if in.ToDoId != 0 {
+		query.Set("toDoId", strconv.FormatInt(in.ToDoId, 10))
+	}
+	if len(in.Actor) > 0 {
+		query.Set("actor", in.Actor)
+	}
+	if len(in.UnverifiedActor) > 0 {
+		query.Set("unverifiedActor", in.UnverifiedActor)
+	}
+	if in.Start != nil {
+		query.Set("start", ptypes.TimestampString(in.Start))
+	}
+	if in.End != nil {
+		query.Set("end", ptypes.TimestampString(in.End))
+	}
+
+	out := new(v1.ListAuditEventsResponse)
+	err := c.invoke(ctx, http.MethodGet, "/v1/todo:audit", query, nil, out, opts)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReadAll todo tasks
+func (c *toDoServiceClient) ReadAll(ctx context.Context, in *v1.ReadAllRequest, opts ...grpc.CallOption) (*v1.ReadAllResponse, error) {
+	query := showDeletedQuery(in.Api, in.ShowDeleted)
+	if len(in.Tags) > 0 {
+		if query == nil {
+			query = url.Values{}
+		}
+		query["tags"] = in.Tags
+	}
+
+	path := "/v1/todo/all"
+	if in.ProjectId != 0 {
+		path = "/v1/projects/" + strconv.FormatInt(in.ProjectId, 10) + "/todos"
+	}
+
+	out := new(v1.ReadAllResponse)
+	err := c.invoke(ctx, http.MethodGet, path, query, nil, out, opts)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CreateProject creates new project
+func (c *toDoServiceClient) CreateProject(ctx context.Context, in *v1.CreateProjectRequest, opts ...grpc.CallOption) (*v1.CreateProjectResponse, error) {
+	out := new(v1.CreateProjectResponse)
+	err := c.invoke(ctx, http.MethodPost, "/v1/projects", nil, in, out, opts)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReadProject reads project
+func (c *toDoServiceClient) ReadProject(ctx context.Context, in *v1.ReadProjectRequest, opts ...grpc.CallOption) (*v1.ReadProjectResponse, error) {
+	out := new(v1.ReadProjectResponse)
+	err := c.invoke(ctx, http.MethodGet, "/v1/projects/"+strconv.FormatInt(in.Id, 10), apiQuery(in.Api), nil, out, opts)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UpdateProject updates project
+func (c *toDoServiceClient) UpdateProject(ctx context.Context, in *v1.UpdateProjectRequest, opts ...grpc.CallOption) (*v1.UpdateProjectResponse, error) {
+	out := new(v1.UpdateProjectResponse)
+	err := c.invoke(ctx, http.MethodPut, "/v1/projects/"+strconv.FormatInt(in.GetProject().GetId(), 10), nil, in, out, opts)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeleteProject deletes project, its tasks restrict the delete or are moved to trash by mode
+func (c *toDoServiceClient) DeleteProject(ctx context.Context, in *v1.DeleteProjectRequest, opts ...grpc.CallOption) (*v1.DeleteProjectResponse, error) {
+	query := apiQuery(in.Api)
+	if in.Mode != v1.ProjectDeleteMode_RESTRICT {
+		if query == nil {
+			query = url.Values{}
+		}
+		query.Set("mode", in.Mode.String())
+	}
+
+	out := new(v1.DeleteProjectResponse)
+	err := c.invoke(ctx, http.MethodDelete, "/v1/projects/"+strconv.FormatInt(in.Id, 10), query, nil, out, opts)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ListProjects lists all projects
+func (c *toDoServiceClient) ListProjects(ctx context.Context, in *v1.ListProjectsRequest, opts ...grpc.CallOption) (*v1.ListProjectsResponse, error) {
+	out := new(v1.ListProjectsResponse)
+	err := c.invoke(ctx, http.MethodGet, "/v1/projects", apiQuery(in.Api), nil, out, opts)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BatchCreate creates todo tasks in one transaction
+func (c *toDoServiceClient) BatchCreate(ctx context.Context, in *v1.BatchCreateRequest, opts ...grpc.CallOption) (*v1.BatchCreateResponse, error) {
+	out := new(v1.BatchCreateResponse)
+	err := c.invoke(ctx, http.MethodPost, "/v1/todo:batchCreate", nil, in, out, opts)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BatchUpdate updates todo tasks in one transaction
+func (c *toDoServiceClient) BatchUpdate(ctx context.Context, in *v1.BatchUpdateRequest, opts ...grpc.CallOption) (*v1.BatchUpdateResponse, error) {
+	out := new(v1.BatchUpdateResponse)
+	err := c.invoke(ctx, http.MethodPost, "/v1/todo:batchUpdate", nil, in, out, opts)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BatchDelete deletes todo tasks in one transaction
+func (c *toDoServiceClient) BatchDelete(ctx context.Context, in *v1.BatchDeleteRequest, opts ...grpc.CallOption) (*v1.BatchDeleteResponse, error) {
+	out := new(v1.BatchDeleteResponse)
+	err := c.invoke(ctx, http.MethodPost, "/v1/todo:batchDelete", nil, in, out, opts)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ImportFile imports todo tasks from CSV or iCalendar file, the file is sent as request body
+func (c *toDoServiceClient) ImportFile(ctx context.Context, in *v1.ImportFileRequest, opts ...grpc.CallOption) (*v1.ImportFileResponse, error) {
+	query := apiQuery(in.Api)
+	if query == nil {
+		query = url.Values{}
+	}
+	for name, value := range map[string]string{
+		"format":              in.Format,
+		"columns.title":       in.GetColumns().GetTitle(),
+		"columns.description": in.GetColumns().GetDescription(),
+		"columns.reminder":    in.GetColumns().GetReminder(),
+		"columns.externalId":  in.GetColumns().GetExternalId(),
+	} {
+		if len(value) > 0 {
+			query.Set(name, value)
+		}
+	}
+	if in.DryRun {
+		query.Set("dryRun", "true")
+	}
+	contentType := in.GetFile().GetContentType()
+	if len(contentType) == 0 {
+		contentType = "application/octet-stream"
+	}
+
+	out := new(v1.ImportFileResponse)
+	err := c.send(ctx, http.MethodPost, "/v1/todo:importFile", query, bytes.NewReader(in.GetFile().GetData()), contentType, out, opts)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// apiQuery passes API version of requests without body as query parameter
+func apiQuery(api string) url.Values {
+	if len(api) == 0 {
+		return nil
+	}
+	return url.Values{"api": []string{api}}
+}
+
+// showDeletedQuery returns query with api version and showDeleted flag
+func showDeletedQuery(api string, showDeleted bool) url.Values {
+	query := apiQuery(api)
+	if showDeleted {
+		if query == nil {
+			query = url.Values{}
+		}
+		query.Set("showDeleted", "true")
+	}
+	return query
+}
+
+// invoke sends request to gateway and decodes response to out
+func (c *toDoServiceClient) invoke(ctx context.Context, method, path string, query url.Values,
+	in, out proto.Message, opts []grpc.CallOption) error {
+	var body io.Reader
+	var contentType string
+	if in != nil {
+		var b bytes.Buffer
+		if err := c.marshaler.Marshal(&b, in); err != nil {
+			return status.Errorf(codes.Internal, "failed to marshal request: %v", err)
+		}
+		body, contentType = &b, "application/json"
+	}
+	return c.send(ctx, method, path, query, body, contentType, out, opts)
+}
+
+// send sends request with body of contentType to gateway and decodes response to out
+func (c *toDoServiceClient) send(ctx context.Context, method, path string, query url.Values,
+	body io.Reader, contentType string, out proto.Message, opts []grpc.CallOption) error {
+	u := c.baseURL + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+
+	req, err := http.NewRequest(method, u, body)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to create request: %v", err)
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Accept", "application/json")
+	if len(contentType) > 0 {
+		req.Header.Set("Content-Type", contentType)
+	}
+	setMetadataHeaders(ctx, req.Header)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return transportError(ctx, err)
+	}
+	return c.readResponse(resp, out, opts)
+}
+
+// readResponse decodes response to out and closes it
+func (c *toDoServiceClient) readResponse(resp *http.Response, out proto.Message, opts []grpc.CallOption) error {
+	defer resp.Body.Close()
+
+	setHeaderOptions(resp.Header, opts)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return responseError(resp)
+	}
+	if err := c.unmarshaler.Unmarshal(resp.Body, out); err != nil {
+		return status.Errorf(codes.Internal, "failed to unmarshal response: %v", err)
+	}
+	return nil
+}
+
+// setMetadataHeaders sends outgoing gRPC metadata as HTTP headers gateway maps back to metadata
+func setMetadataHeaders(ctx context.Context, h http.Header) {
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if !ok {
+		return
+	}
+	for key, values := range md {
+		if strings.HasSuffix(key, "-bin") {
+			// binary metadata is not forwarded by gateway
+			continue
+		}
+		name := metadataHeaderPrefix + key
+		if key == "authorization" {
+			name = "Authorization"
+		}
+		for _, v := range values {
+			h.Add(name, v)
+		}
+	}
+}
+
+// setHeaderOptions passes response metadata to grpc.Header call options
+func setHeaderOptions(h http.Header, opts []grpc.CallOption) {
+	var md metadata.MD
+	for _, opt := range opts {
+		o, ok := opt.(grpc.HeaderCallOption)
+		if !ok {
+			continue
+		}
+		if md == nil {
+			md = metadata.MD{}
+			for name, values := range h {
+				if strings.HasPrefix(name, metadataHeaderPrefix) {
+					md.Append(strings.TrimPrefix(name, metadataHeaderPrefix), values...)
+				}
+			}
+		}
+		*o.HeaderAddr = md
+	}
+}
+
+// transportError converts error of HTTP client to gRPC status error
+func transportError(ctx context.Context, err error) error {
+	switch ctx.Err() {
+	case context.DeadlineExceeded:
+		return status.Error(codes.DeadlineExceeded, ctx.Err().Error())
+	case context.Canceled:
+		return status.Error(codes.Canceled, ctx.Err().Error())
+	}
+	return status.Errorf(codes.Unavailable, "failed to call HTTP gateway: %v", err)
+}
+
+// errorBody is error returned by gateway, it is google.rpc.Status with extra error field
+type errorBody struct {
+	Error   string `json:"error"`
+	Message string `json:"message"`
+	Code    *int32 `json:"code"`
+}
+
+// responseError converts error response of gateway to gRPC status error
+func responseError(resp *http.Response) error {
+	b, _ := ioutil.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+
+	// details are kept when their types are known
+	var s spb.Status
+	u := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err := u.Unmarshal(bytes.NewReader(b), &s); err == nil && s.Code != int32(codes.OK) {
+		return status.ErrorProto(&s)
+	}
+
+	var e errorBody
+	if err := json.Unmarshal(b, &e); err == nil && e.Code != nil && *e.Code != int32(codes.OK) {
+		msg := e.Message
+		if len(msg) == 0 {
+			msg = e.Error
+		}
+		return status.Error(codes.Code(*e.Code), msg)
+	}
+
+	msg := strings.TrimSpace(string(b))
+	if len(msg) == 0 {
+		msg = http.StatusText(resp.StatusCode)
+	}
+	return status.Errorf(codeFromHTTPStatus(resp.StatusCode), "HTTP %d: %s", resp.StatusCode, msg)
+}
+
+// codeFromHTTPStatus maps HTTP status to gRPC code, it is inverse of runtime.HTTPStatusFromCode
+func codeFromHTTPStatus(code int) codes.Code {
+	switch code {
+	case http.StatusBadRequest:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusConflict:
+		return codes.Aborted
+	case http.StatusPreconditionFailed:
+		return codes.FailedPrecondition
+	case http.StatusRequestedRangeNotSatisfiable:
+		return codes.OutOfRange
+	case http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	case http.StatusNotImplemented:
+		return codes.Unimplemented
+	case http.StatusServiceUnavailable, http.StatusBadGateway:
+		return codes.Unavailable
+	case http.StatusGatewayTimeout:
+		return codes.DeadlineExceeded
+	}
+	if code >= 500 {
+		return codes.Internal
+	}
+	return codes.Unknown
+}
@@ -0,0 +1,182 @@
+package rest
+
+import (
+	"context"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
+)
+
+// fakeClient is called by gateway and records requests
+type fakeClient struct {
+	v1.ToDoServiceClient
+	req proto.Message
+	md  metadata.MD
+	err error
+}
+
+func (f *fakeClient) record(ctx context.Context, req proto.Message) error {
+	f.req = req
+	f.md, _ = metadata.FromOutgoingContext(ctx)
+	return f.err
+}
+
+func (f *fakeClient) Create(ctx context.Context, in *v1.CreateRequest, opts ...grpc.CallOption) (*v1.CreateResponse, error) {
+	if err := f.record(ctx, in); err != nil {
+		return nil, err
+	}
+	return &v1.CreateResponse{Api: "v1", Id: 1}, nil
+}
+
+func (f *fakeClient) Read(ctx context.Context, in *v1.ReadRequest, opts ...grpc.CallOption) (*v1.ReadResponse, error) {
+	if err := f.record(ctx, in); err != nil {
+		return nil, err
+	}
+	return &v1.ReadResponse{Api: "v1", ToDo: &v1.ToDo{Id: in.Id, Title: "title"}}, nil
+}
+
+func (f *fakeClient) Update(ctx context.Context, in *v1.UpdateRequest, opts ...grpc.CallOption) (*v1.UpdateResponse, error) {
+	if err := f.record(ctx, in); err != nil {
+		return nil, err
+	}
+	return &v1.UpdateResponse{Api: "v1", Updated: 1}, nil
+}
+
+func (f *fakeClient) Delete(ctx context.Context, in *v1.DeleteRequest, opts ...grpc.CallOption) (*v1.DeleteResponse, error) {
+	if err := f.record(ctx, in); err != nil {
+		return nil, err
+	}
+	return &v1.DeleteResponse{Api: "v1", Deleted: 1}, nil
+}
+
+func (f *fakeClient) ReadAll(ctx context.Context, in *v1.ReadAllRequest, opts ...grpc.CallOption) (*v1.ReadAllResponse, error) {
+	if err := f.record(ctx, in); err != nil {
+		return nil, err
+	}
+	return &v1.ReadAllResponse{Api: "v1", ToDos: []*v1.ToDo{{Id: 1}, {Id: 2}}}, nil
+}
+
+func TestToDoServiceClient(t *testing.T) {
+	fake := &fakeClient{}
+	mux := runtime.NewServeMux()
+	if err := v1.RegisterToDoServiceHandlerClient(context.Background(), mux, fake); err != nil {
+		t.Fatal(err)
+	}
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	c := NewToDoServiceClient(srv.URL, srv.Client())
+
+	tests := []struct {
+		name    string
+		call    func(ctx context.Context) (proto.Message, error)
+		err     error
+		wantReq proto.Message
+		want    proto.Message
+		wantErr codes.Code
+	}{
+		{
+			name: "Create",
+			call: func(ctx context.Context) (proto.Message, error) {
+				return c.Create(ctx, &v1.CreateRequest{Api: "v1", ToDo: &v1.ToDo{Title: "title"}})
+			},
+			wantReq: &v1.CreateRequest{Api: "v1", ToDo: &v1.ToDo{Title: "title"}},
+			want:    &v1.CreateResponse{Api: "v1", Id: 1},
+		},
+		{
+			name: "Read",
+			call: func(ctx context.Context) (proto.Message, error) {
+				return c.Read(ctx, &v1.ReadRequest{Api: "v1", Id: 5})
+			},
+			wantReq: &v1.ReadRequest{Api: "v1", Id: 5},
+			want:    &v1.ReadResponse{Api: "v1", ToDo: &v1.ToDo{Id: 5, Title: "title"}},
+		},
+		{
+			name: "Update",
+			call: func(ctx context.Context) (proto.Message, error) {
+				return c.Update(ctx, &v1.UpdateRequest{Api: "v1", ToDo: &v1.ToDo{Id: 5, Title: "new"}})
+			},
+			wantReq: &v1.UpdateRequest{Api: "v1", ToDo: &v1.ToDo{Id: 5, Title: "new"}},
+			want:    &v1.UpdateResponse{Api: "v1", Updated: 1},
+		},
+		{
+			name: "Delete",
+			call: func(ctx context.Context) (proto.Message, error) {
+				return c.Delete(ctx, &v1.DeleteRequest{Api: "v1", Id: 5})
+			},
+			wantReq: &v1.DeleteRequest{Api: "v1", Id: 5},
+			want:    &v1.DeleteResponse{Api: "v1", Deleted: 1},
+		},
+		{
+			name: "ReadAll",
+			call: func(ctx context.Context) (proto.Message, error) {
+				return c.ReadAll(ctx, &v1.ReadAllRequest{Api: "v1"})
+			},
+			wantReq: &v1.ReadAllRequest{Api: "v1"},
+			want:    &v1.ReadAllResponse{Api: "v1", ToDos: []*v1.ToDo{{Id: 1}, {Id: 2}}},
+		},
+		{
+			name: "NotFound",
+			call: func(ctx context.Context) (proto.Message, error) {
+				return c.Read(ctx, &v1.ReadRequest{Api: "v1", Id: 5})
+			},
+			err:     status.Error(codes.NotFound, "record not found"),
+			wantErr: codes.NotFound,
+		},
+		{
+			name: "Unimplemented",
+			call: func(ctx context.Context) (proto.Message, error) {
+				return c.ReadAll(ctx, &v1.ReadAllRequest{Api: "v2"})
+			},
+			err:     status.Error(codes.Unimplemented, "unsupported API version"),
+			wantErr: codes.Unimplemented,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake.err = tt.err
+			ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "bearer token")
+			got, err := tt.call(ctx)
+			if tt.wantErr != codes.OK {
+				if status.Code(err) != tt.wantErr {
+					t.Fatalf("error = %v, want code %v", err, tt.wantErr)
+				}
+				if s := status.Convert(err); s.Message() != status.Convert(tt.err).Message() {
+					t.Errorf("error message = %v, want %v", s.Message(), status.Convert(tt.err).Message())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("error = %v", err)
+			}
+			if !proto.Equal(fake.req, tt.wantReq) {
+				t.Errorf("request = %v, want %v", fake.req, tt.wantReq)
+			}
+			if !proto.Equal(got, tt.want) {
+				t.Errorf("response = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(fake.md.Get("authorization"), []string{"bearer token"}) {
+				t.Errorf("authorization metadata = %v", fake.md.Get("authorization"))
+			}
+		})
+	}
+}
+
+func TestCodeFromHTTPStatus(t *testing.T) {
+	for _, code := range []codes.Code{codes.InvalidArgument, codes.Unauthenticated, codes.PermissionDenied,
+		codes.NotFound, codes.FailedPrecondition, codes.ResourceExhausted, codes.Unimplemented,
+		codes.Unavailable, codes.DeadlineExceeded} {
+		if got := codeFromHTTPStatus(runtime.HTTPStatusFromCode(code)); got != code {
+			t.Errorf("codeFromHTTPStatus(HTTPStatusFromCode(%v)) = %v", code, got)
+		}
+	}
+}
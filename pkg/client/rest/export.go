@@ -0,0 +1,81 @@
+package rest
+
+import (
+	"context"
+	"io"
+	"net/url"
+	"strconv"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
+)
+
+// exportChunkSize is the largest chunk of exported file returned by Recv
+const exportChunkSize = 32 << 10
+
+// Export todo tasks as file.
+// File is read from response body in chunks. Errors after the file started
+// are written by gateway into the body and cannot be told apart from the file.
+func (c *toDoServiceClient) Export(ctx context.Context, in *v1.ExportRequest, opts ...grpc.CallOption) (v1.ToDoService_ExportClient, error) {
+	query := apiQuery(in.Api)
+	if query == nil {
+		query = url.Values{}
+	}
+	if len(in.Format) > 0 {
+		query.Set("format", in.Format)
+	}
+	for _, id := range in.Ids {
+		query.Add("ids", strconv.FormatInt(id, 10))
+	}
+	if in.ReminderAfter != nil {
+		query.Set("reminderAfter", ptypes.TimestampString(in.ReminderAfter))
+	}
+	if in.ReminderBefore != nil {
+		query.Set("reminderBefore", ptypes.TimestampString(in.ReminderBefore))
+	}
+
+	resp, err := c.openStream(ctx, "/v1/todo:export", query, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &exportClient{clientStream: clientStream{ctx: ctx, resp: resp}}, nil
+}
+
+// exportClient is implementation of v1.ToDoService_ExportClient reading response body
+type exportClient struct {
+	clientStream
+}
+
+// Recv returns next chunk of file, io.EOF is returned at the end of file
+func (e *exportClient) Recv() (*httpbody.HttpBody, error) {
+	buf := make([]byte, exportChunkSize)
+	n, err := io.ReadFull(e.resp.Body, buf)
+	if n > 0 {
+		return &httpbody.HttpBody{ContentType: e.resp.Header.Get("Content-Type"), Data: buf[:n]}, nil
+	}
+	if err == io.ErrUnexpectedEOF {
+		err = io.EOF
+	}
+	return nil, e.readError(err)
+}
+
+// RecvMsg receives next chunk of file into m
+func (e *exportClient) RecvMsg(m interface{}) error {
+	out, err := e.Recv()
+	if err != nil {
+		return err
+	}
+	msg, ok := m.(proto.Message)
+	if !ok {
+		return status.Errorf(codes.Internal, "unexpected message type %T", m)
+	}
+	msg.Reset()
+	proto.Merge(msg, out)
+	return nil
+}
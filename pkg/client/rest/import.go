@@ -0,0 +1,138 @@
+package rest
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
+)
+
+// Import stream of todo tasks.
+// Tasks are sent as newline delimited JSON body of one request while they are sent to the stream.
+func (c *toDoServiceClient) Import(ctx context.Context, opts ...grpc.CallOption) (v1.ToDoService_ImportClient, error) {
+	pr, pw := io.Pipe()
+	req, err := http.NewRequest(http.MethodPost, c.baseURL+"/v1/todo:import", pr)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create request: %v", err)
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/json")
+	setMetadataHeaders(ctx, req.Header)
+
+	ic := &importClient{ctx: ctx, client: c, pw: pw, opts: opts, done: make(chan struct{})}
+	go func() {
+		defer close(ic.done)
+		resp, err := c.httpClient.Do(req)
+		if err != nil {
+			ic.err = transportError(ctx, err)
+		}
+		ic.resp = resp
+		// sending fails once the call is over, e.g. server rejected the stream
+		pr.CloseWithError(io.EOF)
+	}()
+	return ic, nil
+}
+
+// importClient is implementation of v1.ToDoService_ImportClient writing request body
+type importClient struct {
+	ctx    context.Context
+	client *toDoServiceClient
+	pw     *io.PipeWriter
+	opts   []grpc.CallOption
+
+	// done is closed when response is received, resp or err is set then
+	done chan struct{}
+	resp *http.Response
+	err  error
+}
+
+// Send sends task, io.EOF is returned if the call is over and CloseAndRecv returns its status
+func (ic *importClient) Send(m *v1.ImportRequest) error {
+	var b bytes.Buffer
+	if err := ic.client.marshaler.Marshal(&b, m); err != nil {
+		return status.Errorf(codes.Internal, "failed to marshal request: %v", err)
+	}
+	b.WriteByte('\n')
+	if _, err := ic.pw.Write(b.Bytes()); err != nil {
+		return io.EOF
+	}
+	return nil
+}
+
+// CloseAndRecv ends the stream and returns import summary
+func (ic *importClient) CloseAndRecv() (*v1.ImportResponse, error) {
+	ic.pw.Close()
+	<-ic.done
+	if ic.err != nil {
+		return nil, ic.err
+	}
+	out := new(v1.ImportResponse)
+	if err := ic.client.readResponse(ic.resp, out, ic.opts); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Header waits for response and returns its metadata
+func (ic *importClient) Header() (metadata.MD, error) {
+	<-ic.done
+	if ic.err != nil {
+		return nil, ic.err
+	}
+	md := metadata.MD{}
+	for name, values := range ic.resp.Header {
+		if strings.HasPrefix(name, metadataHeaderPrefix) {
+			md.Append(strings.TrimPrefix(name, metadataHeaderPrefix), values...)
+		}
+	}
+	return md, nil
+}
+
+// Trailer returns empty metadata, trailers are not read from response
+func (ic *importClient) Trailer() metadata.MD {
+	return metadata.MD{}
+}
+
+// CloseSend ends request body
+func (ic *importClient) CloseSend() error {
+	return ic.pw.Close()
+}
+
+// Context returns context of the call
+func (ic *importClient) Context() context.Context {
+	return ic.ctx
+}
+
+// SendMsg sends *v1.ImportRequest
+func (ic *importClient) SendMsg(m interface{}) error {
+	req, ok := m.(*v1.ImportRequest)
+	if !ok {
+		return status.Errorf(codes.Internal, "unexpected message type %T", m)
+	}
+	return ic.Send(req)
+}
+
+// RecvMsg ends the stream and receives import summary into m
+func (ic *importClient) RecvMsg(m interface{}) error {
+	out, err := ic.CloseAndRecv()
+	if err != nil {
+		return err
+	}
+	msg, ok := m.(proto.Message)
+	if !ok {
+		return status.Errorf(codes.Internal, "unexpected message type %T", m)
+	}
+	msg.Reset()
+	proto.Merge(msg, out)
+	return nil
+}
@@ -0,0 +1,122 @@
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// streamChunk is message of newline delimited JSON stream sent by gateway
+type streamChunk struct {
+	Result json.RawMessage `json:"result"`
+	Error  *streamError    `json:"error"`
+}
+
+// streamError is error ending stream sent by gateway
+type streamError struct {
+	GrpcCode int32  `json:"grpc_code"`
+	Message  string `json:"message"`
+}
+
+// err converts stream error to gRPC status error
+func (e *streamError) err() error {
+	if e.GrpcCode == int32(codes.OK) {
+		return status.Error(codes.Unknown, e.Message)
+	}
+	return status.Error(codes.Code(e.GrpcCode), e.Message)
+}
+
+// openStream sends GET request of server-streaming call and returns response with stream in body
+func (c *toDoServiceClient) openStream(ctx context.Context, path string, query url.Values,
+	opts []grpc.CallOption) (*http.Response, error) {
+	u := c.baseURL + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+
+	req, err := http.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create request: %v", err)
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Accept", "application/json")
+	setMetadataHeaders(ctx, req.Header)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, transportError(ctx, err)
+	}
+	setHeaderOptions(resp.Header, opts)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		defer resp.Body.Close()
+		// stream failing before first message is ended by error chunk
+		b, _ := ioutil.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+		var chunk streamChunk
+		if err := json.Unmarshal(b, &chunk); err == nil && chunk.Error != nil {
+			return nil, chunk.Error.err()
+		}
+		resp.Body = ioutil.NopCloser(bytes.NewReader(b))
+		return nil, responseError(resp)
+	}
+	return resp, nil
+}
+
+// clientStream implements grpc.ClientStream methods common to server-streaming calls over gateway
+type clientStream struct {
+	ctx  context.Context
+	resp *http.Response
+}
+
+// Header returns response metadata
+func (s *clientStream) Header() (metadata.MD, error) {
+	md := metadata.MD{}
+	for name, values := range s.resp.Header {
+		if strings.HasPrefix(name, metadataHeaderPrefix) {
+			md.Append(strings.TrimPrefix(name, metadataHeaderPrefix), values...)
+		}
+	}
+	return md, nil
+}
+
+// Trailer returns empty metadata, gateway sends no trailers for streams
+func (s *clientStream) Trailer() metadata.MD {
+	return metadata.MD{}
+}
+
+// CloseSend does nothing, request has no stream to close
+func (s *clientStream) CloseSend() error {
+	return nil
+}
+
+// Context returns context of the call
+func (s *clientStream) Context() context.Context {
+	return s.ctx
+}
+
+// SendMsg fails, call is server-streaming only
+func (s *clientStream) SendMsg(m interface{}) error {
+	return status.Error(codes.Internal, "SendMsg is not supported by server-streaming call")
+}
+
+// readError converts error reading stream to gRPC status error and closes response
+func (s *clientStream) readError(err error) error {
+	s.resp.Body.Close()
+	if err == io.EOF {
+		return io.EOF
+	}
+	if s.ctx.Err() != nil {
+		return transportError(s.ctx, err)
+	}
+	return status.Errorf(codes.Unavailable, "failed to read stream: %v", err)
+}
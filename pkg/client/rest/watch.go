@@ -0,0 +1,85 @@
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
+)
+
+// Watch changes of todo tasks.
+// Changes are read from newline delimited JSON stream, cancel ctx to stop watching.
+func (c *toDoServiceClient) Watch(ctx context.Context, in *v1.WatchRequest, opts ...grpc.CallOption) (v1.ToDoService_WatchClient, error) {
+	query := apiQuery(in.Api)
+	if query == nil {
+		query = url.Values{}
+	}
+	for _, t := range in.Types {
+		query.Add("types", t.String())
+	}
+	for _, id := range in.Ids {
+		query.Add("ids", strconv.FormatInt(id, 10))
+	}
+	if in.AfterSequence > 0 {
+		query.Set("afterSequence", strconv.FormatInt(in.AfterSequence, 10))
+	}
+
+	resp, err := c.openStream(ctx, "/v1/todo:watch", query, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &watchClient{
+		clientStream: clientStream{ctx: ctx, resp: resp},
+		client:       c,
+		dec:          json.NewDecoder(resp.Body),
+	}, nil
+}
+
+// watchClient is implementation of v1.ToDoService_WatchClient reading gateway stream
+type watchClient struct {
+	clientStream
+	client *toDoServiceClient
+	dec    *json.Decoder
+}
+
+// Recv returns next change, io.EOF is returned when server ends stream
+func (w *watchClient) Recv() (*v1.WatchResponse, error) {
+	var chunk streamChunk
+	if err := w.dec.Decode(&chunk); err != nil {
+		return nil, w.readError(err)
+	}
+	if chunk.Error != nil {
+		w.resp.Body.Close()
+		return nil, chunk.Error.err()
+	}
+
+	out := new(v1.WatchResponse)
+	if err := w.client.unmarshaler.Unmarshal(bytes.NewReader(chunk.Result), out); err != nil {
+		w.resp.Body.Close()
+		return nil, status.Errorf(codes.Internal, "failed to unmarshal response: %v", err)
+	}
+	return out, nil
+}
+
+// RecvMsg receives next change into m
+func (w *watchClient) RecvMsg(m interface{}) error {
+	out, err := w.Recv()
+	if err != nil {
+		return err
+	}
+	msg, ok := m.(proto.Message)
+	if !ok {
+		return status.Errorf(codes.Internal, "unexpected message type %T", m)
+	}
+	msg.Reset()
+	proto.Merge(msg, out)
+	return nil
+}
@@ -0,0 +1,206 @@
+// Package bench implements load testing tool of ToDo service
+package bench
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
+	"go.smartmachine.io/go-grpc-api/pkg/client"
+	"go.smartmachine.io/go-grpc-api/pkg/client/rest"
+)
+
+const (
+	// TransportGRPC calls gRPC server
+	TransportGRPC = "grpc"
+	// TransportREST calls HTTP/REST gateway
+	TransportREST = "rest"
+
+	// OutputText prints report as text table
+	OutputText = "text"
+	// OutputJSON prints report as JSON
+	OutputJSON = "json"
+)
+
+// options are benchmark parameters
+type options struct {
+	transport string
+	server    string
+	token     string
+	mix       *mix
+	workers   int
+	rate      float64
+	warmup    time.Duration
+	duration  time.Duration
+	timeout   time.Duration
+	seed      int
+}
+
+// tokenTransport sends bearer token with every HTTP request
+type tokenTransport struct {
+	http.RoundTripper
+	token string
+}
+
+// RoundTrip sets Authorization header of request copy
+func (t tokenTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	r2 := new(http.Request)
+	*r2 = *r
+	r2.Header = make(http.Header, len(r.Header)+1)
+	for k, v := range r.Header {
+		r2.Header[k] = v
+	}
+	r2.Header.Set("Authorization", "bearer "+t.token)
+	return t.RoundTripper.RoundTrip(r2)
+}
+
+// run runs benchmark and returns report
+func run(ctx context.Context, c v1.ToDoServiceClient, opts options) (*Report, error) {
+	w := &workload{client: c, timeout: opts.timeout, pool: &pool{}}
+
+	// seed tasks used by read, update and delete
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	for i := 0; i < opts.seed; i++ {
+		if err := w.call(ctx, "create", r); err != nil {
+			return nil, fmt.Errorf("failed to seed tasks: %v", err)
+		}
+	}
+
+	rec := newRecorder()
+	start := time.Now()
+	measureFrom := start.Add(opts.warmup)
+	ctx, cancel := context.WithDeadline(ctx, measureFrom.Add(opts.duration))
+	defer cancel()
+
+	p := newPacer(opts.rate)
+	var wg sync.WaitGroup
+	for i := 0; i < opts.workers; i++ {
+		wg.Add(1)
+		go func(seed int64) {
+			defer wg.Done()
+			r := rand.New(rand.NewSource(seed))
+			for p.wait(ctx) {
+				op := opts.mix.pick(r)
+				t := time.Now()
+				err := w.call(ctx, op, r)
+				// calls interrupted by end of benchmark are not counted
+				if ctx.Err() != nil {
+					return
+				}
+				if t.After(measureFrom) {
+					rec.record(op, time.Since(t), err)
+				}
+			}
+		}(start.UnixNano() + int64(i))
+	}
+	wg.Wait()
+
+	elapsed := time.Since(measureFrom)
+	if elapsed > opts.duration {
+		elapsed = opts.duration
+	}
+	ops, total := rec.report(elapsed)
+	return &Report{
+		Transport: opts.transport,
+		Server:    opts.server,
+		Workers:   opts.workers,
+		Rate:      opts.rate,
+		Duration:  elapsed.Seconds(),
+		Total:     *total,
+		Ops:       ops,
+	}, nil
+}
+
+// Run runs bench command line and returns process exit code
+func Run(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("bench", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	var opts options
+	fs.StringVar(&opts.transport, "transport", TransportGRPC, "Transport: grpc or rest")
+	fs.StringVar(&opts.server, "server", "",
+		"gRPC server host:port or HTTP gateway URL (default localhost:1234 or http://localhost:8080)")
+	fs.StringVar(&opts.token, "token", "", "Bearer token")
+	mixText := fs.String("mix", "create=1,read=4,update=1,readall=1", "Relative weights of RPCs: create, read, update, delete, readall")
+	fs.IntVar(&opts.workers, "workers", 10, "Number of concurrent workers")
+	fs.Float64Var(&opts.rate, "rate", 0, "Target rate of all workers together in calls per second, 0 means unlimited")
+	fs.DurationVar(&opts.warmup, "warmup", 2*time.Second, "Warm-up period not included in report")
+	fs.DurationVar(&opts.duration, "duration", 10*time.Second, "Measured period")
+	fs.DurationVar(&opts.timeout, "timeout", 5*time.Second, "Deadline of each call")
+	fs.IntVar(&opts.seed, "seed", 100, "Number of tasks created before benchmark for read, update and delete")
+	output := fs.String("output", OutputText, "Report format: text or json")
+	if err := fs.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return 0
+		}
+		return 2
+	}
+
+	var err error
+	if opts.mix, err = parseMix(*mixText); err != nil {
+		fmt.Fprintf(stderr, "Error: %v\n", err)
+		return 2
+	}
+	if opts.workers < 1 || opts.duration <= 0 || opts.warmup < 0 || opts.seed < 0 {
+		fmt.Fprintln(stderr, "Error: workers and duration must be positive, warmup and seed not negative")
+		return 2
+	}
+	if *output != OutputText && *output != OutputJSON {
+		fmt.Fprintf(stderr, "Error: invalid output format '%s', expected text or json\n", *output)
+		return 2
+	}
+
+	var c v1.ToDoServiceClient
+	switch opts.transport {
+	case TransportGRPC:
+		if len(opts.server) == 0 {
+			opts.server = "localhost:1234"
+		}
+		// retries would hide errors and distort latency
+		conn, err := client.Dial(context.Background(), client.Config{
+			Target:           opts.server,
+			Token:            opts.token,
+			Timeout:          opts.timeout,
+			RetryMaxAttempts: 1,
+		})
+		if err != nil {
+			fmt.Fprintf(stderr, "Error: %v\n", err)
+			return 1
+		}
+		defer conn.Close()
+		c = conn
+	case TransportREST:
+		if len(opts.server) == 0 {
+			opts.server = "http://localhost:8080"
+		}
+		var transport http.RoundTripper = &http.Transport{MaxIdleConnsPerHost: opts.workers}
+		if len(opts.token) > 0 {
+			transport = tokenTransport{RoundTripper: transport, token: opts.token}
+		}
+		c = rest.NewToDoServiceClient(opts.server, &http.Client{Transport: transport})
+	default:
+		fmt.Fprintf(stderr, "Error: invalid transport '%s', expected grpc or rest\n", opts.transport)
+		return 2
+	}
+
+	rep, err := run(context.Background(), c, opts)
+	if err != nil {
+		fmt.Fprintf(stderr, "Error: %v\n", err)
+		return 1
+	}
+	if *output == OutputJSON {
+		err = rep.writeJSON(stdout)
+	} else {
+		err = rep.writeText(stdout)
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "Error: %v\n", err)
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,97 @@
+package bench
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
+)
+
+func TestParseMix(t *testing.T) {
+	tests := []struct {
+		name    string
+		mix     string
+		wantOps []string
+		wantErr bool
+	}{
+		{name: "Weights", mix: "create=1,read=4", wantOps: []string{"create", "read"}},
+		{name: "Default weight", mix: "readall, Delete", wantOps: []string{"readall", "delete"}},
+		{name: "Zero weight", mix: "create=0,read=1", wantOps: []string{"read"}},
+		{name: "Unknown RPC", mix: "list=1", wantErr: true},
+		{name: "Invalid weight", mix: "read=x", wantErr: true},
+		{name: "Empty", mix: "read=0", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseMix(tt.mix)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseMix() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if len(got.ops) != len(tt.wantOps) {
+				t.Fatalf("parseMix() ops = %v, want %v", got.ops, tt.wantOps)
+			}
+			for i := range got.ops {
+				if got.ops[i] != tt.wantOps[i] {
+					t.Errorf("parseMix() ops = %v, want %v", got.ops, tt.wantOps)
+				}
+			}
+		})
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	var sorted []time.Duration
+	for i := 1; i <= 100; i++ {
+		sorted = append(sorted, time.Duration(i)*time.Millisecond)
+	}
+	for p, want := range map[float64]time.Duration{50: 50 * time.Millisecond, 95: 95 * time.Millisecond,
+		99: 99 * time.Millisecond, 100: 100 * time.Millisecond} {
+		if got := percentile(sorted, p); got != want {
+			t.Errorf("percentile(%v) = %v, want %v", p, got, want)
+		}
+	}
+}
+
+// countingClient answers every call immediately
+type countingClient struct {
+	v1.ToDoServiceClient
+	id int64
+}
+
+func (c *countingClient) Create(ctx context.Context, in *v1.CreateRequest, opts ...grpc.CallOption) (*v1.CreateResponse, error) {
+	return &v1.CreateResponse{Api: "v1", Id: atomic.AddInt64(&c.id, 1)}, nil
+}
+
+func (c *countingClient) Read(ctx context.Context, in *v1.ReadRequest, opts ...grpc.CallOption) (*v1.ReadResponse, error) {
+	return &v1.ReadResponse{Api: "v1", ToDo: &v1.ToDo{Id: in.Id}}, nil
+}
+
+func TestRun(t *testing.T) {
+	m, _ := parseMix("create=1,read=1")
+	rep, err := run(context.Background(), &countingClient{}, options{
+		mix:      m,
+		workers:  4,
+		rate:     200,
+		warmup:   100 * time.Millisecond,
+		duration: 500 * time.Millisecond,
+		timeout:  time.Second,
+		seed:     5,
+	})
+	if err != nil {
+		t.Fatalf("run() error = %v", err)
+	}
+	// target rate limits number of calls in measured period to about 100
+	if rep.Total.Count < 80 || rep.Total.Count > 110 || rep.Total.Errors != 0 {
+		t.Errorf("run() total = %+v, want about 100 calls without errors", rep.Total)
+	}
+	if rep.Ops["create"] == nil || rep.Ops["read"] == nil {
+		t.Errorf("run() ops = %v, want create and read", rep.Ops)
+	}
+}
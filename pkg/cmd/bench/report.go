@@ -0,0 +1,206 @@
+package bench
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"google.golang.org/grpc/status"
+)
+
+// recorder collects latencies and errors of measured calls
+type recorder struct {
+	mu        sync.Mutex
+	latencies map[string][]time.Duration
+	errors    map[string]map[string]int
+}
+
+// newRecorder creates empty recorder
+func newRecorder() *recorder {
+	return &recorder{
+		latencies: map[string][]time.Duration{},
+		errors:    map[string]map[string]int{},
+	}
+}
+
+// record records result of call
+func (r *recorder) record(op string, d time.Duration, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err != nil {
+		if r.errors[op] == nil {
+			r.errors[op] = map[string]int{}
+		}
+		r.errors[op][status.Code(err).String()]++
+		return
+	}
+	r.latencies[op] = append(r.latencies[op], d)
+}
+
+// Latency is latency distribution in milliseconds
+type Latency struct {
+	Min  float64 `json:"min"`
+	Mean float64 `json:"mean"`
+	P50  float64 `json:"p50"`
+	P95  float64 `json:"p95"`
+	P99  float64 `json:"p99"`
+	Max  float64 `json:"max"`
+}
+
+// Stats are results of one RPC or all RPCs together
+type Stats struct {
+	// Count is number of successful calls
+	Count int `json:"count"`
+	// Errors is number of failed calls
+	Errors int `json:"errors"`
+	// ErrorCodes is number of failed calls by gRPC status code
+	ErrorCodes map[string]int `json:"error_codes,omitempty"`
+	// Throughput is successful calls per second
+	Throughput float64 `json:"throughput"`
+	// Latency is latency of successful calls
+	Latency Latency `json:"latency_ms"`
+}
+
+// Report is result of benchmark
+type Report struct {
+	Transport string  `json:"transport"`
+	Server    string  `json:"server"`
+	Workers   int     `json:"workers"`
+	Rate      float64 `json:"rate"`
+	// Duration is length of measured period in seconds, warm-up is not included
+	Duration float64           `json:"duration_s"`
+	Total    Stats             `json:"total"`
+	Ops      map[string]*Stats `json:"ops"`
+}
+
+// percentile returns nearest-rank percentile of sorted durations
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(p/100*float64(len(sorted))+0.5) - 1
+	if rank < 0 {
+		rank = 0
+	}
+	if rank >= len(sorted) {
+		rank = len(sorted) - 1
+	}
+	return sorted[rank]
+}
+
+// ms converts duration to milliseconds
+func ms(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+// stats computes statistics of latencies and errors
+func stats(latencies []time.Duration, errors map[string]int, elapsed time.Duration) *Stats {
+	s := &Stats{Count: len(latencies)}
+	for code, n := range errors {
+		if s.ErrorCodes == nil {
+			s.ErrorCodes = map[string]int{}
+		}
+		s.ErrorCodes[code] += n
+		s.Errors += n
+	}
+	if elapsed > 0 {
+		s.Throughput = float64(s.Count) / elapsed.Seconds()
+	}
+	if len(latencies) == 0 {
+		return s
+	}
+
+	sorted := make([]time.Duration, len(latencies))
+	copy(sorted, latencies)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	var sum time.Duration
+	for _, d := range sorted {
+		sum += d
+	}
+	s.Latency = Latency{
+		Min:  ms(sorted[0]),
+		Mean: ms(sum / time.Duration(len(sorted))),
+		P50:  ms(percentile(sorted, 50)),
+		P95:  ms(percentile(sorted, 95)),
+		P99:  ms(percentile(sorted, 99)),
+		Max:  ms(sorted[len(sorted)-1]),
+	}
+	return s
+}
+
+// report computes statistics of all recorded calls
+func (r *recorder) report(elapsed time.Duration) (ops map[string]*Stats, total *Stats) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	ops = map[string]*Stats{}
+	var all []time.Duration
+	allErrors := map[string]int{}
+	for _, op := range opNames {
+		latencies, errors := r.latencies[op], r.errors[op]
+		if len(latencies) == 0 && len(errors) == 0 {
+			continue
+		}
+		ops[op] = stats(latencies, errors, elapsed)
+		all = append(all, latencies...)
+		for code, n := range errors {
+			allErrors[code] += n
+		}
+	}
+	return ops, stats(all, allErrors, elapsed)
+}
+
+// writeJSON prints report as JSON
+func (rep *Report) writeJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(rep)
+}
+
+// writeText prints report as text table
+func (rep *Report) writeText(w io.Writer) error {
+	fmt.Fprintf(w, "transport: %s, server: %s, workers: %d, rate: %s, duration: %.1fs\n\n",
+		rep.Transport, rep.Server, rep.Workers, rateText(rep.Rate), rep.Duration)
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "RPC\tCOUNT\tERRORS\tREQ/S\tMIN\tMEAN\tP50\tP95\tP99\tMAX\t")
+	row := func(name string, s *Stats) {
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%.1f\t%.2f\t%.2f\t%.2f\t%.2f\t%.2f\t%.2f\t\n", name, s.Count, s.Errors, s.Throughput,
+			s.Latency.Min, s.Latency.Mean, s.Latency.P50, s.Latency.P95, s.Latency.P99, s.Latency.Max)
+	}
+	for _, op := range opNames {
+		if s, ok := rep.Ops[op]; ok {
+			row(op, s)
+		}
+	}
+	row("total", &rep.Total)
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	fmt.Fprintln(w, "\nlatency in milliseconds")
+
+	if rep.Total.Errors > 0 {
+		fmt.Fprintln(w, "\nerrors:")
+		codes := make([]string, 0, len(rep.Total.ErrorCodes))
+		for code := range rep.Total.ErrorCodes {
+			codes = append(codes, code)
+		}
+		sort.Strings(codes)
+		for _, code := range codes {
+			fmt.Fprintf(w, "  %s: %d\n", code, rep.Total.ErrorCodes[code])
+		}
+	}
+	return nil
+}
+
+// rateText formats target rate
+func rateText(rate float64) string {
+	if rate <= 0 {
+		return "unlimited"
+	}
+	return fmt.Sprintf("%g/s", rate)
+}
@@ -0,0 +1,214 @@
+package bench
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
+)
+
+const (
+	// apiVersion is version of API is provided by server
+	apiVersion = "v1"
+)
+
+// opNames are names of benchmarked RPCs in report order
+var opNames = []string{"create", "read", "update", "delete", "readall"}
+
+// mix is relative weights of RPCs
+type mix struct {
+	ops     []string
+	weights []int
+	total   int
+}
+
+// parseMix parses weights in format create=1,readall=1
+func parseMix(s string) (*mix, error) {
+	m := &mix{}
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(item)
+		if len(item) == 0 {
+			continue
+		}
+		parts := strings.SplitN(item, "=", 2)
+		op := strings.ToLower(strings.TrimSpace(parts[0]))
+		known := false
+		for _, name := range opNames {
+			known = known || name == op
+		}
+		if !known {
+			return nil, fmt.Errorf("unknown RPC '%s' in mix, expected %s", op, strings.Join(opNames, ", "))
+		}
+		weight := 1
+		if len(parts) == 2 {
+			w, err := strconv.Atoi(strings.TrimSpace(parts[1]))
+			if err != nil || w < 0 {
+				return nil, fmt.Errorf("invalid weight of '%s' in mix: '%s'", op, parts[1])
+			}
+			weight = w
+		}
+		if weight == 0 {
+			continue
+		}
+		m.ops = append(m.ops, op)
+		m.weights = append(m.weights, weight)
+		m.total += weight
+	}
+	if m.total == 0 {
+		return nil, fmt.Errorf("mix '%s' contains no RPC", s)
+	}
+	return m, nil
+}
+
+// pick returns random RPC according to weights
+func (m *mix) pick(r *rand.Rand) string {
+	n := r.Intn(m.total)
+	for i, w := range m.weights {
+		if n < w {
+			return m.ops[i]
+		}
+		n -= w
+	}
+	return m.ops[len(m.ops)-1]
+}
+
+// pool is IDs of tasks created by benchmark
+type pool struct {
+	mu  sync.Mutex
+	ids []int64
+}
+
+// add adds task ID
+func (p *pool) add(id int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.ids = append(p.ids, id)
+}
+
+// random returns random task ID, false if pool is empty
+func (p *pool) random(r *rand.Rand) (int64, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if len(p.ids) == 0 {
+		return 0, false
+	}
+	return p.ids[r.Intn(len(p.ids))], true
+}
+
+// take removes random task ID, false if pool is empty
+func (p *pool) take(r *rand.Rand) (int64, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if len(p.ids) == 0 {
+		return 0, false
+	}
+	i := r.Intn(len(p.ids))
+	id := p.ids[i]
+	p.ids[i] = p.ids[len(p.ids)-1]
+	p.ids = p.ids[:len(p.ids)-1]
+	return id, true
+}
+
+// pacer spreads calls of all workers evenly to keep target rate
+type pacer struct {
+	start    time.Time
+	interval time.Duration
+	n        int64
+}
+
+// newPacer creates pacer, rate <= 0 means unlimited
+func newPacer(rate float64) *pacer {
+	p := &pacer{start: time.Now()}
+	if rate > 0 {
+		p.interval = time.Duration(float64(time.Second) / rate)
+	}
+	return p
+}
+
+// wait blocks until next call is due, it returns false if context is done first
+func (p *pacer) wait(ctx context.Context) bool {
+	if p.interval == 0 {
+		return ctx.Err() == nil
+	}
+	n := atomic.AddInt64(&p.n, 1) - 1
+	d := time.Until(p.start.Add(time.Duration(n) * p.interval))
+	if d <= 0 {
+		return ctx.Err() == nil
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-t.C:
+		return true
+	}
+}
+
+// workload runs RPCs against server
+type workload struct {
+	client  v1.ToDoServiceClient
+	timeout time.Duration
+	pool    *pool
+}
+
+// newToDo returns task with unique title
+func newToDo(r *rand.Rand) *v1.ToDo {
+	reminder, _ := ptypes.TimestampProto(time.Now().Add(time.Hour))
+	return &v1.ToDo{
+		Title:       fmt.Sprintf("bench task %d", r.Int63()),
+		Description: "created by bench",
+		Reminder:    reminder,
+	}
+}
+
+// call runs one RPC
+func (w *workload) call(ctx context.Context, op string, r *rand.Rand) error {
+	ctx, cancel := context.WithTimeout(ctx, w.timeout)
+	defer cancel()
+
+	switch op {
+	case "create":
+		res, err := w.client.Create(ctx, &v1.CreateRequest{Api: apiVersion, ToDo: newToDo(r)})
+		if err == nil {
+			w.pool.add(res.Id)
+		}
+		return err
+	case "read":
+		id, ok := w.pool.random(r)
+		if !ok {
+			return status.Error(codes.FailedPrecondition, "no task to read")
+		}
+		_, err := w.client.Read(ctx, &v1.ReadRequest{Api: apiVersion, Id: id})
+		return err
+	case "update":
+		id, ok := w.pool.random(r)
+		if !ok {
+			return status.Error(codes.FailedPrecondition, "no task to update")
+		}
+		td := newToDo(r)
+		td.Id = id
+		_, err := w.client.Update(ctx, &v1.UpdateRequest{Api: apiVersion, ToDo: td})
+		return err
+	case "delete":
+		id, ok := w.pool.take(r)
+		if !ok {
+			return status.Error(codes.FailedPrecondition, "no task to delete")
+		}
+		_, err := w.client.Delete(ctx, &v1.DeleteRequest{Api: apiVersion, Id: id})
+		return err
+	default:
+		_, err := w.client.ReadAll(ctx, &v1.ReadAllRequest{Api: apiVersion})
+		return err
+	}
+}
@@ -0,0 +1,191 @@
+// Package client implements command line client of ToDo service
+package client
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/peterh/liner"
+	"google.golang.org/grpc/status"
+
+	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
+	"go.smartmachine.io/go-grpc-api/pkg/client"
+)
+
+const (
+	// apiVersion is version of API is provided by server
+	apiVersion = "v1"
+)
+
+// command is client subcommand
+type command struct {
+	// usage is argument synopsis printed in help
+	usage string
+	// help is one line description of command
+	help string
+	// run runs command with remaining arguments
+	run func(c *cli, args []string) error
+}
+
+// commands are client subcommands by name
+var commands = map[string]command{}
+
+// cli is state shared by subcommands
+type cli struct {
+	stdout  io.Writer
+	stderr  io.Writer
+	client  v1.ToDoServiceClient
+	printer *printer
+	timeout time.Duration
+	// line is line editor of interactive shell, nil outside shell
+	line *liner.State
+}
+
+// context returns context of single call with deadline
+func (c *cli) context() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), c.timeout)
+}
+
+// dial connects to server described by profile
+func dial(prof Profile, timeout time.Duration) (*client.Client, error) {
+	cfg := client.Config{
+		Target:  prof.Server,
+		Timeout: timeout,
+		Token:   prof.Token,
+	}
+	if prof.TLS {
+		var err error
+		if cfg.TLS, err = client.LoadTLSConfig(prof.CAFile, prof.ServerName); err != nil {
+			return nil, err
+		}
+	}
+	return client.Dial(context.Background(), cfg)
+}
+
+// usage prints client help
+func usage(w io.Writer, fs *flag.FlagSet) {
+	fmt.Fprintf(w, "Usage: %s [flags] <command> [arguments]\n\nCommands:\n", fs.Name())
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	for _, name := range names {
+		fmt.Fprintf(tw, "  %s %s\t%s\n", name, commands[name].usage, commands[name].help)
+	}
+	_ = tw.Flush()
+	fmt.Fprintln(w, "\nFlags:")
+	fs.SetOutput(w)
+	fs.PrintDefaults()
+}
+
+// envDefault returns value of environment variable or default value
+func envDefault(name, value string) string {
+	if v, ok := os.LookupEnv(name); ok {
+		return v
+	}
+	return value
+}
+
+// Run runs client command line and returns process exit code
+func Run(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("client", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	configFile := fs.String("config", envDefault("TODO_CLIENT_CONFIG", defaultConfigFile()),
+		"Path to client config file with profiles")
+	profileName := fs.String("profile", os.Getenv("TODO_PROFILE"), "Profile from config file, current profile if empty")
+	server := fs.String("server", os.Getenv("TODO_SERVER"), "gRPC server in format host:port, overrides profile")
+	token := fs.String("token", os.Getenv("TODO_TOKEN"), "Bearer token, overrides profile")
+	output := fs.String("output", FormatTable, "Output format: table, json or yaml")
+	fs.StringVar(output, "o", FormatTable, "Shorthand for --output")
+	timeout := fs.Duration("timeout", 5*time.Second, "Deadline of each call")
+	fs.Usage = func() { usage(stderr, fs) }
+	if err := fs.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return ExitOK
+		}
+		return ExitUsage
+	}
+
+	if fs.NArg() == 0 {
+		usage(stderr, fs)
+		return ExitUsage
+	}
+	cmd, ok := commands[fs.Arg(0)]
+	if !ok {
+		fmt.Fprintf(stderr, "Error: unknown command '%s'\n", fs.Arg(0))
+		usage(stderr, fs)
+		return ExitUsage
+	}
+
+	p, err := newPrinter(stdout, *output)
+	if err != nil {
+		fmt.Fprintf(stderr, "Error: %v\n", err)
+		return ExitUsage
+	}
+
+	explicit := false
+	fs.Visit(func(f *flag.Flag) {
+		if f.Name == "config" {
+			explicit = true
+		}
+	})
+	_, explicitEnv := os.LookupEnv("TODO_CLIENT_CONFIG")
+	profiles, err := loadProfiles(*configFile, explicit || explicitEnv)
+	if err != nil {
+		fmt.Fprintf(stderr, "Error: %v\n", err)
+		return ExitUsage
+	}
+	prof, err := profiles.profile(*profileName)
+	if err != nil {
+		fmt.Fprintf(stderr, "Error: %v\n", err)
+		return ExitUsage
+	}
+	if len(*server) > 0 {
+		prof.Server = *server
+	}
+	if len(*token) > 0 {
+		prof.Token = *token
+	}
+
+	conn, err := dial(prof, *timeout)
+	if err != nil {
+		fmt.Fprintf(stderr, "Error: did not connect: %v\n", err)
+		return ExitUnavailable
+	}
+	defer conn.Close()
+
+	c := &cli{
+		stdout:  stdout,
+		stderr:  stderr,
+		client:  conn,
+		printer: p,
+		timeout: *timeout,
+	}
+	err = cmd.run(c, fs.Args()[1:])
+	if err != nil {
+		printError(stderr, err)
+	}
+	return ExitCode(err)
+}
+
+// printError prints command error, gRPC errors are printed with status code
+func printError(w io.Writer, err error) {
+	if s, ok := status.FromError(err); ok {
+		fmt.Fprintf(w, "Error: %s: %s\n", s.Code(), s.Message())
+		return
+	}
+	msg := err.Error()
+	if !strings.HasSuffix(msg, "\n") {
+		msg += "\n"
+	}
+	fmt.Fprintf(w, "Error: %s", msg)
+}
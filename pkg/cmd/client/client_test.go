@@ -0,0 +1,108 @@
+package client
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
+)
+
+func TestExitCode(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{name: "OK", err: nil, want: ExitOK},
+		{name: "Usage", err: usageError{msg: "bad flag"}, want: ExitUsage},
+		{name: "Not gRPC", err: errors.New("failed"), want: ExitError},
+		{name: "NotFound", err: status.Error(codes.NotFound, "not found"), want: ExitNotFound},
+		{name: "InvalidArgument", err: status.Error(codes.InvalidArgument, "invalid"), want: ExitInvalidArgument},
+		{name: "Unauthenticated", err: status.Error(codes.Unauthenticated, "no token"), want: ExitPermission},
+		{name: "Unavailable", err: status.Error(codes.Unavailable, "down"), want: ExitUnavailable},
+		{name: "Internal", err: status.Error(codes.Internal, "failed"), want: ExitError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ExitCode(tt.err); got != tt.want {
+				t.Errorf("ExitCode() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPrinter(t *testing.T) {
+	res := &v1.ReadAllResponse{
+		Api: "v1",
+		ToDos: []*v1.ToDo{
+			{Id: 1, Title: "title", Description: "description"},
+		},
+	}
+	tests := []struct {
+		name   string
+		format string
+		want   string
+	}{
+		{
+			name:   "Table",
+			format: FormatTable,
+			want:   "ID  TITLE  DESCRIPTION  REMINDER\n1   title  description  \n",
+		},
+		{
+			name:   "JSON",
+			format: FormatJSON,
+			want:   "{\n  \"api\": \"v1\",\n  \"toDos\": [\n    {\n      \"id\": \"1\",\n      \"title\": \"title\",\n      \"description\": \"description\"\n    }\n  ]\n}\n",
+		},
+		{
+			name:   "YAML",
+			format: FormatYAML,
+			want:   "api: v1\ntoDos:\n- id: \"1\"\n  title: title\n  description: description\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b bytes.Buffer
+			p, err := newPrinter(&b, tt.format)
+			if err != nil {
+				t.Fatalf("newPrinter() error = %v", err)
+			}
+			if err := p.printToDos(res, res.ToDos); err != nil {
+				t.Fatalf("printToDos() error = %v", err)
+			}
+			if got := b.String(); got != tt.want {
+				t.Errorf("printToDos() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSplitArgs(t *testing.T) {
+	tests := []struct {
+		name    string
+		line    string
+		want    []string
+		wantErr bool
+	}{
+		{name: "Words", line: " get  1 ", want: []string{"get", "1"}},
+		{name: "Double quotes", line: `create --title "Buy milk"`, want: []string{"create", "--title", "Buy milk"}},
+		{name: "Single quotes", line: `create --description 'say "hi"'`, want: []string{"create", "--description", `say "hi"`}},
+		{name: "Empty quotes", line: `update 1 --description ""`, want: []string{"update", "1", "--description", ""}},
+		{name: "Unterminated", line: `create --title "Buy`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := splitArgs(tt.line)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("splitArgs() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("splitArgs() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,364 @@
+package client
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+
+	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
+)
+
+func init() {
+	commands["create"] = command{
+		usage: "--title <title> --reminder <time> [--description <text>] [--recurrence <rrule>] [--tags <tag,...>] [--project <id>]",
+		help:  "Create task and print its ID",
+		run:   create,
+	}
+	commands["get"] = command{
+		usage: "<id>",
+		help:  "Print task",
+		run:   get,
+	}
+	commands["update"] = command{
+		usage: "<id> [--title <title>] [--description <text>] [--reminder <time>] [--recurrence <rrule>] [--tags <tag,...>] [--project <id>]",
+		help:  "Update given fields of task",
+		run:   update,
+	}
+	commands["delete"] = command{
+		usage: "<id>...",
+		help:  "Move tasks to trash",
+		run:   del,
+	}
+	commands["complete"] = command{
+		usage: "<id>",
+		help:  "Complete task and print ID of task for its next occurrence, 0 if it does not recur",
+		run:   complete,
+	}
+	commands["list"] = command{
+		usage: "[--project <id>]",
+		help:  "List all tasks",
+		run:   list,
+	}
+	commands["undelete"] = command{
+		usage: "<id>",
+		help:  "Restore task from trash",
+		run:   undelete,
+	}
+	commands["trash"] = command{
+		usage: "",
+		help:  "List tasks in trash",
+		run:   trash,
+	}
+}
+
+// toDoFlags are flags editing task fields
+type toDoFlags struct {
+	fs          *flag.FlagSet
+	title       string
+	description string
+	reminder    string
+	recurrence  string
+	tags        string
+	project     int64
+}
+
+// newToDoFlags defines flags editing task fields
+func newToDoFlags(name string) *toDoFlags {
+	f := &toDoFlags{fs: flag.NewFlagSet(name, flag.ContinueOnError)}
+	f.fs.SetOutput(ioutil.Discard)
+	f.fs.StringVar(&f.title, "title", "", "Title of the task")
+	f.fs.StringVar(&f.description, "description", "", "Detail description of the task")
+	f.fs.StringVar(&f.reminder, "reminder", "", "Time to remind the task in RFC3339 format, e.g. 2019-06-01T10:00:00Z, or duration from now, e.g. 2h")
+	f.fs.StringVar(&f.recurrence, "recurrence", "", "iCalendar RRULE of recurring task, e.g. FREQ=WEEKLY;BYDAY=MO, empty to stop recurrence")
+	f.fs.StringVar(&f.tags, "tags", "", "Comma separated tags of the task, empty to remove all tags")
+	f.fs.Int64Var(&f.project, "project", 0, "ID of project of the task, 0 to remove the task from its project")
+	return f
+}
+
+// parse parses flags, flags and positional arguments may be mixed
+func (f *toDoFlags) parse(args []string) ([]string, error) {
+	return parseFlags(f.fs, args)
+}
+
+// parseFlags parses flags of fs and returns positional arguments, flags and positional arguments may be mixed
+func parseFlags(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, usageError{msg: fmt.Sprintf("%s: %v", fs.Name(), err)}
+		}
+		if fs.NArg() == 0 {
+			return positional, nil
+		}
+		positional = append(positional, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+}
+
+// apply sets fields given on command line to task
+func (f *toDoFlags) apply(td *v1.ToDo) error {
+	var err error
+	f.fs.Visit(func(fl *flag.Flag) {
+		switch fl.Name {
+		case "title":
+			td.Title = f.title
+		case "description":
+			td.Description = f.description
+		case "recurrence":
+			td.Recurrence = f.recurrence
+		case "tags":
+			td.Tags = splitTags(f.tags)
+		case "project":
+			td.ProjectId = f.project
+		case "reminder":
+			var ts *timestamp.Timestamp
+			if ts, err = parseReminder(f.reminder); err == nil {
+				td.Reminder = ts
+			}
+		}
+	})
+	return err
+}
+
+// splitTags splits comma separated tags
+func splitTags(s string) []string {
+	if len(strings.TrimSpace(s)) == 0 {
+		return nil
+	}
+	return strings.Split(s, ",")
+}
+
+// parseReminder parses RFC3339 time or duration from now
+func parseReminder(s string) (*timestamp.Timestamp, error) {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		d, derr := time.ParseDuration(s)
+		if derr != nil {
+			return nil, usageError{msg: fmt.Sprintf("invalid reminder '%s', expected RFC3339 time or duration", s)}
+		}
+		t = time.Now().Add(d)
+	}
+	return ptypes.TimestampProto(t.In(time.UTC))
+}
+
+// parseID parses task ID argument
+func parseID(s string) (int64, error) {
+	id, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || id <= 0 {
+		return 0, usageError{msg: fmt.Sprintf("invalid task ID '%s'", s)}
+	}
+	return id, nil
+}
+
+// oneID parses arguments of command taking exactly one task ID
+func oneID(cmd string, args []string) (int64, error) {
+	if len(args) != 1 {
+		return 0, usageError{msg: fmt.Sprintf("%s: expected exactly one task ID", cmd)}
+	}
+	return parseID(args[0])
+}
+
+// create creates new task
+func create(c *cli, args []string) error {
+	f := newToDoFlags("create")
+	positional, err := f.parse(args)
+	if err != nil {
+		return err
+	}
+	if len(positional) > 0 {
+		return usageError{msg: fmt.Sprintf("create: unexpected arguments %v", positional)}
+	}
+	if len(f.title) == 0 || len(f.reminder) == 0 {
+		return usageError{msg: "create: --title and --reminder are required"}
+	}
+
+	td := &v1.ToDo{}
+	if err := f.apply(td); err != nil {
+		return err
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+	res, err := c.client.Create(ctx, &v1.CreateRequest{
+		Api:  apiVersion,
+		ToDo: td,
+	})
+	if err != nil {
+		return err
+	}
+	return c.printer.printValue(res, "id", res.Id)
+}
+
+// get prints task
+func get(c *cli, args []string) error {
+	id, err := oneID("get", args)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+	res, err := c.client.Read(ctx, &v1.ReadRequest{
+		Api: apiVersion,
+		Id:  id,
+	})
+	if err != nil {
+		return err
+	}
+	return c.printer.printToDos(res, []*v1.ToDo{res.ToDo})
+}
+
+// update reads task, changes fields given on command line and writes it back
+func update(c *cli, args []string) error {
+	f := newToDoFlags("update")
+	positional, err := f.parse(args)
+	if err != nil {
+		return err
+	}
+	id, err := oneID("update", positional)
+	if err != nil {
+		return err
+	}
+	if f.fs.NFlag() == 0 {
+		return usageError{msg: "update: nothing to update, expected --title, --description, --reminder, --recurrence, --tags or --project"}
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+	res, err := c.client.Read(ctx, &v1.ReadRequest{
+		Api: apiVersion,
+		Id:  id,
+	})
+	if err != nil {
+		return err
+	}
+	td := res.ToDo
+	if err := f.apply(td); err != nil {
+		return err
+	}
+
+	res2, err := c.client.Update(ctx, &v1.UpdateRequest{
+		Api:  apiVersion,
+		ToDo: td,
+	})
+	if err != nil {
+		return err
+	}
+	return c.printer.printValue(res2, "updated", res2.Updated)
+}
+
+// complete completes task and prints ID of its next occurrence
+func complete(c *cli, args []string) error {
+	id, err := oneID("complete", args)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+	res, err := c.client.Complete(ctx, &v1.CompleteRequest{
+		Api: apiVersion,
+		Id:  id,
+	})
+	if err != nil {
+		return err
+	}
+	return c.printer.printValue(res, "next", res.Next.GetId())
+}
+
+// del deletes tasks, it stops at first error
+func del(c *cli, args []string) error {
+	if len(args) == 0 {
+		return usageError{msg: "delete: expected at least one task ID"}
+	}
+	ids := make([]int64, 0, len(args))
+	for _, arg := range args {
+		id, err := parseID(arg)
+		if err != nil {
+			return err
+		}
+		ids = append(ids, id)
+	}
+
+	for _, id := range ids {
+		ctx, cancel := c.context()
+		res, err := c.client.Delete(ctx, &v1.DeleteRequest{
+			Api: apiVersion,
+			Id:  id,
+		})
+		cancel()
+		if err != nil {
+			return err
+		}
+		if err := c.printer.printValue(res, "deleted", res.Deleted); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// list prints all tasks or tasks of project
+func list(c *cli, args []string) error {
+	fs := flag.NewFlagSet("list", flag.ContinueOnError)
+	fs.SetOutput(ioutil.Discard)
+	project := fs.Int64("project", 0, "ID of project to list tasks of")
+	if err := fs.Parse(args); err != nil {
+		return usageError{msg: fmt.Sprintf("list: %v", err)}
+	}
+	if fs.NArg() > 0 {
+		return usageError{msg: fmt.Sprintf("list: unexpected arguments %v", fs.Args())}
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+	res, err := c.client.ReadAll(ctx, &v1.ReadAllRequest{
+		Api:       apiVersion,
+		ProjectId: *project,
+	})
+	if err != nil {
+		return err
+	}
+	return c.printer.printToDos(res, res.ToDos)
+}
+
+// undelete restores task from trash
+func undelete(c *cli, args []string) error {
+	id, err := oneID("undelete", args)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+	res, err := c.client.Undelete(ctx, &v1.UndeleteRequest{
+		Api: apiVersion,
+		Id:  id,
+	})
+	if err != nil {
+		return err
+	}
+	return c.printer.printToDos(res, []*v1.ToDo{res.ToDo})
+}
+
+// trash prints tasks in trash
+func trash(c *cli, args []string) error {
+	if len(args) > 0 {
+		return usageError{msg: fmt.Sprintf("trash: unexpected arguments %v", args)}
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+	res, err := c.client.ListDeleted(ctx, &v1.ListDeletedRequest{
+		Api: apiVersion,
+	})
+	if err != nil {
+		return err
+	}
+	return c.printer.printToDos(res, res.ToDos)
+}
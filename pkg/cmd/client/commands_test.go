@@ -0,0 +1,158 @@
+package client
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"go.smartmachine.io/go-grpc-api/pkg/testing/todotest"
+)
+
+// runClient runs client command line against server at addr and returns its output and exit code
+func runClient(addr string, args ...string) (stdout, stderr string, code int) {
+	var out, errOut bytes.Buffer
+	code = Run(append([]string{"--config", "", "--server", addr}, args...), &out, &errOut)
+	return out.String(), errOut.String(), code
+}
+
+func TestCommands(t *testing.T) {
+	srv := todotest.Start(t, todotest.Config{})
+	defer srv.Close()
+
+	// steps run in order against the same server
+	steps := []struct {
+		args       []string
+		wantCode   int
+		wantOut    string
+		wantStderr string
+	}{
+		{
+			args:     []string{"create", "--title", "Buy milk", "--reminder", "2019-06-01T10:00:00Z", "--tags", "home,shop"},
+			wantCode: ExitOK,
+			wantOut:  "ID\n1\n",
+		},
+		{
+			args:       []string{"create", "--title", "No reminder"},
+			wantCode:   ExitUsage,
+			wantStderr: "create: --title and --reminder are required",
+		},
+		{
+			args:       []string{"create", "--title", "Soon", "--reminder", "soon"},
+			wantCode:   ExitUsage,
+			wantStderr: "invalid reminder 'soon'",
+		},
+		{
+			args:       []string{"create", "--title", "Tagged", "--reminder", "1h", "--tags", "home,"},
+			wantCode:   ExitInvalidArgument,
+			wantStderr: "Error: InvalidArgument:",
+		},
+		{
+			args:       []string{"create", "--title", "Flag", "--reminder", "1h", "--unknown"},
+			wantCode:   ExitUsage,
+			wantStderr: "flag provided but not defined: -unknown",
+		},
+		{
+			args:     []string{"-o", "json", "get", "1"},
+			wantCode: ExitOK,
+			wantOut:  `"title": "Buy milk"`,
+		},
+		{
+			args:     []string{"get", "1"},
+			wantCode: ExitOK,
+			wantOut:  "ID  TITLE     DESCRIPTION  REMINDER\n1   Buy milk",
+		},
+		{
+			args:       []string{"get", "100"},
+			wantCode:   ExitNotFound,
+			wantStderr: "Error: NotFound:",
+		},
+		{
+			args:       []string{"get", "abc"},
+			wantCode:   ExitUsage,
+			wantStderr: "invalid task ID 'abc'",
+		},
+		{
+			args:     []string{"update", "1", "--title", "Buy bread", "--description", "whole grain"},
+			wantCode: ExitOK,
+			wantOut:  "UPDATED\n1\n",
+		},
+		{
+			args:       []string{"update", "1"},
+			wantCode:   ExitUsage,
+			wantStderr: "update: nothing to update",
+		},
+		{
+			args:       []string{"update", "100", "--title", "Missing"},
+			wantCode:   ExitNotFound,
+			wantStderr: "Error: NotFound:",
+		},
+		{
+			args:     []string{"-o", "yaml", "list"},
+			wantCode: ExitOK,
+			wantOut:  "title: Buy bread\n",
+		},
+		{
+			args:       []string{"list", "extra"},
+			wantCode:   ExitUsage,
+			wantStderr: "list: unexpected arguments [extra]",
+		},
+		{
+			args:     []string{"delete", "1", "100"},
+			wantCode: ExitOK,
+			wantOut:  "DELETED\n1\nDELETED\n0\n",
+		},
+		{
+			args:       []string{"delete"},
+			wantCode:   ExitUsage,
+			wantStderr: "delete: expected at least one task ID",
+		},
+		{
+			args:       []string{"get", "1"},
+			wantCode:   ExitNotFound,
+			wantStderr: "Error: NotFound:",
+		},
+		{
+			args:     []string{"list"},
+			wantCode: ExitOK,
+			wantOut:  "ID  TITLE  DESCRIPTION  REMINDER\n",
+		},
+		{
+			args:       []string{"frobnicate"},
+			wantCode:   ExitUsage,
+			wantStderr: "Error: unknown command 'frobnicate'",
+		},
+		{
+			args:       []string{"-o", "xml", "list"},
+			wantCode:   ExitUsage,
+			wantStderr: "invalid output format 'xml'",
+		},
+	}
+	for _, tt := range steps {
+		t.Run(strings.Join(tt.args, " "), func(t *testing.T) {
+			stdout, stderr, code := runClient(srv.Addr, tt.args...)
+			if code != tt.wantCode {
+				t.Fatalf("exit code = %d, want %d, stderr: %s", code, tt.wantCode, stderr)
+			}
+			if !strings.Contains(stdout, tt.wantOut) {
+				t.Errorf("stdout = %q, want to contain %q", stdout, tt.wantOut)
+			}
+			if !strings.Contains(stderr, tt.wantStderr) {
+				t.Errorf("stderr = %q, want to contain %q", stderr, tt.wantStderr)
+			}
+			if len(tt.wantStderr) == 0 && len(stderr) > 0 {
+				t.Errorf("stderr = %q, want empty", stderr)
+			}
+		})
+	}
+}
+
+func TestCommands_Unavailable(t *testing.T) {
+	srv := todotest.Start(t, todotest.Config{})
+	addr := srv.Addr
+	srv.Close()
+
+	_, stderr, code := runClient(addr, "--timeout", "200ms", "list")
+	if code != ExitUnavailable {
+		t.Errorf("exit code = %d, want %d, stderr: %s", code, ExitUnavailable, stderr)
+	}
+}
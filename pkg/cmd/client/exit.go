@@ -0,0 +1,70 @@
+package client
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Exit codes returned by client
+const (
+	// ExitOK means command succeeded
+	ExitOK = 0
+	// ExitError is returned for errors not mapped to other codes
+	ExitError = 1
+	// ExitUsage means invalid command line or configuration
+	ExitUsage = 2
+	// ExitInvalidArgument means server rejected request (InvalidArgument, FailedPrecondition, OutOfRange)
+	ExitInvalidArgument = 3
+	// ExitNotFound means task does not exist
+	ExitNotFound = 4
+	// ExitAlreadyExists means task already exists
+	ExitAlreadyExists = 5
+	// ExitPermission means client is not authenticated or not allowed (Unauthenticated, PermissionDenied)
+	ExitPermission = 6
+	// ExitUnavailable means server is not reachable or did not respond in time (Unavailable, DeadlineExceeded)
+	ExitUnavailable = 7
+	// ExitUnimplemented means server does not support the API version or method
+	ExitUnimplemented = 8
+)
+
+// usageError is error in command line arguments
+type usageError struct {
+	msg string
+}
+
+// Error returns error message
+func (e usageError) Error() string {
+	return e.msg
+}
+
+// ExitCode maps error returned by command to process exit code
+func ExitCode(err error) int {
+	if err == nil {
+		return ExitOK
+	}
+	if _, ok := err.(usageError); ok {
+		return ExitUsage
+	}
+	s, ok := status.FromError(err)
+	if !ok {
+		return ExitError
+	}
+	switch s.Code() {
+	case codes.OK:
+		return ExitOK
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return ExitInvalidArgument
+	case codes.NotFound:
+		return ExitNotFound
+	case codes.AlreadyExists:
+		return ExitAlreadyExists
+	case codes.Unauthenticated, codes.PermissionDenied:
+		return ExitPermission
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return ExitUnavailable
+	case codes.Unimplemented:
+		return ExitUnimplemented
+	default:
+		return ExitError
+	}
+}
@@ -0,0 +1,125 @@
+package client
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"gopkg.in/yaml.v2"
+
+	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
+)
+
+const (
+	// FormatTable prints tasks as aligned text table
+	FormatTable = "table"
+	// FormatJSON prints responses as JSON
+	FormatJSON = "json"
+	// FormatYAML prints responses as YAML
+	FormatYAML = "yaml"
+)
+
+// printer prints responses in selected format
+type printer struct {
+	w      io.Writer
+	format string
+}
+
+// newPrinter validates output format and returns printer
+func newPrinter(w io.Writer, format string) (*printer, error) {
+	switch format {
+	case FormatTable, FormatJSON, FormatYAML:
+		return &printer{w: w, format: format}, nil
+	default:
+		return nil, fmt.Errorf("invalid output format '%s', expected %s, %s or %s", format, FormatTable, FormatJSON, FormatYAML)
+	}
+}
+
+// print prints response message, table format is rendered by table function
+func (p *printer) print(msg proto.Message, table func(w io.Writer)) error {
+	switch p.format {
+	case FormatTable:
+		tw := tabwriter.NewWriter(p.w, 0, 4, 2, ' ', 0)
+		table(tw)
+		return tw.Flush()
+	case FormatJSON:
+		m := jsonpb.Marshaler{OrigName: true, Indent: "  "}
+		if err := m.Marshal(p.w, msg); err != nil {
+			return fmt.Errorf("failed to marshal response: %v", err)
+		}
+		_, err := fmt.Fprintln(p.w)
+		return err
+	default:
+		// JSON is valid YAML, decoding it to MapSlice keeps field order of jsonpb
+		var b bytes.Buffer
+		m := jsonpb.Marshaler{OrigName: true}
+		if err := m.Marshal(&b, msg); err != nil {
+			return fmt.Errorf("failed to marshal response: %v", err)
+		}
+		var v yaml.MapSlice
+		if err := yaml.Unmarshal(b.Bytes(), &v); err != nil {
+			return fmt.Errorf("failed to convert response to YAML: %v", err)
+		}
+		out, err := yaml.Marshal(v)
+		if err != nil {
+			return fmt.Errorf("failed to convert response to YAML: %v", err)
+		}
+		_, err = p.w.Write(out)
+		return err
+	}
+}
+
+// printToDos prints list of tasks
+func (p *printer) printToDos(msg proto.Message, toDos []*v1.ToDo) error {
+	return p.print(msg, func(w io.Writer) {
+		fmt.Fprintln(w, "ID\tTITLE\tDESCRIPTION\tREMINDER")
+		for _, td := range toDos {
+			fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", td.Id, cell(td.Title), cell(td.Description), reminder(td))
+		}
+	})
+}
+
+// printProjects prints list of projects
+func (p *printer) printProjects(msg proto.Message, projects []*v1.Project) error {
+	return p.print(msg, func(w io.Writer) {
+		fmt.Fprintln(w, "ID\tNAME\tDESCRIPTION")
+		for _, pr := range projects {
+			fmt.Fprintf(w, "%d\t%s\t%s\n", pr.Id, cell(pr.Name), cell(pr.Description))
+		}
+	})
+}
+
+// printValue prints single named value in table format, e.g. ID of created task
+func (p *printer) printValue(msg proto.Message, name string, value interface{}) error {
+	return p.print(msg, func(w io.Writer) {
+		fmt.Fprintf(w, "%s\n%v\n", strings.ToUpper(name), value)
+	})
+}
+
+// cell makes text fit table cell
+func cell(s string) string {
+	s = strings.Replace(s, "\n", " ", -1)
+	s = strings.Replace(s, "\t", " ", -1)
+	if r := []rune(s); len(r) > 50 {
+		s = string(r[:47]) + "..."
+	}
+	return s
+}
+
+// reminder formats task reminder for table
+func reminder(td *v1.ToDo) string {
+	if td.Reminder == nil {
+		return ""
+	}
+	t, err := ptypes.Timestamp(td.Reminder)
+	if err != nil {
+		return ""
+	}
+	return t.Local().Format(time.RFC3339)
+}
@@ -0,0 +1,98 @@
+package client
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// defaultProfile is name of profile used when none is selected
+	defaultProfile = "default"
+
+	// defaultServer is gRPC server used when profile does not specify one
+	defaultServer = "localhost:1234"
+)
+
+// Profile is named set of connection parameters
+type Profile struct {
+	// Server is gRPC server in format host:port
+	Server string `yaml:"server"`
+	// Token is bearer token sent in authorization metadata
+	Token string `yaml:"token,omitempty"`
+	// TLS turns on transport security
+	TLS bool `yaml:"tls,omitempty"`
+	// CAFile is PEM file with CA certificates used to verify server, system pool is used if empty
+	CAFile string `yaml:"ca-file,omitempty"`
+	// ServerName overrides server name used to verify server certificate
+	ServerName string `yaml:"server-name,omitempty"`
+}
+
+// Profiles is content of client config file, e.g.
+//
+//	current-profile: local
+//	profiles:
+//	  local:
+//	    server: localhost:1234
+//	  prod:
+//	    server: todo.example.com:443
+//	    tls: true
+//	    token: secret
+type Profiles struct {
+	// CurrentProfile is name of profile used when --profile flag and TODO_PROFILE are not set
+	CurrentProfile string `yaml:"current-profile"`
+	// Profiles are connection parameters by profile name
+	Profiles map[string]Profile `yaml:"profiles"`
+}
+
+// defaultConfigFile returns path of client config file in user home directory
+func defaultConfigFile() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".todo", "config.yaml")
+}
+
+// loadProfiles reads client config file, missing default file means no profiles
+func loadProfiles(path string, explicit bool) (*Profiles, error) {
+	p := &Profiles{}
+	if len(path) == 0 {
+		return p, nil
+	}
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) && !explicit {
+			return p, nil
+		}
+		return nil, fmt.Errorf("failed to read config file: %v", err)
+	}
+	if err := yaml.UnmarshalStrict(b, p); err != nil {
+		return nil, fmt.Errorf("failed to parse config file '%s': %v", path, err)
+	}
+	return p, nil
+}
+
+// profile returns profile by name, empty name selects current profile
+func (p *Profiles) profile(name string) (Profile, error) {
+	if len(name) == 0 {
+		name = p.CurrentProfile
+	}
+	if len(name) == 0 {
+		name = defaultProfile
+	}
+	prof, ok := p.Profiles[name]
+	if !ok {
+		if name != defaultProfile {
+			return Profile{}, fmt.Errorf("unknown profile '%s'", name)
+		}
+		prof = Profile{}
+	}
+	if len(prof.Server) == 0 {
+		prof.Server = defaultServer
+	}
+	return prof, nil
+}
@@ -0,0 +1,107 @@
+package client
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+
+	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
+)
+
+func init() {
+	commands["projects"] = command{
+		usage: "",
+		help:  "List projects",
+		run:   projects,
+	}
+	commands["project-create"] = command{
+		usage: "--name <name> [--description <text>]",
+		help:  "Create project and print its ID",
+		run:   projectCreate,
+	}
+	commands["project-delete"] = command{
+		usage: "<id> [--cascade]",
+		help:  "Delete project which has no tasks, --cascade moves its tasks to trash",
+		run:   projectDelete,
+	}
+}
+
+// projects prints all projects
+func projects(c *cli, args []string) error {
+	if len(args) > 0 {
+		return usageError{msg: fmt.Sprintf("projects: unexpected arguments %v", args)}
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+	res, err := c.client.ListProjects(ctx, &v1.ListProjectsRequest{
+		Api: apiVersion,
+	})
+	if err != nil {
+		return err
+	}
+	return c.printer.printProjects(res, res.Projects)
+}
+
+// projectCreate creates new project
+func projectCreate(c *cli, args []string) error {
+	fs := flag.NewFlagSet("project-create", flag.ContinueOnError)
+	fs.SetOutput(ioutil.Discard)
+	name := fs.String("name", "", "Unique name of the project")
+	description := fs.String("description", "", "Detail description of the project")
+	if err := fs.Parse(args); err != nil {
+		return usageError{msg: fmt.Sprintf("project-create: %v", err)}
+	}
+	if fs.NArg() > 0 {
+		return usageError{msg: fmt.Sprintf("project-create: unexpected arguments %v", fs.Args())}
+	}
+	if len(*name) == 0 {
+		return usageError{msg: "project-create: --name is required"}
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+	res, err := c.client.CreateProject(ctx, &v1.CreateProjectRequest{
+		Api:     apiVersion,
+		Project: &v1.Project{Name: *name, Description: *description},
+	})
+	if err != nil {
+		return err
+	}
+	return c.printer.printValue(res, "id", res.Project.GetId())
+}
+
+// projectDelete deletes project
+func projectDelete(c *cli, args []string) error {
+	fs := flag.NewFlagSet("project-delete", flag.ContinueOnError)
+	fs.SetOutput(ioutil.Discard)
+	cascade := fs.Bool("cascade", false, "Move tasks of the project to trash")
+	positional, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return usageError{msg: "project-delete: expected exactly one project ID"}
+	}
+	id, err := strconv.ParseInt(positional[0], 10, 64)
+	if err != nil || id <= 0 {
+		return usageError{msg: fmt.Sprintf("invalid project ID '%s'", positional[0])}
+	}
+	mode := v1.ProjectDeleteMode_RESTRICT
+	if *cascade {
+		mode = v1.ProjectDeleteMode_CASCADE
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+	res, err := c.client.DeleteProject(ctx, &v1.DeleteProjectRequest{
+		Api:  apiVersion,
+		Id:   id,
+		Mode: mode,
+	})
+	if err != nil {
+		return err
+	}
+	return c.printer.printValue(res, "deleted", res.Deleted)
+}
@@ -0,0 +1,389 @@
+package client
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/peterh/liner"
+
+	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
+)
+
+const (
+	// shellPrompt is prompt printed by shell
+	shellPrompt = "todo> "
+
+	// clearScreen is ANSI sequence moving cursor home and clearing screen
+	clearScreen = "\033[H\033[2J"
+)
+
+// shellCommands are commands available only in shell
+var shellCommands = map[string]command{}
+
+// mutating are commands changing tasks, task list is re-rendered after them
+var mutating = map[string]bool{
+	"create":   true,
+	"update":   true,
+	"delete":   true,
+	"complete": true,
+	"undelete": true,
+	// tasks of project deleted with --cascade are moved to trash
+	"project-delete": true,
+}
+
+func init() {
+	commands["shell"] = command{
+		usage: "",
+		help:  "Start interactive shell with history and tab-completion",
+		run:   shell,
+	}
+	shellCommands["watch"] = command{
+		usage: "[interval]",
+		help:  "Re-render task list every interval (default 2s) until Enter or Ctrl-C",
+		run:   watch,
+	}
+	shellCommands["output"] = command{
+		usage: "table|json|yaml",
+		help:  "Change output format",
+		run:   setOutput,
+	}
+	shellCommands["help"] = command{
+		usage: "",
+		help:  "Print commands",
+		run:   shellHelp,
+	}
+	shellCommands["exit"] = command{
+		usage: "",
+		help:  "Leave shell (Ctrl-D works too)",
+	}
+}
+
+// historyFile returns path of shell history file in user home directory
+func historyFile() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".todo", "history")
+}
+
+// shellCommand returns command available in shell by name
+func shellCommand(name string) (command, bool) {
+	if cmd, ok := shellCommands[name]; ok {
+		return cmd, true
+	}
+	if name == "shell" {
+		return command{}, false
+	}
+	cmd, ok := commands[name]
+	return cmd, ok
+}
+
+// shellCommandNames returns sorted names of commands available in shell
+func shellCommandNames() []string {
+	names := []string{}
+	for name := range commands {
+		if name != "shell" {
+			names = append(names, name)
+		}
+	}
+	for name := range shellCommands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// splitArgs splits command line to arguments, single and double quotes group words
+func splitArgs(line string) ([]string, error) {
+	var (
+		args  []string
+		arg   strings.Builder
+		quote rune
+		inArg bool
+	)
+	for _, r := range line {
+		switch {
+		case quote != 0 && r == quote:
+			quote = 0
+		case quote != 0:
+			arg.WriteRune(r)
+		case r == '"' || r == '\'':
+			quote = r
+			inArg = true
+		case r == ' ' || r == '\t':
+			if inArg {
+				args = append(args, arg.String())
+				arg.Reset()
+				inArg = false
+			}
+		default:
+			arg.WriteRune(r)
+			inArg = true
+		}
+	}
+	if quote != 0 {
+		return nil, usageError{msg: "unterminated quote"}
+	}
+	if inArg {
+		args = append(args, arg.String())
+	}
+	return args, nil
+}
+
+// completer completes command names, task and project IDs
+type completer struct {
+	c *cli
+}
+
+// toDoIDs returns IDs of tasks
+func toDoIDs(toDos []*v1.ToDo) []string {
+	ids := make([]string, 0, len(toDos))
+	for _, td := range toDos {
+		ids = append(ids, strconv.FormatInt(td.Id, 10))
+	}
+	return ids
+}
+
+// taskIDs fetches IDs of all tasks via ReadAll
+func (cp *completer) taskIDs() []string {
+	ctx, cancel := cp.c.context()
+	defer cancel()
+	res, err := cp.c.client.ReadAll(ctx, &v1.ReadAllRequest{
+		Api: apiVersion,
+	})
+	if err != nil {
+		return nil
+	}
+	return toDoIDs(res.ToDos)
+}
+
+// deletedIDs fetches IDs of tasks in trash via ListDeleted
+func (cp *completer) deletedIDs() []string {
+	ctx, cancel := cp.c.context()
+	defer cancel()
+	res, err := cp.c.client.ListDeleted(ctx, &v1.ListDeletedRequest{
+		Api: apiVersion,
+	})
+	if err != nil {
+		return nil
+	}
+	return toDoIDs(res.ToDos)
+}
+
+// projectIDs fetches IDs of all projects via ListProjects
+func (cp *completer) projectIDs() []string {
+	ctx, cancel := cp.c.context()
+	defer cancel()
+	res, err := cp.c.client.ListProjects(ctx, &v1.ListProjectsRequest{
+		Api: apiVersion,
+	})
+	if err != nil {
+		return nil
+	}
+	ids := make([]string, 0, len(res.Projects))
+	for _, p := range res.Projects {
+		ids = append(ids, strconv.FormatInt(p.Id, 10))
+	}
+	return ids
+}
+
+// complete returns candidates for the word under cursor
+func (cp *completer) complete(line string, pos int) (head string, candidates []string, tail string) {
+	head, tail = line[:pos], line[pos:]
+	start := strings.LastIndexAny(head, " \t") + 1
+	word := head[start:]
+	head = head[:start]
+
+	var words []string
+	fields := strings.Fields(head)
+	switch {
+	case len(fields) == 0:
+		words = shellCommandNames()
+	case fields[len(fields)-1] == "--project" && (fields[0] == "create" || fields[0] == "update" || fields[0] == "list"):
+		words = cp.projectIDs()
+	case fields[0] == "get" || fields[0] == "update" || fields[0] == "delete" || fields[0] == "complete":
+		if strings.HasPrefix(word, "-") {
+			if fields[0] == "update" {
+				words = []string{"--title", "--description", "--reminder", "--recurrence", "--tags", "--project"}
+			}
+		} else {
+			words = cp.taskIDs()
+		}
+	case fields[0] == "undelete":
+		words = cp.deletedIDs()
+	case fields[0] == "create":
+		words = []string{"--title", "--description", "--reminder", "--recurrence", "--tags", "--project"}
+	case fields[0] == "list":
+		words = []string{"--project"}
+	case fields[0] == "project-create":
+		words = []string{"--name", "--description"}
+	case fields[0] == "project-delete":
+		if strings.HasPrefix(word, "-") {
+			words = []string{"--cascade"}
+		} else {
+			words = cp.projectIDs()
+		}
+	case fields[0] == "output" && len(fields) == 1:
+		words = []string{FormatTable, FormatJSON, FormatYAML}
+	}
+
+	for _, w := range words {
+		if strings.HasPrefix(w, word) {
+			candidates = append(candidates, w+" ")
+		}
+	}
+	return head, candidates, tail
+}
+
+// shell runs interactive read-eval-print loop
+func shell(c *cli, args []string) error {
+	if len(args) > 0 {
+		return usageError{msg: fmt.Sprintf("shell: unexpected arguments %v", args)}
+	}
+
+	line := liner.NewLiner()
+	defer line.Close()
+	c.line = line
+	defer func() {
+		c.line = nil
+	}()
+	line.SetCtrlCAborts(true)
+	line.SetTabCompletionStyle(liner.TabPrints)
+	cp := &completer{c: c}
+	line.SetWordCompleter(cp.complete)
+
+	history := historyFile()
+	if len(history) > 0 {
+		if f, err := os.Open(history); err == nil {
+			_, _ = line.ReadHistory(f)
+			f.Close()
+		}
+		defer func() {
+			if err := os.MkdirAll(filepath.Dir(history), 0700); err != nil {
+				return
+			}
+			if f, err := os.OpenFile(history, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600); err == nil {
+				_, _ = line.WriteHistory(f)
+				f.Close()
+			}
+		}()
+	}
+
+	fmt.Fprintln(c.stdout, "Type 'help' for commands, Tab completes commands and task IDs.")
+	for {
+		input, err := line.Prompt(shellPrompt)
+		if err == liner.ErrPromptAborted {
+			continue
+		}
+		if err != nil {
+			// io.EOF on Ctrl-D
+			fmt.Fprintln(c.stdout)
+			return nil
+		}
+
+		args, err := splitArgs(input)
+		if err != nil {
+			printError(c.stderr, err)
+			continue
+		}
+		if len(args) == 0 {
+			continue
+		}
+		line.AppendHistory(input)
+		if args[0] == "exit" || args[0] == "quit" {
+			return nil
+		}
+
+		cmd, ok := shellCommand(args[0])
+		if !ok {
+			fmt.Fprintf(c.stderr, "Error: unknown command '%s', type 'help' for commands\n", args[0])
+			continue
+		}
+		if err := cmd.run(c, args[1:]); err != nil {
+			printError(c.stderr, err)
+			continue
+		}
+		if mutating[args[0]] && c.printer.format == FormatTable {
+			fmt.Fprintln(c.stdout)
+			if err := list(c, nil); err != nil {
+				printError(c.stderr, err)
+			}
+		}
+	}
+}
+
+// watch re-renders task list until Enter or Ctrl-C is pressed.
+// The keys are read by line editor of shell, so no reader is left behind to consume the next command.
+func watch(c *cli, args []string) error {
+	interval := 2 * time.Second
+	if len(args) > 1 {
+		return usageError{msg: "watch: expected at most one interval argument"}
+	}
+	if len(args) == 1 {
+		d, err := time.ParseDuration(args[0])
+		if err != nil || d <= 0 {
+			return usageError{msg: fmt.Sprintf("watch: invalid interval '%s'", args[0])}
+		}
+		interval = d
+	}
+
+	if c.line == nil {
+		return usageError{msg: "watch: available in shell only"}
+	}
+
+	stop := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			fmt.Fprint(c.stdout, clearScreen)
+			fmt.Fprintf(c.stdout, "Every %v: list    %s    (Enter or Ctrl-C to stop)\n\n", interval, time.Now().Format(time.RFC3339))
+			if err := list(c, nil); err != nil {
+				printError(c.stderr, err)
+			}
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	// Enter ends the prompt, Ctrl-C aborts it and Ctrl-D ends input
+	_, _ = c.line.Prompt("")
+	close(stop)
+	<-stopped
+	return nil
+}
+
+// setOutput changes output format of shell
+func setOutput(c *cli, args []string) error {
+	if len(args) != 1 {
+		return usageError{msg: "output: expected table, json or yaml"}
+	}
+	p, err := newPrinter(c.stdout, args[0])
+	if err != nil {
+		return usageError{msg: err.Error()}
+	}
+	c.printer = p
+	return nil
+}
+
+// shellHelp prints commands available in shell
+func shellHelp(c *cli, args []string) error {
+	tw := tabwriter.NewWriter(c.stdout, 0, 4, 2, ' ', 0)
+	for _, name := range shellCommandNames() {
+		cmd, _ := shellCommand(name)
+		fmt.Fprintf(tw, "  %s %s\t%s\n", name, cmd.usage, cmd.help)
+	}
+	return tw.Flush()
+}
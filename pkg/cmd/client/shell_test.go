@@ -0,0 +1,59 @@
+package client
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
+	"go.smartmachine.io/go-grpc-api/pkg/testing/todotest"
+)
+
+func TestCompleter(t *testing.T) {
+	ctx := context.Background()
+	srv := todotest.Start(t, todotest.Config{})
+	defer srv.Close()
+
+	reminder, _ := ptypes.TimestampProto(time.Now())
+	srv.Seed(t, &v1.ToDo{Title: "first", Reminder: reminder}, &v1.ToDo{Title: "second", Reminder: reminder})
+	if _, err := srv.Client.Delete(ctx, &v1.DeleteRequest{Id: 2}); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"home", "work"} {
+		if _, err := srv.Client.CreateProject(ctx, &v1.CreateProjectRequest{Project: &v1.Project{Name: name}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cp := &completer{c: &cli{client: srv.Client, timeout: 5 * time.Second}}
+	tests := []struct {
+		line string
+		want []string
+	}{
+		{line: "und", want: []string{"undelete "}},
+		{line: "get ", want: []string{"1 "}},
+		{line: "create --t", want: []string{"--title ", "--tags "}},
+		{line: "update 1 --t", want: []string{"--title ", "--tags "}},
+		{line: "create --p", want: []string{"--project "}},
+		{line: "update 1 --p", want: []string{"--project "}},
+		{line: "create --title x --project ", want: []string{"1 ", "2 "}},
+		{line: "update 1 --project 2", want: []string{"2 "}},
+		{line: "undelete ", want: []string{"2 "}},
+		{line: "list --", want: []string{"--project "}},
+		{line: "list --project ", want: []string{"1 ", "2 "}},
+		{line: "project-create --", want: []string{"--name ", "--description "}},
+		{line: "project-delete ", want: []string{"1 ", "2 "}},
+		{line: "project-delete 1 --", want: []string{"--cascade "}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.line, func(t *testing.T) {
+			_, got, _ := cp.complete(tt.line, len(tt.line))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("complete(%q) = %q, want %q", tt.line, got, tt.want)
+			}
+		})
+	}
+}
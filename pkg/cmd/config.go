@@ -0,0 +1,349 @@
+package cmd
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"go.uber.org/multierr"
+	"gopkg.in/yaml.v2"
+
+	"go.smartmachine.io/go-grpc-api/pkg/events"
+	"go.smartmachine.io/go-grpc-api/pkg/logger"
+	"go.smartmachine.io/go-grpc-api/pkg/protocol/rest"
+	"go.smartmachine.io/go-grpc-api/pkg/scheduler"
+	"go.smartmachine.io/go-grpc-api/pkg/trash"
+	"go.smartmachine.io/go-grpc-api/pkg/webhook"
+)
+
+const (
+	// envPrefix is prefix of environment variables overriding configuration,
+	// e.g. TODO_GRPC_PORT overrides grpc-port
+	envPrefix = "TODO_"
+
+	// configFlag is name of flag (and TODO_CONFIG environment variable) with path to config file
+	configFlag = "config"
+
+	// redacted replaces secrets when configuration is printed
+	redacted = "[REDACTED]"
+)
+
+// secrets are names of configuration parameters never printed
+var secrets = map[string]bool{
+	"admin-token": true,
+}
+
+// Config is configuration for Server
+type Config struct {
+	// gRPC server start parameters section
+	// gRPC is TCP port to listen by gRPC server
+	GRPCPort string
+	HTTPPort string
+	// HTTPMaxRequestSize is size in bytes HTTP request bodies, e.g. uploaded files, are limited to
+	HTTPMaxRequestSize int64
+
+	// AdminToken is bearer token required by admin API, empty token disables admin API
+	AdminToken string
+
+	// WatchHistorySize is number of recent changes kept for resuming watchers
+	WatchHistorySize int
+
+	// Reminder scheduler parameters section
+	// ReminderInterval is time between scans for due reminders, 0 disables reminder scheduler
+	ReminderInterval time.Duration
+	// ReminderLease is time replica has to fire reminder before other replica fires it
+	ReminderLease time.Duration
+	// ReminderCatchUp is how old due reminders may be to be fired
+	ReminderCatchUp time.Duration
+
+	// Trash parameters section
+	// TrashRetention is how long deleted tasks are kept in trash, 0 keeps them forever
+	TrashRetention time.Duration
+	// TrashPurgeInterval is time between purges of trash
+	TrashPurgeInterval time.Duration
+
+	// Webhook parameters section
+	// WebhookMaxAttempts is number of attempts to deliver event to webhook before it becomes dead letter
+	WebhookMaxAttempts int
+	// WebhookBackoff is time before the first retry of delivery, it doubles with every retry
+	WebhookBackoff time.Duration
+	// WebhookMaxBackoff is the longest time between retries of delivery
+	WebhookMaxBackoff time.Duration
+	// WebhookTimeout is time limit of one delivery attempt
+	WebhookTimeout time.Duration
+
+	// Log parameters section
+	// LogLevel is global log level: Debug(-1), Info(0), Warn(1), Error(2), DPanic(3), Panic(4), Fatal(5)
+	LogLevel int
+	// LogTimeFormat is print time format for logger e.g. 2006-01-02T15:04:05Z07:00
+	LogTimeFormat string
+	// LogFormat is log encoding: console or json
+	LogFormat string
+	// LogOutput is comma separated list of log sinks: stdout, stderr or file path
+	LogOutput string
+	// LogMaxSize is size in megabytes a log file may grow to before it is rotated
+	LogMaxSize int
+	// LogMaxBackups is number of rotated log files to keep
+	LogMaxBackups int
+	// LogSamplingInitial is number of identical log entries per second logged before sampling
+	LogSamplingInitial int
+	// LogSamplingThereafter is every how many identical log entries one is logged after sampling kicks in
+	LogSamplingThereafter int
+	// LogPayload turns on logging of request/response payloads with sensitive fields redacted
+	LogPayload bool
+	// LogPayloadMaxSize is size in bytes logged payloads are truncated to
+	LogPayloadMaxSize int
+}
+
+// newFlagSet defines every configuration parameter as a flag with its default value.
+// Flag names are used as keys in config file and, upper cased with TODO_ prefix, as environment variables.
+func newFlagSet(cfg *Config) *flag.FlagSet {
+	fs := flag.NewFlagSet("server", flag.ContinueOnError)
+	fs.String(configFlag, "", "Path to YAML (.yaml, .yml) or TOML (.toml) config file")
+	fs.StringVar(&cfg.GRPCPort, "grpc-port", "1234", "gRPC port to bind")
+	fs.StringVar(&cfg.HTTPPort, "http-port", "8080", "HTTP port to bind")
+	fs.Int64Var(&cfg.HTTPMaxRequestSize, "http-max-request-size", rest.DefaultMaxRequestSize,
+		"Size in bytes HTTP request bodies, e.g. uploaded files, are limited to, 0 means no limit")
+	fs.StringVar(&cfg.AdminToken, "admin-token", "", "Bearer token required by admin API, admin API is disabled if empty")
+	fs.IntVar(&cfg.WatchHistorySize, "watch-history-size", events.DefaultHistorySize,
+		"Number of recent changes kept for watchers resuming after sequence number")
+	fs.DurationVar(&cfg.ReminderInterval, "reminder-interval", scheduler.DefaultInterval,
+		"Time between scans for due reminders, 0 disables reminder scheduler")
+	fs.DurationVar(&cfg.ReminderLease, "reminder-lease", scheduler.DefaultLease,
+		"Time replica has to fire due reminder before other replica fires it")
+	fs.DurationVar(&cfg.ReminderCatchUp, "reminder-catch-up", scheduler.DefaultCatchUp,
+		"How old due reminders may be to be fired, e.g. after server was down")
+	fs.DurationVar(&cfg.TrashRetention, "trash-retention", trash.DefaultRetention,
+		"How long deleted tasks are kept in trash before they are purged, 0 keeps them forever")
+	fs.DurationVar(&cfg.TrashPurgeInterval, "trash-purge-interval", trash.DefaultInterval, "Time between purges of trash")
+	fs.IntVar(&cfg.WebhookMaxAttempts, "webhook-max-attempts", webhook.DefaultMaxAttempts,
+		"Number of attempts to deliver event to webhook before it becomes dead letter")
+	fs.DurationVar(&cfg.WebhookBackoff, "webhook-backoff", webhook.DefaultBackoff,
+		"Time before the first retry of webhook delivery, it doubles with every retry")
+	fs.DurationVar(&cfg.WebhookMaxBackoff, "webhook-max-backoff", webhook.DefaultMaxBackoff,
+		"The longest time between retries of webhook delivery")
+	fs.DurationVar(&cfg.WebhookTimeout, "webhook-timeout", webhook.DefaultTimeout, "Time limit of one webhook delivery attempt")
+	fs.IntVar(&cfg.LogLevel, "log-level", 0, "Global log level")
+	fs.StringVar(&cfg.LogTimeFormat, "log-time-format", "2006-01-02T15:04:05.0000Z07:00",
+		"Print time format for logger e.g. 2006-01-02T15:04:05Z07:00")
+	fs.StringVar(&cfg.LogFormat, "log-format", logger.FormatConsole, "Log format: console or json")
+	fs.StringVar(&cfg.LogOutput, "log-output", "",
+		"Comma separated log sinks: stdout, stderr or file path (default errors to stderr, rest to stdout)")
+	fs.IntVar(&cfg.LogMaxSize, "log-max-size", 100, "Size in megabytes of log file before it is rotated, 0 disables rotation")
+	fs.IntVar(&cfg.LogMaxBackups, "log-max-backups", 3, "Number of rotated log files to keep")
+	fs.IntVar(&cfg.LogSamplingInitial, "log-sampling-initial", 0,
+		"Number of identical log entries below ERROR logged per second before sampling, 0 disables sampling")
+	fs.IntVar(&cfg.LogSamplingThereafter, "log-sampling-thereafter", 100,
+		"Log every Nth identical entry once sampling kicks in")
+	fs.BoolVar(&cfg.LogPayload, "log-payload", false, "Log request/response payloads, fields marked with (log.redact) are redacted")
+	fs.IntVar(&cfg.LogPayloadMaxSize, "log-payload-max-size", 4096, "Size in bytes logged payloads are truncated to, 0 means no limit")
+	return fs
+}
+
+// envName returns name of environment variable overriding the flag
+func envName(flagName string) string {
+	return envPrefix + strings.ToUpper(strings.Replace(flagName, "-", "_", -1))
+}
+
+// readConfigFile reads parameters from YAML or TOML config file
+func readConfigFile(path string) (map[string]interface{}, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %v", err)
+	}
+
+	values := map[string]interface{}{}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(b, &values)
+	case ".toml":
+		err = toml.Unmarshal(b, &values)
+	default:
+		return nil, fmt.Errorf("unsupported config file format '%s', expected .yaml, .yml or .toml", path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse config file '%s': %v", path, err)
+	}
+	return values, nil
+}
+
+// LoadConfig loads configuration with precedence (highest first):
+// command line flags, TODO_* environment variables, config file, defaults.
+// All invalid parameters are reported at once.
+func LoadConfig(args []string) (*Config, error) {
+	var cfg Config
+	fs := newFlagSet(&cfg)
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+
+	// flags set on command line take precedence over everything else
+	set := map[string]bool{}
+	fs.Visit(func(f *flag.Flag) {
+		set[f.Name] = true
+	})
+
+	var errs error
+
+	// environment variables
+	fs.VisitAll(func(f *flag.Flag) {
+		if set[f.Name] {
+			return
+		}
+		if v, ok := os.LookupEnv(envName(f.Name)); ok {
+			if err := fs.Set(f.Name, v); err != nil {
+				errs = multierr.Append(errs, fmt.Errorf("environment variable %s: %v", envName(f.Name), err))
+			}
+			set[f.Name] = true
+		}
+	})
+
+	// config file
+	if path := fs.Lookup(configFlag).Value.String(); len(path) > 0 {
+		values, err := readConfigFile(path)
+		errs = multierr.Append(errs, err)
+		for name, v := range values {
+			f := fs.Lookup(name)
+			if f == nil || name == configFlag {
+				errs = multierr.Append(errs, fmt.Errorf("config file: unknown parameter '%s'", name))
+				continue
+			}
+			if set[name] {
+				continue
+			}
+			if err := fs.Set(name, fmt.Sprint(v)); err != nil {
+				errs = multierr.Append(errs, fmt.Errorf("config file: parameter '%s': %v", name, err))
+			}
+		}
+	}
+
+	errs = multierr.Append(errs, cfg.Validate())
+	if errs != nil {
+		msgs := []string{}
+		for _, err := range multierr.Errors(errs) {
+			msgs = append(msgs, err.Error())
+		}
+		return nil, fmt.Errorf("invalid configuration:\n  %s", strings.Join(msgs, "\n  "))
+	}
+	return &cfg, nil
+}
+
+// validPort checks TCP port
+func validPort(name, port string) error {
+	if p, err := strconv.Atoi(port); err != nil || p < 1 || p > 65535 {
+		return fmt.Errorf("invalid TCP port for %s: '%s'", name, port)
+	}
+	return nil
+}
+
+// Validate checks configuration and returns all errors found
+func (cfg *Config) Validate() error {
+	var errs error
+	errs = multierr.Append(errs, validPort("gRPC server", cfg.GRPCPort))
+	errs = multierr.Append(errs, validPort("HTTP gateway", cfg.HTTPPort))
+	if cfg.HTTPMaxRequestSize < 0 {
+		errs = multierr.Append(errs, fmt.Errorf("invalid HTTP max request size: %d", cfg.HTTPMaxRequestSize))
+	}
+	if cfg.WatchHistorySize < 1 {
+		errs = multierr.Append(errs, fmt.Errorf("invalid watch history size: %d", cfg.WatchHistorySize))
+	}
+	if cfg.ReminderInterval < 0 {
+		errs = multierr.Append(errs, fmt.Errorf("invalid reminder interval: %v", cfg.ReminderInterval))
+	}
+	if cfg.ReminderLease <= 0 {
+		errs = multierr.Append(errs, fmt.Errorf("invalid reminder lease: %v", cfg.ReminderLease))
+	}
+	if cfg.ReminderCatchUp <= 0 {
+		errs = multierr.Append(errs, fmt.Errorf("invalid reminder catch up: %v", cfg.ReminderCatchUp))
+	}
+	if cfg.TrashRetention < 0 {
+		errs = multierr.Append(errs, fmt.Errorf("invalid trash retention: %v", cfg.TrashRetention))
+	}
+	if cfg.TrashPurgeInterval <= 0 {
+		errs = multierr.Append(errs, fmt.Errorf("invalid trash purge interval: %v", cfg.TrashPurgeInterval))
+	}
+	if cfg.WebhookMaxAttempts < 1 {
+		errs = multierr.Append(errs, fmt.Errorf("invalid webhook max attempts: %d", cfg.WebhookMaxAttempts))
+	}
+	if cfg.WebhookBackoff <= 0 {
+		errs = multierr.Append(errs, fmt.Errorf("invalid webhook backoff: %v", cfg.WebhookBackoff))
+	}
+	if cfg.WebhookMaxBackoff < cfg.WebhookBackoff {
+		errs = multierr.Append(errs, fmt.Errorf("invalid webhook max backoff: %v, expected at least backoff %v",
+			cfg.WebhookMaxBackoff, cfg.WebhookBackoff))
+	}
+	if cfg.WebhookTimeout <= 0 {
+		errs = multierr.Append(errs, fmt.Errorf("invalid webhook timeout: %v", cfg.WebhookTimeout))
+	}
+	if cfg.LogLevel < -1 || cfg.LogLevel > 5 {
+		errs = multierr.Append(errs, fmt.Errorf("invalid log level: %d, expected -1..5", cfg.LogLevel))
+	}
+	if cfg.LogFormat != logger.FormatConsole && cfg.LogFormat != logger.FormatJSON {
+		errs = multierr.Append(errs, fmt.Errorf("invalid log format: '%s', expected '%s' or '%s'",
+			cfg.LogFormat, logger.FormatConsole, logger.FormatJSON))
+	}
+	if cfg.LogMaxSize < 0 {
+		errs = multierr.Append(errs, fmt.Errorf("invalid log max size: %d", cfg.LogMaxSize))
+	}
+	if cfg.LogMaxBackups < 0 {
+		errs = multierr.Append(errs, fmt.Errorf("invalid log max backups: %d", cfg.LogMaxBackups))
+	}
+	if cfg.LogSamplingInitial < 0 || cfg.LogSamplingThereafter < 0 ||
+		(cfg.LogSamplingInitial > 0 && cfg.LogSamplingThereafter < 1) {
+		errs = multierr.Append(errs, fmt.Errorf("invalid log sampling: initial %d, thereafter %d, expected thereafter at least 1 when sampling is enabled",
+			cfg.LogSamplingInitial, cfg.LogSamplingThereafter))
+	}
+	if cfg.LogPayloadMaxSize < 0 {
+		errs = multierr.Append(errs, fmt.Errorf("invalid log payload max size: %d", cfg.LogPayloadMaxSize))
+	}
+	return errs
+}
+
+// PrintConfig loads configuration the same way the server does and prints
+// the effective configuration in YAML config file format with secrets redacted
+func PrintConfig(w io.Writer, args []string) error {
+	cfg, err := LoadConfig(args)
+	if err != nil {
+		return err
+	}
+
+	// newFlagSet resets cfg to defaults, so loaded values are restored afterwards
+	loaded := *cfg
+	fs := newFlagSet(cfg)
+	*cfg = loaded
+
+	values := yaml.MapSlice{}
+	names := []string{}
+	fs.VisitAll(func(f *flag.Flag) {
+		if f.Name != configFlag {
+			names = append(names, f.Name)
+		}
+	})
+	sort.Strings(names)
+	for _, name := range names {
+		v := fs.Lookup(name).Value.(flag.Getter).Get()
+		if secrets[name] && v != "" {
+			v = redacted
+		}
+		// durations are printed the way they are parsed, e.g. 10s
+		if d, ok := v.(time.Duration); ok {
+			v = d.String()
+		}
+		values = append(values, yaml.MapItem{Key: name, Value: v})
+	}
+
+	b, err := yaml.Marshal(values)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(b)
+	return err
+}
@@ -0,0 +1,156 @@
+package cmd
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLoadConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	yamlFile := filepath.Join(dir, "server.yaml")
+	if err := ioutil.WriteFile(yamlFile, []byte("grpc-port: 2000\nhttp-port: 2001\nlog-format: json\nlog-level: -1\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	tomlFile := filepath.Join(dir, "server.toml")
+	if err := ioutil.WriteFile(tomlFile, []byte("grpc-port = \"3000\"\nlog-payload = true\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	brokenFile := filepath.Join(dir, "broken.yaml")
+	if err := ioutil.WriteFile(brokenFile, []byte("grpc-port: [2000\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	badFile := filepath.Join(dir, "bad.yaml")
+	if err := ioutil.WriteFile(badFile, []byte("grpc-port: x\nlog-format: xml\nunknown: 1\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		args    []string
+		env     map[string]string
+		check   func(cfg *Config) bool
+		wantErr []string
+	}{
+		{
+			name: "Defaults",
+			check: func(cfg *Config) bool {
+				return cfg.GRPCPort == "1234" && cfg.HTTPPort == "8080" && cfg.LogFormat == "console"
+			},
+		},
+		{
+			name: "YAML file",
+			args: []string{"--config", yamlFile},
+			check: func(cfg *Config) bool {
+				return cfg.GRPCPort == "2000" && cfg.HTTPPort == "2001" && cfg.LogFormat == "json" && cfg.LogLevel == -1
+			},
+		},
+		{
+			name: "TOML file",
+			env:  map[string]string{"TODO_CONFIG": tomlFile},
+			check: func(cfg *Config) bool {
+				return cfg.GRPCPort == "3000" && cfg.LogPayload
+			},
+		},
+		{
+			name: "Precedence",
+			args: []string{"--config", yamlFile, "--grpc-port", "4000"},
+			env:  map[string]string{"TODO_GRPC_PORT": "5000", "TODO_HTTP_PORT": "5001"},
+			check: func(cfg *Config) bool {
+				return cfg.GRPCPort == "4000" && cfg.HTTPPort == "5001" && cfg.LogFormat == "json"
+			},
+		},
+		{
+			name: "All errors reported",
+			args: []string{"--config", badFile},
+			env:  map[string]string{"TODO_LOG_LEVEL": "high"},
+			wantErr: []string{
+				"unknown parameter 'unknown'",
+				"TODO_LOG_LEVEL",
+				"invalid TCP port for gRPC server: 'x'",
+				"invalid log format: 'xml'",
+			},
+		},
+		{
+			name: "Missing file reported with other errors",
+			args: []string{"--config", filepath.Join(dir, "missing.yaml"), "--http-port", "0"},
+			env:  map[string]string{"TODO_LOG_LEVEL": "high"},
+			wantErr: []string{
+				"failed to read config file",
+				"TODO_LOG_LEVEL",
+				"invalid TCP port for HTTP gateway: '0'",
+			},
+		},
+		{
+			name: "Unparsable file reported with other errors",
+			args: []string{"--config", brokenFile, "--grpc-port", "x"},
+			wantErr: []string{
+				"failed to parse config file",
+				"invalid TCP port for gRPC server: 'x'",
+			},
+		},
+		{
+			name:    "Sampling without thereafter",
+			args:    []string{"--log-sampling-initial", "1", "--log-sampling-thereafter", "0"},
+			wantErr: []string{"invalid log sampling: initial 1, thereafter 0"},
+		},
+		{
+			name:    "Negative HTTP max request size",
+			args:    []string{"--http-max-request-size", "-1"},
+			wantErr: []string{"invalid HTTP max request size: -1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for k, v := range tt.env {
+				os.Setenv(k, v)
+			}
+			defer func() {
+				for k := range tt.env {
+					os.Unsetenv(k)
+				}
+			}()
+
+			got, err := LoadConfig(tt.args)
+			if len(tt.wantErr) > 0 {
+				if err == nil {
+					t.Fatalf("LoadConfig() error = nil, want %v", tt.wantErr)
+				}
+				for _, want := range tt.wantErr {
+					if !strings.Contains(err.Error(), want) {
+						t.Errorf("LoadConfig() error = %v, want to contain %v", err, want)
+					}
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadConfig() error = %v", err)
+			}
+			if !tt.check(got) {
+				t.Errorf("LoadConfig() = %+v", got)
+			}
+		})
+	}
+}
+
+func TestPrintConfig(t *testing.T) {
+	var b bytes.Buffer
+	if err := PrintConfig(&b, []string{"--admin-token", "secret", "--http-port", "9090"}); err != nil {
+		t.Fatalf("PrintConfig() error = %v", err)
+	}
+	out := b.String()
+	if strings.Contains(out, "secret") || !strings.Contains(out, "admin-token: '[REDACTED]'") {
+		t.Errorf("PrintConfig() secret not redacted:\n%s", out)
+	}
+	if !strings.Contains(out, `http-port: "9090"`) {
+		t.Errorf("PrintConfig() http-port not printed:\n%s", out)
+	}
+}
@@ -2,54 +2,49 @@ package cmd
 
 import (
 	"context"
-	"flag"
 	"fmt"
+	"os"
+
 	"github.com/jinzhu/gorm"
-	apiv1 "go.smartmachine.io/go-grpc-api/pkg/api/v1"
 	"go.smartmachine.io/go-grpc-api/pkg/logger"
 	"go.smartmachine.io/go-grpc-api/pkg/protocol/rest"
 
 	_ "github.com/jinzhu/gorm/dialects/sqlite"
 
+	"go.smartmachine.io/go-grpc-api/pkg/events"
 	"go.smartmachine.io/go-grpc-api/pkg/protocol/grpc"
+	"go.smartmachine.io/go-grpc-api/pkg/scheduler"
+	"go.smartmachine.io/go-grpc-api/pkg/schema"
 	servicev1 "go.smartmachine.io/go-grpc-api/pkg/service/v1"
+	"go.smartmachine.io/go-grpc-api/pkg/trash"
+	"go.smartmachine.io/go-grpc-api/pkg/webhook"
 )
 
-// Config is configuration for Server
-type Config struct {
-	// gRPC server start parameters section
-	// gRPC is TCP port to listen by gRPC server
-	GRPCPort string
-	HTTPPort string
-
-	// Log parameters section
-	// LogLevel is global log level: Debug(-1), Info(0), Warn(1), Error(2), DPanic(3), Panic(4), Fatal(5)
-	LogLevel int
-	// LogTimeFormat is print time format for logger e.g. 2006-01-02T15:04:05Z07:00
-	LogTimeFormat string
-}
-
 // RunServer runs gRPC server and HTTP gateway
 func RunServer() error {
 	ctx := context.Background()
 
-	// get configuration
-	var cfg Config
-	flag.StringVar(&cfg.GRPCPort, "grpc-port", "1234", "gRPC port to bind")
-	flag.StringVar(&cfg.HTTPPort, "http-port", "8080", "HTTP port to bind")
-	flag.IntVar(&cfg.LogLevel, "log-level", 0, "Global log level")
-	flag.StringVar(&cfg.LogTimeFormat, "log-time-format", "2006-01-02T15:04:05.0000Z07:00",
-		"Print time format for logger e.g. 2006-01-02T15:04:05Z07:00")
-	flag.Parse()
-
-	if len(cfg.GRPCPort) == 0 {
-		return fmt.Errorf("invalid TCP port for gRPC server: '%s'", cfg.GRPCPort)
+	args := os.Args[1:]
+	if len(args) >= 2 && args[0] == "config" && args[1] == "print" {
+		return PrintConfig(os.Stdout, args[2:])
 	}
-	if len(cfg.HTTPPort) == 0 {
-		return fmt.Errorf("invalid TCP port for HTTP gateway: '%s'", cfg.HTTPPort)
+
+	// get configuration
+	cfg, err := LoadConfig(args)
+	if err != nil {
+		return err
 	}
 
-	if err := logger.Init(cfg.LogLevel, cfg.LogTimeFormat); err != nil {
+	if err := logger.Init(logger.Config{
+		Level:              cfg.LogLevel,
+		TimeFormat:         cfg.LogTimeFormat,
+		Format:             cfg.LogFormat,
+		Output:             cfg.LogOutput,
+		MaxSize:            cfg.LogMaxSize,
+		MaxBackups:         cfg.LogMaxBackups,
+		SamplingInitial:    cfg.LogSamplingInitial,
+		SamplingThereafter: cfg.LogSamplingThereafter,
+	}); err != nil {
 		return fmt.Errorf("failed to initialize logger: %v", err)
 	}
 
@@ -58,21 +53,67 @@ func RunServer() error {
 		return fmt.Errorf("failed to open database: %v", err)
 	}
 	defer db.Close()
+	// every connection to in-memory SQLite opens its own empty database,
+	// so concurrent calls served by another pooled connection would find no tables
+	db.DB().SetMaxOpenConns(1)
 
-	err = db.AutoMigrate(&apiv1.ToDoORM{}).Error
-	if err != nil {
+	if err := schema.Migrate(db); err != nil {
 		return fmt.Errorf("failed to create schema: %v", err)
 	}
 
+	logger.Log.Info("created schema")
+
+	bus := events.NewBus(cfg.WatchHistorySize)
+	v1API := servicev1.NewToDoServiceServer(db, bus)
+
+	// run reminder scheduler
+	if cfg.ReminderInterval > 0 {
+		sched := scheduler.New(db, scheduler.Config{
+			Interval:  cfg.ReminderInterval,
+			Lease:     cfg.ReminderLease,
+			CatchUp:   cfg.ReminderCatchUp,
+			Notifiers: []scheduler.Notifier{scheduler.LogNotifier(), scheduler.BusNotifier(bus)},
+		})
+		go func() {
+			_ = sched.Run(ctx)
+		}()
+	}
 
-	logger.Log.Info("created schema: ToDoORM")
+	// purge trash
+	if cfg.TrashRetention > 0 {
+		purger := trash.New(db, trash.Config{
+			Retention: cfg.TrashRetention,
+			Interval:  cfg.TrashPurgeInterval,
+		})
+		go func() {
+			_ = purger.Run(ctx)
+		}()
+	}
 
-	v1API := servicev1.NewToDoServiceServer(db)
+	// post events to webhooks
+	hooks := webhook.New(db, webhook.Config{
+		MaxAttempts: cfg.WebhookMaxAttempts,
+		Backoff:     cfg.WebhookBackoff,
+		MaxBackoff:  cfg.WebhookMaxBackoff,
+		Timeout:     cfg.WebhookTimeout,
+	})
+	if err := hooks.Start(ctx, bus); err != nil {
+		return fmt.Errorf("failed to start webhooks: %v", err)
+	}
+	adminAPI := servicev1.NewAdminServiceServer(hooks)
 
 	// run HTTP gateway
 	go func() {
-		_ = rest.RunServer(ctx, cfg.GRPCPort, cfg.HTTPPort)
+		_ = rest.RunServer(ctx, cfg.GRPCPort, cfg.HTTPPort, rest.Config{
+			LogPayload:        cfg.LogPayload,
+			LogPayloadMaxSize: cfg.LogPayloadMaxSize,
+			MaxRequestSize:    cfg.HTTPMaxRequestSize,
+		})
 	}()
 
-	return grpc.RunServer(ctx, v1API, cfg.GRPCPort)
+	return grpc.RunServer(ctx, v1API, adminAPI, cfg.GRPCPort, grpc.Config{
+		AdminToken:        cfg.AdminToken,
+		LogPayload:        cfg.LogPayload,
+		LogPayloadMaxSize: cfg.LogPayloadMaxSize,
+	})
 }
@@ -0,0 +1,172 @@
+// Package events distributes changes of todo tasks to watchers.
+package events
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+
+	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
+)
+
+const (
+	// DefaultHistorySize is number of recent events kept for resuming watchers
+	DefaultHistorySize = 1000
+
+	// subscriptionBuffer is number of live events queued for subscriber before it is dropped
+	subscriptionBuffer = 256
+)
+
+var (
+	// ErrHistoryExpired is returned if events after requested sequence are no longer kept
+	ErrHistoryExpired = errors.New("events after requested sequence are no longer available")
+	// ErrSlowSubscriber closes subscription which did not keep up with events
+	ErrSlowSubscriber = errors.New("subscriber did not keep up with events")
+	// ErrClosed closes subscriptions when bus is closed
+	ErrClosed = errors.New("event bus is closed")
+)
+
+// Bus publishes events to subscribers and keeps recent events for resuming
+type Bus struct {
+	mu      sync.Mutex
+	seq     int64
+	history []*v1.Event
+	size    int
+	subs    map[*Subscription]struct{}
+	closed  bool
+}
+
+// NewBus creates event bus keeping historySize recent events
+func NewBus(historySize int) *Bus {
+	if historySize <= 0 {
+		historySize = DefaultHistorySize
+	}
+	return &Bus{
+		size: historySize,
+		subs: map[*Subscription]struct{}{},
+	}
+}
+
+// Filter selects events delivered to subscriber, nil filter selects all events
+type Filter func(e *v1.Event) bool
+
+// Subscription receives events published after given sequence
+type Subscription struct {
+	// C receives events, it is closed when subscription ends
+	C <-chan *v1.Event
+	// Sequence is sequence number of the last event published before subscription
+	Sequence int64
+
+	bus    *Bus
+	c      chan *v1.Event
+	filter Filter
+	err    error
+}
+
+// Err returns reason of closing C, nil if subscription was closed by Close
+func (s *Subscription) Err() error {
+	s.bus.mu.Lock()
+	defer s.bus.mu.Unlock()
+	return s.err
+}
+
+// Close ends subscription
+func (s *Subscription) Close() {
+	s.bus.mu.Lock()
+	defer s.bus.mu.Unlock()
+	s.bus.unsubscribe(s, nil)
+}
+
+// unsubscribe removes subscription and closes its channel, bus must be locked
+func (b *Bus) unsubscribe(s *Subscription, err error) {
+	if _, ok := b.subs[s]; !ok {
+		return
+	}
+	delete(b.subs, s)
+	s.err = err
+	close(s.c)
+}
+
+// Sequence returns sequence number of the last published event
+func (b *Bus) Sequence() int64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.seq
+}
+
+// Publish assigns next sequence number to change of task and sends it to subscribers.
+// Event is shared by subscribers and kept in history, it must not be modified.
+func (b *Bus) Publish(typ v1.EventType, td *v1.ToDo) *v1.Event {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return nil
+	}
+
+	b.seq++
+	e := &v1.Event{
+		Sequence: b.seq,
+		Type:     typ,
+		ToDo:     proto.Clone(td).(*v1.ToDo),
+		Time:     ptypes.TimestampNow(),
+	}
+	if len(b.history) == b.size {
+		copy(b.history, b.history[1:])
+		b.history = b.history[:b.size-1]
+	}
+	b.history = append(b.history, e)
+
+	for s := range b.subs {
+		if s.filter != nil && !s.filter(e) {
+			continue
+		}
+		select {
+		case s.c <- e:
+		default:
+			b.unsubscribe(s, ErrSlowSubscriber)
+		}
+	}
+	return e
+}
+
+// Subscribe returns subscription receiving events with sequence greater than afterSequence
+// selected by filter. Kept events are replayed first, afterSequence 0 means new events only.
+func (b *Bus) Subscribe(afterSequence int64, filter Filter) (*Subscription, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return nil, ErrClosed
+	}
+
+	var backlog []*v1.Event
+	if afterSequence > 0 && afterSequence < b.seq {
+		if len(b.history) == 0 || b.history[0].Sequence > afterSequence+1 {
+			return nil, ErrHistoryExpired
+		}
+		for _, e := range b.history[afterSequence+1-b.history[0].Sequence:] {
+			if filter == nil || filter(e) {
+				backlog = append(backlog, e)
+			}
+		}
+	}
+
+	c := make(chan *v1.Event, len(backlog)+subscriptionBuffer)
+	for _, e := range backlog {
+		c <- e
+	}
+	s := &Subscription{C: c, Sequence: b.seq, bus: b, c: c, filter: filter}
+	b.subs[s] = struct{}{}
+	return s, nil
+}
+
+// Close closes all subscriptions, events published later are dropped
+func (b *Bus) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.closed = true
+	for s := range b.subs {
+		b.unsubscribe(s, ErrClosed)
+	}
+}
@@ -0,0 +1,110 @@
+package events
+
+import (
+	"testing"
+
+	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
+)
+
+// received returns sequence numbers of events queued for subscription
+func received(s *Subscription) []int64 {
+	var seqs []int64
+	for {
+		select {
+		case e, ok := <-s.C:
+			if !ok {
+				return seqs
+			}
+			seqs = append(seqs, e.Sequence)
+		default:
+			return seqs
+		}
+	}
+}
+
+func TestBus_Subscribe(t *testing.T) {
+	onlyDeleted := func(e *v1.Event) bool { return e.Type == v1.EventType_DELETED }
+
+	tests := []struct {
+		name    string
+		after   int64
+		filter  Filter
+		want    []int64
+		wantErr error
+	}{
+		{name: "New events only", after: 0, want: []int64{6}},
+		{name: "Resume", after: 3, want: []int64{4, 5, 6}},
+		{name: "Resume from oldest kept", after: 2, want: []int64{3, 4, 5, 6}},
+		{name: "Resume at last event", after: 5, want: []int64{6}},
+		{name: "Resume ahead of last event", after: 10, want: []int64{6}},
+		{name: "History expired", after: 1, wantErr: ErrHistoryExpired},
+		{name: "Filter", after: 2, filter: onlyDeleted, want: []int64{5}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewBus(3)
+			for i := 1; i <= 5; i++ {
+				typ := v1.EventType_UPDATED
+				if i == 5 {
+					typ = v1.EventType_DELETED
+				}
+				b.Publish(typ, &v1.ToDo{Id: int64(i)})
+			}
+
+			s, err := b.Subscribe(tt.after, tt.filter)
+			if err != tt.wantErr {
+				t.Fatalf("Subscribe() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			defer s.Close()
+			b.Publish(v1.EventType_CREATED, &v1.ToDo{Id: 6})
+
+			got := received(s)
+			if len(got) != len(tt.want) {
+				t.Fatalf("received %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("received %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestBus_SlowSubscriber(t *testing.T) {
+	b := NewBus(1)
+	s, err := b.Subscribe(0, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i <= subscriptionBuffer; i++ {
+		b.Publish(v1.EventType_CREATED, &v1.ToDo{Id: int64(i)})
+	}
+	if got := len(received(s)); got != subscriptionBuffer {
+		t.Errorf("received %d events, want %d", got, subscriptionBuffer)
+	}
+	if s.Err() != ErrSlowSubscriber {
+		t.Errorf("Err() = %v, want %v", s.Err(), ErrSlowSubscriber)
+	}
+}
+
+func TestBus_Close(t *testing.T) {
+	b := NewBus(1)
+	s, err := b.Subscribe(0, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.Close()
+	if _, ok := <-s.C; ok {
+		t.Error("subscription is not closed")
+	}
+	if s.Err() != ErrClosed {
+		t.Errorf("Err() = %v, want %v", s.Err(), ErrClosed)
+	}
+	if _, err := b.Subscribe(0, nil); err != ErrClosed {
+		t.Errorf("Subscribe() error = %v, want %v", err, ErrClosed)
+	}
+}
@@ -0,0 +1,33 @@
+package logger
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+)
+
+// Key to use when setting request scoped logger.
+type ctxKeyLogger int
+
+// loggerKey is the key that holds request scoped logger in a request context.
+const loggerKey ctxKeyLogger = 0
+
+// WithContext returns copy of ctx holding request scoped logger
+func WithContext(ctx context.Context, log *zap.Logger) context.Context {
+	return context.WithValue(ctx, loggerKey, log)
+}
+
+// FromContext returns request scoped logger with request ID, method, caller identity
+// and trace ID attached by gRPC and HTTP/REST middleware.
+// Returns global logger if ctx has none, or no-op logger if global logger is not initialized.
+func FromContext(ctx context.Context) *zap.Logger {
+	if ctx != nil {
+		if log, ok := ctx.Value(loggerKey).(*zap.Logger); ok {
+			return log
+		}
+	}
+	if Log != nil {
+		return Log
+	}
+	return zap.NewNop()
+}
@@ -0,0 +1,48 @@
+package logger
+
+import (
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// Field keys shared by gRPC and HTTP/REST request logging.
+// The gRPC specific keys (grpc.service, grpc.method, grpc.code) are produced by grpc_zap,
+// the keys below are used by both protocols so log entries can be queried the same way.
+const (
+	// FieldSystem is protocol which served the request: grpc or http
+	FieldSystem = "system"
+	// FieldSpanKind is client or server
+	FieldSpanKind = "span.kind"
+	// FieldRequestID is unique request identifier
+	FieldRequestID = "request.id"
+	// FieldPeerAddress is remote address of the caller
+	FieldPeerAddress = "peer.address"
+	// FieldCallerID is identity of the caller, authenticated or declared by the caller
+	FieldCallerID = "caller.id"
+	// FieldPrincipal is authenticated identity of the caller
+	FieldPrincipal = "caller.principal"
+	// FieldTraceID is distributed trace ID
+	FieldTraceID = "trace.id"
+	// FieldDuration is request run time in milliseconds
+	FieldDuration = "time_ms"
+
+	// FieldHTTPScheme is http or https
+	FieldHTTPScheme = "http.scheme"
+	// FieldHTTPProto is HTTP protocol version
+	FieldHTTPProto = "http.proto"
+	// FieldHTTPMethod is HTTP request method
+	FieldHTTPMethod = "http.method"
+	// FieldHTTPURI is full request URI
+	FieldHTTPURI = "http.uri"
+	// FieldHTTPUserAgent is user agent of the caller
+	FieldHTTPUserAgent = "http.user_agent"
+	// FieldHTTPStatus is HTTP response status code
+	FieldHTTPStatus = "http.status"
+)
+
+// Duration returns request run time field in milliseconds
+func Duration(d time.Duration) zapcore.Field {
+	return zap.Float32(FieldDuration, float32(d.Nanoseconds()/1000)/1000)
+}
@@ -0,0 +1,141 @@
+package logger
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+var (
+	// level is global log level which can be changed at runtime
+	level = zap.NewAtomicLevel()
+
+	// overrides is map[string]zapcore.Level of per logger name level overrides
+	overrides atomic.Value
+
+	// overridesMu serializes updates of overrides
+	overridesMu sync.Mutex
+)
+
+func init() {
+	overrides.Store(map[string]zapcore.Level{})
+}
+
+// Level returns global log level
+func Level() zapcore.Level {
+	return level.Level()
+}
+
+// Overrides returns copy of log level overrides keyed by logger name
+func Overrides() map[string]zapcore.Level {
+	current := overrides.Load().(map[string]zapcore.Level)
+	m := make(map[string]zapcore.Level, len(current))
+	for name, lvl := range current {
+		m[name] = lvl
+	}
+	return m
+}
+
+// OverrideNames returns sorted names of loggers with overridden log level
+func OverrideNames() []string {
+	current := overrides.Load().(map[string]zapcore.Level)
+	names := make([]string, 0, len(current))
+	for name := range current {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// SetLevel changes log level at runtime
+// name - empty for global level, package name (e.g. "rest") or gRPC method
+// (e.g. "/v1.ToDoService/Create") or service (e.g. "/v1.ToDoService") for an override
+// lvl - debug, info, warn, error, dpanic, panic or fatal; empty removes the override
+func SetLevel(name string, lvl string) error {
+	if len(name) == 0 {
+		var l zapcore.Level
+		if err := l.UnmarshalText([]byte(lvl)); err != nil {
+			return fmt.Errorf("invalid log level '%s': %v", lvl, err)
+		}
+		level.SetLevel(l)
+		return nil
+	}
+
+	overridesMu.Lock()
+	defer overridesMu.Unlock()
+
+	m := Overrides()
+	if len(lvl) == 0 {
+		delete(m, name)
+	} else {
+		var l zapcore.Level
+		if err := l.UnmarshalText([]byte(lvl)); err != nil {
+			return fmt.Errorf("invalid log level '%s': %v", lvl, err)
+		}
+		m[name] = l
+	}
+	overrides.Store(m)
+	return nil
+}
+
+// levelFor returns log level of the named logger.
+// The longest override matching the name or one of its parents is used,
+// global level otherwise.
+func levelFor(name string) zapcore.Level {
+	m := overrides.Load().(map[string]zapcore.Level)
+	if len(m) == 0 || len(name) == 0 {
+		return level.Level()
+	}
+
+	var match string
+	lvl := level.Level()
+	for key, l := range m {
+		if len(key) <= len(match) {
+			continue
+		}
+		if name == key || strings.HasPrefix(name, key+".") || strings.HasPrefix(name, key+"/") {
+			match, lvl = key, l
+		}
+	}
+	return lvl
+}
+
+// minLevel returns the most verbose of global and overridden log levels
+func minLevel() zapcore.Level {
+	lvl := level.Level()
+	for _, l := range overrides.Load().(map[string]zapcore.Level) {
+		if l < lvl {
+			lvl = l
+		}
+	}
+	return lvl
+}
+
+// levelCore filters log entries by global log level or by the override of the logger name.
+// Errors are always logged.
+type levelCore struct {
+	zapcore.Core
+}
+
+// Enabled reports whether any logger may log at the given level
+func (c *levelCore) Enabled(lvl zapcore.Level) bool {
+	return (lvl >= zapcore.ErrorLevel || lvl >= minLevel()) && c.Core.Enabled(lvl)
+}
+
+// With adds structured context to the wrapped core
+func (c *levelCore) With(fields []zapcore.Field) zapcore.Core {
+	return &levelCore{c.Core.With(fields)}
+}
+
+// Check filters entry by level of its logger
+func (c *levelCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if ent.Level < zapcore.ErrorLevel && ent.Level < levelFor(ent.LoggerName) {
+		return ce
+	}
+	return c.Core.Check(ent, ce)
+}
@@ -0,0 +1,174 @@
+package logger
+
+import (
+	"reflect"
+	"testing"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+// withLevels sets global level and overrides for the test, they are restored by returned function
+func withLevels(t *testing.T, global string, override map[string]string) func() {
+	saved, savedOverrides := Level(), overrides.Load()
+	if err := SetLevel("", global); err != nil {
+		t.Fatal(err)
+	}
+	overrides.Store(map[string]zapcore.Level{})
+	for name, lvl := range override {
+		if err := SetLevel(name, lvl); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return func() {
+		level.SetLevel(saved)
+		overrides.Store(savedOverrides)
+	}
+}
+
+// logged returns messages of entries logged by levelCore
+func logged(entries []observer.LoggedEntry) []string {
+	msgs := []string{}
+	for _, e := range entries {
+		msgs = append(msgs, e.Message)
+	}
+	return msgs
+}
+
+func TestLevelCore(t *testing.T) {
+	tests := []struct {
+		name     string
+		global   string
+		override map[string]string
+		log      func(l *zap.Logger)
+		want     []string
+	}{
+		{
+			name:     "Override below global level",
+			global:   "info",
+			override: map[string]string{"rest": "debug"},
+			log: func(l *zap.Logger) {
+				l.Named("rest").Debug("rest debug")
+				l.Named("rest").Named("payload").Debug("rest child debug")
+				l.Named("restore").Debug("other name debug")
+				l.Named("grpc").Debug("grpc debug")
+				l.Named("grpc").Info("grpc info")
+			},
+			want: []string{"rest debug", "rest child debug", "grpc info"},
+		},
+		{
+			name:     "Override above global level",
+			global:   "debug",
+			override: map[string]string{"rest": "warn"},
+			log: func(l *zap.Logger) {
+				l.Named("rest").Info("rest info")
+				l.Named("rest").Warn("rest warn")
+				l.Named("grpc").Debug("grpc debug")
+			},
+			want: []string{"rest warn", "grpc debug"},
+		},
+		{
+			name:     "Errors are always logged",
+			global:   "info",
+			override: map[string]string{"rest": "fatal"},
+			log: func(l *zap.Logger) {
+				l.Named("rest").Warn("rest warn")
+				l.Named("rest").Error("rest error")
+			},
+			want: []string{"rest error"},
+		},
+		{
+			name:   "Longest override wins",
+			global: "info",
+			override: map[string]string{
+				"grpc./v1.ToDoService":        "warn",
+				"grpc./v1.ToDoService/Create": "debug",
+			},
+			log: func(l *zap.Logger) {
+				l.Named("grpc./v1.ToDoService/Create").Debug("create debug")
+				l.Named("grpc./v1.ToDoService/Read").Info("read info")
+				l.Named("grpc./v1.ToDoService/Read").Warn("read warn")
+			},
+			want: []string{"create debug", "read warn"},
+		},
+		{
+			name:   "Global level without overrides",
+			global: "warn",
+			log: func(l *zap.Logger) {
+				l.Info("root info")
+				l.Named("rest").Info("rest info")
+				l.Named("rest").Warn("rest warn")
+			},
+			want: []string{"rest warn"},
+		},
+		{
+			name:     "Global level of names without override",
+			global:   "warn",
+			override: map[string]string{"rest": "debug"},
+			log: func(l *zap.Logger) {
+				l.Info("root info")
+				l.Warn("root warn")
+				l.Named("grpc").Info("grpc info")
+			},
+			want: []string{"root warn"},
+		},
+		{
+			name:     "Children with fields inherit override",
+			global:   "info",
+			override: map[string]string{"rest": "debug", "grpc": "error"},
+			log: func(l *zap.Logger) {
+				l.Named("rest").With(zap.String("request.id", "1")).Debug("rest child debug")
+				l.Named("grpc").With(zap.String("request.id", "2")).Warn("grpc child warn")
+			},
+			want: []string{"rest child debug"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer withLevels(t, tt.global, tt.override)()
+
+			core, entries := observer.New(zapcore.DebugLevel)
+			tt.log(zap.New(&levelCore{core}))
+			if got := logged(entries.All()); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("logged %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLevelCore_WithKeepsFields(t *testing.T) {
+	defer withLevels(t, "info", map[string]string{"rest": "debug"})()
+
+	core, entries := observer.New(zapcore.DebugLevel)
+	zap.New(&levelCore{core}).Named("rest").With(zap.String("request.id", "1")).Debug("debug")
+	all := entries.All()
+	if len(all) != 1 || all[0].ContextMap()["request.id"] != "1" {
+		t.Errorf("logged %+v, want debug entry with request.id", all)
+	}
+}
+
+func TestLevelFor(t *testing.T) {
+	defer withLevels(t, "info", map[string]string{"rest": "debug", "rest.payload": "error"})()
+
+	// global level changed at runtime is used by names without override
+	if err := SetLevel("", "warn"); err != nil {
+		t.Fatal(err)
+	}
+	for name, want := range map[string]zapcore.Level{
+		"":                    zapcore.WarnLevel,
+		"grpc":                zapcore.WarnLevel,
+		"restore":             zapcore.WarnLevel,
+		"rest":                zapcore.DebugLevel,
+		"rest/v1":             zapcore.DebugLevel,
+		"rest.payload":        zapcore.ErrorLevel,
+		"rest.payload.events": zapcore.ErrorLevel,
+	} {
+		if got := levelFor(name); got != want {
+			t.Errorf("levelFor(%q) = %v, want %v", name, got, want)
+		}
+	}
+	if got := minLevel(); got != zapcore.DebugLevel {
+		t.Errorf("minLevel() = %v, want debug", got)
+	}
+}
@@ -1,16 +1,51 @@
 package logger
 
 import (
+	"fmt"
 	"os"
+	"strings"
 	"sync"
 	"time"
 
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 )
+
+const (
+	// FormatConsole is human readable log format
+	FormatConsole = "console"
+	// FormatJSON is log format for automated log ingestion
+	FormatJSON = "json"
+)
+
+// Config is configuration for logger
+type Config struct {
+	// Level is global log level: Debug(-1), Info(0), Warn(1), Error(2), DPanic(3), Panic(4), Fatal(5)
+	Level int
+	// TimeFormat is custom time format for logger or empty string to use default
+	TimeFormat string
+	// Format is log encoding: console or json
+	Format string
+
+	// Output is comma separated list of log sinks: stdout, stderr or file path.
+	// Empty output sends errors to stderr and everything else to stdout.
+	Output string
+	// MaxSize is size in megabytes a log file may grow to before it is rotated, 0 disables rotation
+	MaxSize int
+	// MaxBackups is number of rotated log files to keep
+	MaxBackups int
+
+	// SamplingInitial is number of identical entries below Error logged every second
+	// before sampling kicks in, 0 disables sampling
+	SamplingInitial int
+	// SamplingThereafter is how many identical entries are skipped for every one logged
+	// once SamplingInitial is exceeded
+	SamplingThereafter int
+}
+
 var (
-	// Log is global logger
-	Log *zap.Logger
+	// Log is global logger, it discards entries until Init is called
+	Log = zap.NewNop()
 
 	// timeFormat is custom Time format
 	customTimeFormat string
@@ -26,57 +61,117 @@ func customTimeEncoder(t time.Time, enc zapcore.PrimitiveArrayEncoder) {
 }
 
 // Init initializes log by input parameters
-// lvl - global log level: Debug(-1), Info(0), Warn(1), Error(2), DPanic(3), Panic(4), Fatal(5)
-// timeFormat - custom time format for logger of empty string to use default
-func Init(lvl int, timeFormat string) error {
+func Init(cfg Config) error {
 	var err error
 
 	onceInit.Do(func() {
-		// First, define our level-handling logic.
-		globalLevel := zapcore.Level(lvl)
-
-		// High-priority output should also go to standard error, and low-priority
-		// output should also go to standard out.
-		// It is usefull for Kubernetes deployment.
-		// Kubernetes interprets os.Stdout log items as INFO and os.Stderr log items
-		// as ERROR by default.
-		highPriority := zap.LevelEnablerFunc(func(lvl zapcore.Level) bool {
-			return lvl >= zapcore.ErrorLevel
-		})
-		lowPriority := zap.LevelEnablerFunc(func(lvl zapcore.Level) bool {
-			return lvl >= globalLevel && lvl < zapcore.ErrorLevel
-		})
-		consoleInfos := zapcore.Lock(os.Stdout)
-		consoleErrors := zapcore.Lock(os.Stderr)
-
-		// Configure console output.
-		var useCustomTimeFormat bool
-		ecfg := zap.NewProductionEncoderConfig()
-		if len(timeFormat) > 0 {
-			customTimeFormat = timeFormat
-			ecfg.EncodeTime = customTimeEncoder
-			useCustomTimeFormat = true
+		var core zapcore.Core
+		if core, err = newCore(cfg); err != nil {
+			return
 		}
 
-		// For automated log ingestion use zapcore.NewJSONEncoder
-		consoleEncoder := zapcore.NewConsoleEncoder(ecfg)
-
-
-		// Join the outputs, encoders, and level-handling functions into
-		// zapcore.
-		core := zapcore.NewTee(
-			zapcore.NewCore(consoleEncoder, consoleErrors, highPriority),
-			zapcore.NewCore(consoleEncoder, consoleInfos, lowPriority),
-		)
-
 		// From a zapcore.Core, it's easy to construct a Logger.
 		Log = zap.New(core)
 		zap.RedirectStdLog(Log)
 
-		if !useCustomTimeFormat {
+		if len(cfg.TimeFormat) == 0 {
 			Log.Warn("time format for logger is not provided - use zap default")
 		}
 	})
 
 	return err
 }
+
+// newCore builds core writing entries to outputs of cfg
+func newCore(cfg Config) (zapcore.Core, error) {
+	// zap sampler divides by SamplingThereafter
+	if cfg.SamplingInitial > 0 && cfg.SamplingThereafter < 1 {
+		return nil, fmt.Errorf("invalid log sampling thereafter %d, expected at least 1 when sampling is enabled",
+			cfg.SamplingThereafter)
+	}
+
+	// First, define our level-handling logic.
+	// Global level and per logger overrides are applied by levelCore
+	// so they can be changed at runtime.
+	level.SetLevel(zapcore.Level(cfg.Level))
+
+	// Configure encoder.
+	ecfg := zap.NewProductionEncoderConfig()
+	if len(cfg.TimeFormat) > 0 {
+		customTimeFormat = cfg.TimeFormat
+		ecfg.EncodeTime = customTimeEncoder
+	}
+
+	var encoder zapcore.Encoder
+	switch cfg.Format {
+	case FormatConsole, "":
+		encoder = zapcore.NewConsoleEncoder(ecfg)
+	case FormatJSON:
+		encoder = zapcore.NewJSONEncoder(ecfg)
+	default:
+		return nil, fmt.Errorf("unsupported log format '%s', expected '%s' or '%s'", cfg.Format, FormatConsole, FormatJSON)
+	}
+
+	highPriority := zap.LevelEnablerFunc(func(lvl zapcore.Level) bool {
+		return lvl >= zapcore.ErrorLevel
+	})
+	lowPriority := zap.LevelEnablerFunc(func(lvl zapcore.Level) bool {
+		return lvl < zapcore.ErrorLevel
+	})
+
+	var highOutput, lowOutput zapcore.WriteSyncer
+	if len(cfg.Output) == 0 {
+		// High-priority output should also go to standard error, and low-priority
+		// output should also go to standard out.
+		// It is usefull for Kubernetes deployment.
+		// Kubernetes interprets os.Stdout log items as INFO and os.Stderr log items
+		// as ERROR by default.
+		highOutput = zapcore.Lock(os.Stderr)
+		lowOutput = zapcore.Lock(os.Stdout)
+	} else {
+		sinks, err := openSinks(cfg.Output, cfg.MaxSize, cfg.MaxBackups)
+		if err != nil {
+			return nil, err
+		}
+		highOutput, lowOutput = sinks, sinks
+	}
+
+	// High-volume entries below Error are sampled, errors are always logged.
+	lowCore := zapcore.NewCore(encoder, lowOutput, lowPriority)
+	if cfg.SamplingInitial > 0 {
+		lowCore = zapcore.NewSampler(lowCore, time.Second, cfg.SamplingInitial, cfg.SamplingThereafter)
+	}
+
+	// Join the outputs, encoders, and level-handling functions into
+	// zapcore.
+	return &levelCore{zapcore.NewTee(
+		zapcore.NewCore(encoder, highOutput, highPriority),
+		lowCore,
+	)}, nil
+}
+
+// openSinks opens every sink in comma separated output list
+func openSinks(output string, maxSize, maxBackups int) (zapcore.WriteSyncer, error) {
+	var sinks []zapcore.WriteSyncer
+	for _, name := range strings.Split(output, ",") {
+		name = strings.TrimSpace(name)
+		switch name {
+		case "":
+			continue
+		case "stdout":
+			sinks = append(sinks, zapcore.Lock(os.Stdout))
+		case "stderr":
+			sinks = append(sinks, zapcore.Lock(os.Stderr))
+		default:
+			f, err := newRotatingFile(name, int64(maxSize)*1024*1024, maxBackups)
+			if err != nil {
+				return nil, fmt.Errorf("failed to open log file '%s': %v", name, err)
+			}
+			sinks = append(sinks, f)
+		}
+	}
+	if len(sinks) == 0 {
+		return nil, fmt.Errorf("invalid log output: '%s'", output)
+	}
+	return zapcore.NewMultiWriteSyncer(sinks...), nil
+}
@@ -0,0 +1,87 @@
+package logger
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"go.uber.org/zap"
+)
+
+func TestNewCore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "logger")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	tests := []struct {
+		name    string
+		cfg     Config
+		check   func(line string) bool
+		wantErr bool
+	}{
+		{
+			name: "JSON",
+			cfg:  Config{Format: FormatJSON},
+			check: func(line string) bool {
+				var v map[string]interface{}
+				return json.Unmarshal([]byte(line), &v) == nil && v["msg"] == "hello" && v["level"] == "info"
+			},
+		},
+		{
+			name: "Console",
+			cfg:  Config{Format: FormatConsole},
+			check: func(line string) bool {
+				return !strings.HasPrefix(line, "{") && strings.Contains(line, "\tinfo\thello")
+			},
+		},
+		{name: "Unsupported format", cfg: Config{Format: "xml"}, wantErr: true},
+		{name: "Sampling without thereafter", cfg: Config{SamplingInitial: 1}, wantErr: true},
+		{
+			name: "Sampling",
+			cfg:  Config{SamplingInitial: 1, SamplingThereafter: 1},
+			check: func(line string) bool {
+				return strings.Contains(line, "hello")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name := filepath.Join(dir, strings.Replace(tt.name, " ", "-", -1)+".log")
+			tt.cfg.Output = name
+			core, err := newCore(tt.cfg)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("newCore() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			log := zap.New(core)
+			// identical entries must not crash sampler
+			log.Info("hello")
+			log.Info("hello")
+			if err := log.Sync(); err != nil {
+				t.Fatal(err)
+			}
+
+			data, err := ioutil.ReadFile(name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			line := strings.SplitN(string(data), "\n", 2)[0]
+			if !tt.check(line) {
+				t.Errorf("log line = %q", line)
+			}
+		})
+	}
+}
+
+func TestInit_InvalidSampling(t *testing.T) {
+	if err := Init(Config{SamplingInitial: 1, SamplingThereafter: 0}); err == nil {
+		t.Error("Init() error = nil, want invalid sampling")
+	}
+}
@@ -0,0 +1,209 @@
+package logger
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/golang/protobuf/descriptor"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	pbdescriptor "github.com/golang/protobuf/protoc-gen-go/descriptor"
+
+	logpb "go.smartmachine.io/go-grpc-api/pkg/api/log"
+)
+
+// Redacted replaces logged value of fields marked with (log.redact) option
+const Redacted = "[REDACTED]"
+
+var (
+	// payloadMarshaler marshals messages the same way as HTTP/REST gateway does
+	payloadMarshaler = &jsonpb.Marshaler{OrigName: true}
+
+	// redactions is cache of map[string][]redaction keyed by full message name
+	redactions sync.Map
+)
+
+// redaction describes how to log message field
+type redaction struct {
+	// name of the field in JSON representation
+	name string
+	// redact is true if field is marked with (log.redact) option
+	redact bool
+	// nested is message type of the field, nil if field is not a message
+	nested reflect.Type
+}
+
+// isRedacted returns true if field is marked with (log.redact) option
+func isRedacted(f *pbdescriptor.FieldDescriptorProto) bool {
+	if f.Options == nil {
+		return false
+	}
+	v, err := proto.GetExtension(f.Options, logpb.E_Redact)
+	if err != nil {
+		return false
+	}
+	redact, ok := v.(*bool)
+	return ok && *redact
+}
+
+// redactionsOf returns how to log fields of message
+func redactionsOf(msg descriptor.Message) []redaction {
+	name := proto.MessageName(msg)
+	if r, ok := redactions.Load(name); ok {
+		return r.([]redaction)
+	}
+
+	_, md := descriptor.ForMessage(msg)
+	var list []redaction
+	for _, f := range md.Field {
+		r := redaction{
+			name:   f.GetName(),
+			redact: isRedacted(f),
+		}
+		if f.GetType() == pbdescriptor.FieldDescriptorProto_TYPE_MESSAGE {
+			if t := proto.MessageType(strings.TrimPrefix(f.GetTypeName(), ".")); t != nil && t.Kind() == reflect.Ptr {
+				if _, ok := reflect.New(t.Elem()).Interface().(descriptor.Message); ok {
+					r.nested = t.Elem()
+				}
+			}
+		}
+		list = append(list, r)
+	}
+
+	redactions.Store(name, list)
+	return list
+}
+
+// redactMessage replaces values of redacted fields in JSON object of message
+func redactMessage(obj map[string]interface{}, msg descriptor.Message) {
+	for _, r := range redactionsOf(msg) {
+		v, ok := obj[r.name]
+		if !ok {
+			continue
+		}
+		if r.redact {
+			obj[r.name] = Redacted
+			continue
+		}
+		if r.nested == nil {
+			continue
+		}
+		nested := reflect.New(r.nested).Interface().(descriptor.Message)
+		switch v := v.(type) {
+		case map[string]interface{}:
+			redactMessage(v, nested)
+		case []interface{}:
+			for _, item := range v {
+				if o, ok := item.(map[string]interface{}); ok {
+					redactMessage(o, nested)
+				}
+			}
+		}
+	}
+}
+
+// truncate cuts s to maxSize bytes, 0 means no limit
+func truncate(s string, maxSize int) string {
+	if maxSize > 0 && len(s) > maxSize {
+		return s[:maxSize] + "...(truncated)"
+	}
+	return s
+}
+
+// Payload returns JSON representation of message for logging.
+// Values of fields marked with (log.redact) option are replaced by Redacted
+// and the result is truncated to maxSize bytes (0 means no limit).
+func Payload(msg proto.Message, maxSize int) string {
+	s, err := payloadMarshaler.MarshalToString(msg)
+	if err != nil {
+		return fmt.Sprintf("[unable to marshal payload: %v]", err)
+	}
+
+	if dm, ok := msg.(descriptor.Message); ok {
+		var obj map[string]interface{}
+		if err := json.Unmarshal([]byte(s), &obj); err != nil {
+			return fmt.Sprintf("[unable to redact payload: %v]", err)
+		}
+		redactMessage(obj, dm)
+		b, err := json.Marshal(obj)
+		if err != nil {
+			return fmt.Sprintf("[unable to redact payload: %v]", err)
+		}
+		s = string(b)
+	}
+
+	return truncate(s, maxSize)
+}
+
+// JSONRedactor redacts JSON payloads whose message type is unknown, e.g. HTTP/REST bodies.
+// Every property named like a field marked with (log.redact) option in any message
+// of the known proto files is redacted.
+type JSONRedactor struct {
+	names map[string]bool
+}
+
+// NewJSONRedactor creates JSONRedactor for proto files declaring the messages
+func NewJSONRedactor(msgs ...descriptor.Message) *JSONRedactor {
+	r := &JSONRedactor{names: map[string]bool{}}
+	for _, msg := range msgs {
+		fd, _ := descriptor.ForMessage(msg)
+		for _, md := range fd.MessageType {
+			r.addMessage(md)
+		}
+	}
+	return r
+}
+
+// addMessage collects names of redacted fields of message and its nested messages
+func (r *JSONRedactor) addMessage(md *pbdescriptor.DescriptorProto) {
+	for _, f := range md.Field {
+		if isRedacted(f) {
+			r.names[f.GetName()] = true
+			if len(f.GetJsonName()) > 0 {
+				r.names[f.GetJsonName()] = true
+			}
+		}
+	}
+	for _, nested := range md.NestedType {
+		r.addMessage(nested)
+	}
+}
+
+// redactValue replaces values of redacted properties in JSON value
+func (r *JSONRedactor) redactValue(v interface{}) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for name, value := range v {
+			if r.names[name] {
+				v[name] = Redacted
+			} else {
+				r.redactValue(value)
+			}
+		}
+	case []interface{}:
+		for _, item := range v {
+			r.redactValue(item)
+		}
+	}
+}
+
+// Payload returns redacted JSON payload for logging truncated to maxSize bytes (0 means no limit).
+// Payload which is not JSON is not logged, only its size.
+func (r *JSONRedactor) Payload(body []byte, maxSize int) string {
+	if len(body) == 0 {
+		return ""
+	}
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return fmt.Sprintf("[%d bytes]", len(body))
+	}
+	r.redactValue(v)
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("[%d bytes]", len(body))
+	}
+	return truncate(string(b), maxSize)
+}
@@ -0,0 +1,93 @@
+package logger
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+
+	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
+)
+
+func TestPayload(t *testing.T) {
+	tests := []struct {
+		name    string
+		msg     proto.Message
+		maxSize int
+		want    string
+	}{
+		{
+			name: "Redacted fields",
+			msg: &v1.ToDo{
+				Id:          1,
+				Title:       "title",
+				Description: "description",
+			},
+			want: `{"description":"[REDACTED]","id":"1","title":"[REDACTED]"}`,
+		},
+		{
+			name: "Nested message",
+			msg: &v1.CreateRequest{
+				Api: "v1",
+				ToDo: &v1.ToDo{
+					Title: "title",
+				},
+			},
+			want: `{"api":"v1","toDo":{"title":"[REDACTED]"}}`,
+		},
+		{
+			name: "Repeated message",
+			msg: &v1.ReadAllResponse{
+				Api: "v1",
+				ToDos: []*v1.ToDo{
+					{Id: 1, Description: "description"},
+					{Id: 2},
+				},
+			},
+			want: `{"api":"v1","toDos":[{"description":"[REDACTED]","id":"1"},{"id":"2"}]}`,
+		},
+		{
+			name: "Truncated",
+			msg: &v1.ReadRequest{
+				Api: "v1",
+				Id:  1,
+			},
+			maxSize: 10,
+			want:    `{"api":"v1...(truncated)`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Payload(tt.msg, tt.maxSize); got != tt.want {
+				t.Errorf("Payload() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJSONRedactor_Payload(t *testing.T) {
+	r := NewJSONRedactor(&v1.ToDo{})
+
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{
+			name: "Redacted fields",
+			body: `{"api":"v1","toDo":{"title":"title","description":"description","reminder":"2019-01-01T00:00:00Z"}}`,
+			want: `{"api":"v1","toDo":{"description":"[REDACTED]","reminder":"2019-01-01T00:00:00Z","title":"[REDACTED]"}}`,
+		},
+		{
+			name: "Not JSON",
+			body: `title,description`,
+			want: `[17 bytes]`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := r.Payload([]byte(tt.body), 0); got != tt.want {
+				t.Errorf("JSONRedactor.Payload() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package logger
+
+import (
+	"fmt"
+	"os"
+	"sync"
+)
+
+// rotatingFile is log sink writing to a file which is rotated by size.
+// Rotated files are renamed to name.1, name.2, ... name.N where name.1 is the newest one.
+type rotatingFile struct {
+	mu sync.Mutex
+
+	name       string
+	maxSize    int64
+	maxBackups int
+
+	file *os.File
+	size int64
+}
+
+// newRotatingFile opens (or creates) log file for appending
+// maxSize - size in bytes the file may grow to before rotation, 0 disables rotation
+// maxBackups - number of rotated files to keep
+func newRotatingFile(name string, maxSize int64, maxBackups int) (*rotatingFile, error) {
+	f := &rotatingFile{
+		name:       name,
+		maxSize:    maxSize,
+		maxBackups: maxBackups,
+	}
+	if err := f.open(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// open opens log file for appending and remembers its current size
+func (f *rotatingFile) open() error {
+	file, err := os.OpenFile(f.name, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+	f.file = file
+	f.size = info.Size()
+	return nil
+}
+
+// Write writes log entry, rotating the file first if the entry does not fit
+func (f *rotatingFile) Write(p []byte) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.maxSize > 0 && f.size > 0 && f.size+int64(len(p)) > f.maxSize {
+		if err := f.rotate(); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := f.file.Write(p)
+	f.size += int64(n)
+	return n, err
+}
+
+// Sync flushes log file to disk
+func (f *rotatingFile) Sync() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.file.Sync()
+}
+
+// rotate shifts backups by one, moves current file to name.1 and opens new one
+func (f *rotatingFile) rotate() error {
+	if err := f.file.Close(); err != nil {
+		return err
+	}
+
+	if f.maxBackups > 0 {
+		_ = os.Remove(fmt.Sprintf("%s.%d", f.name, f.maxBackups))
+		for i := f.maxBackups - 1; i > 0; i-- {
+			_ = os.Rename(fmt.Sprintf("%s.%d", f.name, i), fmt.Sprintf("%s.%d", f.name, i+1))
+		}
+		if err := os.Rename(f.name, f.name+".1"); err != nil {
+			return err
+		}
+	} else if err := os.Remove(f.name); err != nil {
+		return err
+	}
+
+	return f.open()
+}
@@ -0,0 +1,87 @@
+package logger
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRotatingFile_Write(t *testing.T) {
+	tests := []struct {
+		name        string
+		maxBackups  int
+		wantBackups []string
+	}{
+		{name: "Pruned to max backups", maxBackups: 2, wantBackups: []string{"4444", "3333"}},
+		{name: "No backups", maxBackups: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "rotate")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(dir)
+			name := filepath.Join(dir, "server.log")
+
+			// every entry fills the file, so every next one rotates it
+			f, err := newRotatingFile(name, 8, tt.maxBackups)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for i := 1; i <= 5; i++ {
+				if _, err := f.Write([]byte(strings.Repeat(fmt.Sprint(i), 4) + "\n")); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if err := f.file.Close(); err != nil {
+				t.Fatal(err)
+			}
+
+			if data, err := ioutil.ReadFile(name); err != nil || string(data) != "5555\n" {
+				t.Errorf("current file = %q, %v, want %q", data, err, "5555\n")
+			}
+			for i, want := range tt.wantBackups {
+				backup := fmt.Sprintf("%s.%d", name, i+1)
+				if data, err := ioutil.ReadFile(backup); err != nil || string(data) != want+"\n" {
+					t.Errorf("%s = %q, %v, want %q", filepath.Base(backup), data, err, want+"\n")
+				}
+			}
+			files, err := ioutil.ReadDir(dir)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(files) != len(tt.wantBackups)+1 {
+				t.Errorf("%d log files, want %d", len(files), len(tt.wantBackups)+1)
+			}
+		})
+	}
+}
+
+func TestRotatingFile_Reopen(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rotate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	name := filepath.Join(dir, "server.log")
+	if err := ioutil.WriteFile(name, []byte("1111\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// size of existing file counts towards MaxSize
+	f, err := newRotatingFile(name, 8, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.file.Close()
+	if _, err := f.Write([]byte("2222\n")); err != nil {
+		t.Fatal(err)
+	}
+	if data, err := ioutil.ReadFile(name + ".1"); err != nil || string(data) != "1111\n" {
+		t.Errorf("backup = %q, %v, want %q", data, err, "1111\n")
+	}
+}
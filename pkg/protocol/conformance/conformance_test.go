@@ -0,0 +1,873 @@
+package conformance
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
+	"go.smartmachine.io/go-grpc-api/pkg/testing/todotest"
+)
+
+// scenario is RPC called through gRPC and equivalent HTTP request
+type scenario struct {
+	name string
+	// call calls RPC through gRPC client
+	call func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error)
+	// method, path and body are equivalent HTTP request
+	method string
+	path   string
+	body   string
+	// response is empty response message HTTP response body is decoded to
+	response proto.Message
+	// wantCode is expected status code of both transports
+	wantCode codes.Code
+	// setup prepares state of both servers after seed tasks are created, optional
+	setup func(ctx context.Context, c v1.ToDoServiceClient) error
+	// normalize clears fields of response which differ between servers, e.g. times, optional
+	normalize func(m proto.Message)
+}
+
+func timestamp(t *testing.T, s string) string {
+	ts, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ts.Format(time.RFC3339Nano)
+}
+
+// setup runs setup of scenario on server
+func setup(ctx context.Context, t *testing.T, sc scenario, s *todotest.Server) {
+	if sc.setup == nil {
+		return
+	}
+	if err := sc.setup(ctx, s.Client); err != nil {
+		t.Fatalf("failed to set up scenario: %v", err)
+	}
+}
+
+// clearTimes clears times in response which are recorded by server
+func clearTimes(m proto.Message) {
+	switch res := m.(type) {
+	case *v1.ListDeletedResponse:
+		for _, td := range res.ToDos {
+			td.DeletedAt = nil
+		}
+	case *v1.ListRevisionsResponse:
+		for _, r := range res.Revisions {
+			r.CreateTime = nil
+		}
+	case *v1.GetRevisionResponse:
+		res.Revision.CreateTime = nil
+	case *v1.ListAuditEventsResponse:
+		// request IDs are generated by server too
+		for _, e := range res.Events {
+			e.Time, e.RequestId = nil, ""
+		}
+	}
+}
+
+func TestConformance(t *testing.T) {
+	reminder, _ := ptypes.TimestampProto(time.Date(2019, 6, 1, 10, 0, 0, 0, time.UTC))
+	seed := []*v1.ToDo{
+		{Title: "first", Description: "first task", Reminder: reminder},
+		{Title: "second", Description: "second task", Reminder: reminder},
+	}
+	rem := timestamp(t, "2019-06-01T10:00:00Z")
+
+	// projects creates project "home" with task "third" and empty project "empty"
+	projects := func(ctx context.Context, c v1.ToDoServiceClient) error {
+		home, err := c.CreateProject(ctx, &v1.CreateProjectRequest{Api: "v1", Project: &v1.Project{Name: "home"}})
+		if err != nil {
+			return err
+		}
+		if _, err := c.CreateProject(ctx, &v1.CreateProjectRequest{Api: "v1", Project: &v1.Project{Name: "empty"}}); err != nil {
+			return err
+		}
+		_, err = c.Create(ctx, &v1.CreateRequest{Api: "v1", ToDo: &v1.ToDo{
+			Title: "third", Reminder: reminder, ProjectId: home.Project.Id}})
+		return err
+	}
+	// tagged creates task "third" tagged home and urgent and task "fourth" tagged urgent
+	tagged := func(ctx context.Context, c v1.ToDoServiceClient) error {
+		for _, td := range []*v1.ToDo{
+			{Title: "third", Reminder: reminder, Tags: []string{"home", "urgent"}},
+			{Title: "fourth", Reminder: reminder, Tags: []string{"urgent"}},
+		} {
+			if _, err := c.Create(ctx, &v1.CreateRequest{Api: "v1", ToDo: td}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	// revised updates task "first" to record its second revision
+	revised := func(ctx context.Context, c v1.ToDoServiceClient) error {
+		_, err := c.Update(ctx, &v1.UpdateRequest{Api: "v1", ToDo: &v1.ToDo{Id: 1, Title: "revised", Reminder: reminder}})
+		return err
+	}
+	// trashed moves task "second" to trash
+	trashed := func(ctx context.Context, c v1.ToDoServiceClient) error {
+		_, err := c.Delete(ctx, &v1.DeleteRequest{Api: "v1", Id: 2})
+		return err
+	}
+	// recurring creates task "third" recurring daily three times and completes task "second"
+	recurring := func(ctx context.Context, c v1.ToDoServiceClient) error {
+		if _, err := c.Create(ctx, &v1.CreateRequest{Api: "v1", ToDo: &v1.ToDo{
+			Title: "third", Reminder: reminder, Recurrence: "FREQ=DAILY;COUNT=3"}}); err != nil {
+			return err
+		}
+		_, err := c.Complete(ctx, &v1.CompleteRequest{Api: "v1", Id: 2})
+		return err
+	}
+	start, _ := ptypes.TimestampProto(time.Date(2019, 6, 1, 0, 0, 0, 0, time.UTC))
+	end, _ := ptypes.TimestampProto(time.Date(2019, 6, 3, 0, 0, 0, 0, time.UTC))
+
+	scenarios := []scenario{
+		{
+			name: "Create",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.Create(ctx, &v1.CreateRequest{Api: "v1", ToDo: &v1.ToDo{Title: "new", Reminder: reminder}})
+			},
+			method: http.MethodPost, path: "/v1/todo",
+			body:     `{"api":"v1","toDo":{"title":"new","reminder":"` + rem + `"}}`,
+			response: &v1.CreateResponse{},
+		},
+		{
+			name: "Create without reminder",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.Create(ctx, &v1.CreateRequest{Api: "v1", ToDo: &v1.ToDo{Title: "new"}})
+			},
+			method: http.MethodPost, path: "/v1/todo",
+			body:     `{"api":"v1","toDo":{"title":"new"}}`,
+			response: &v1.CreateResponse{},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "Create unsupported API",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.Create(ctx, &v1.CreateRequest{Api: "v2", ToDo: &v1.ToDo{Title: "new", Reminder: reminder}})
+			},
+			method: http.MethodPost, path: "/v1/todo",
+			body:     `{"api":"v2","toDo":{"title":"new","reminder":"` + rem + `"}}`,
+			response: &v1.CreateResponse{},
+			wantCode: codes.Unimplemented,
+		},
+		{
+			name: "Read",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.Read(ctx, &v1.ReadRequest{Api: "v1", Id: 2})
+			},
+			method: http.MethodGet, path: "/v1/todo/2?api=v1",
+			response: &v1.ReadResponse{},
+		},
+		{
+			name: "Read not found",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.Read(ctx, &v1.ReadRequest{Api: "v1", Id: 100})
+			},
+			method: http.MethodGet, path: "/v1/todo/100?api=v1",
+			response: &v1.ReadResponse{},
+			wantCode: codes.NotFound,
+		},
+		{
+			name: "Read without API version",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.Read(ctx, &v1.ReadRequest{Id: 1})
+			},
+			method: http.MethodGet, path: "/v1/todo/1",
+			response: &v1.ReadResponse{},
+		},
+		{
+			name: "Update",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.Update(ctx, &v1.UpdateRequest{Api: "v1", ToDo: &v1.ToDo{Id: 1, Title: "updated", Reminder: reminder}})
+			},
+			method: http.MethodPut, path: "/v1/todo/1",
+			body:     `{"api":"v1","toDo":{"title":"updated","reminder":"` + rem + `"}}`,
+			response: &v1.UpdateResponse{},
+		},
+		{
+			name: "Update with PATCH",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.Update(ctx, &v1.UpdateRequest{Api: "v1", ToDo: &v1.ToDo{Id: 2, Description: "patched", Reminder: reminder}})
+			},
+			method: http.MethodPatch, path: "/v1/todo/2",
+			body:     `{"api":"v1","toDo":{"description":"patched","reminder":"` + rem + `"}}`,
+			response: &v1.UpdateResponse{},
+		},
+		{
+			// Update saves task even if it does not exist
+			name: "Update missing task",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.Update(ctx, &v1.UpdateRequest{Api: "v1", ToDo: &v1.ToDo{Id: 100, Title: "updated", Reminder: reminder}})
+			},
+			method: http.MethodPut, path: "/v1/todo/100",
+			body:     `{"api":"v1","toDo":{"title":"updated","reminder":"` + rem + `"}}`,
+			response: &v1.UpdateResponse{},
+		},
+		{
+			name: "Delete",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.Delete(ctx, &v1.DeleteRequest{Api: "v1", Id: 1})
+			},
+			method: http.MethodDelete, path: "/v1/todo/1?api=v1",
+			response: &v1.DeleteResponse{},
+		},
+		{
+			// Delete of missing task succeeds with zero deleted count
+			name: "Delete missing task",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.Delete(ctx, &v1.DeleteRequest{Api: "v1", Id: 100})
+			},
+			method: http.MethodDelete, path: "/v1/todo/100?api=v1",
+			response: &v1.DeleteResponse{},
+		},
+		{
+			name: "BatchCreate",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.BatchCreate(ctx, &v1.BatchCreateRequest{Api: "v1", ToDos: []*v1.ToDo{
+					{Title: "third", Reminder: reminder}, {Title: "fourth", Reminder: reminder}}})
+			},
+			method: http.MethodPost, path: "/v1/todo:batchCreate",
+			body:     `{"api":"v1","toDos":[{"title":"third","reminder":"` + rem + `"},{"title":"fourth","reminder":"` + rem + `"}]}`,
+			response: &v1.BatchCreateResponse{},
+		},
+		{
+			name: "BatchCreate without reminder",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.BatchCreate(ctx, &v1.BatchCreateRequest{Api: "v1", ToDos: []*v1.ToDo{
+					{Title: "third", Reminder: reminder}, {Title: "fourth"}}})
+			},
+			method: http.MethodPost, path: "/v1/todo:batchCreate",
+			body:     `{"api":"v1","toDos":[{"title":"third","reminder":"` + rem + `"},{"title":"fourth"}]}`,
+			response: &v1.BatchCreateResponse{},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "BatchCreate per item",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.BatchCreate(ctx, &v1.BatchCreateRequest{Api: "v1", Mode: v1.BatchMode_PER_ITEM, ToDos: []*v1.ToDo{
+					{Title: "third", Reminder: reminder}, {Title: "fourth"}}})
+			},
+			method: http.MethodPost, path: "/v1/todo:batchCreate",
+			body:     `{"api":"v1","mode":"PER_ITEM","toDos":[{"title":"third","reminder":"` + rem + `"},{"title":"fourth"}]}`,
+			response: &v1.BatchCreateResponse{},
+		},
+		{
+			name: "BatchUpdate missing task",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.BatchUpdate(ctx, &v1.BatchUpdateRequest{Api: "v1", ToDos: []*v1.ToDo{
+					{Id: 1, Title: "updated", Reminder: reminder}, {Id: 100, Title: "updated", Reminder: reminder}}})
+			},
+			method: http.MethodPost, path: "/v1/todo:batchUpdate",
+			body: `{"api":"v1","toDos":[{"id":"1","title":"updated","reminder":"` + rem + `"},` +
+				`{"id":"100","title":"updated","reminder":"` + rem + `"}]}`,
+			response: &v1.BatchUpdateResponse{},
+			wantCode: codes.NotFound,
+		},
+		{
+			name: "BatchUpdate per item",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.BatchUpdate(ctx, &v1.BatchUpdateRequest{Api: "v1", Mode: v1.BatchMode_PER_ITEM, ToDos: []*v1.ToDo{
+					{Id: 1, Title: "updated", Reminder: reminder}, {Id: 100, Title: "updated", Reminder: reminder}}})
+			},
+			method: http.MethodPost, path: "/v1/todo:batchUpdate",
+			body: `{"api":"v1","mode":"PER_ITEM","toDos":[{"id":"1","title":"updated","reminder":"` + rem + `"},` +
+				`{"id":"100","title":"updated","reminder":"` + rem + `"}]}`,
+			response: &v1.BatchUpdateResponse{},
+		},
+		{
+			name: "BatchDelete",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.BatchDelete(ctx, &v1.BatchDeleteRequest{Api: "v1", Ids: []int64{1, 2}})
+			},
+			method: http.MethodPost, path: "/v1/todo:batchDelete",
+			body:     `{"api":"v1","ids":["1","2"]}`,
+			response: &v1.BatchDeleteResponse{},
+		},
+		{
+			name: "BatchDelete missing task",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.BatchDelete(ctx, &v1.BatchDeleteRequest{Api: "v1", Ids: []int64{1, 100}})
+			},
+			method: http.MethodPost, path: "/v1/todo:batchDelete",
+			body:     `{"api":"v1","ids":["1","100"]}`,
+			response: &v1.BatchDeleteResponse{},
+			wantCode: codes.NotFound,
+		},
+		{
+			name: "BatchDelete per item",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.BatchDelete(ctx, &v1.BatchDeleteRequest{Api: "v1", Mode: v1.BatchMode_PER_ITEM, Ids: []int64{1, 100}})
+			},
+			method: http.MethodPost, path: "/v1/todo:batchDelete",
+			body:     `{"api":"v1","mode":"PER_ITEM","ids":["1","100"]}`,
+			response: &v1.BatchDeleteResponse{},
+		},
+		{
+			name: "ReadAll",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.ReadAll(ctx, &v1.ReadAllRequest{Api: "v1"})
+			},
+			method: http.MethodGet, path: "/v1/todo/all?api=v1",
+			response: &v1.ReadAllResponse{},
+		},
+		{
+			name: "ReadAll unsupported API",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.ReadAll(ctx, &v1.ReadAllRequest{Api: "v2"})
+			},
+			method: http.MethodGet, path: "/v1/todo/all?api=v2",
+			response: &v1.ReadAllResponse{},
+			wantCode: codes.Unimplemented,
+		},
+		{
+			name: "CreateProject",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.CreateProject(ctx, &v1.CreateProjectRequest{Api: "v1", Project: &v1.Project{Name: "work", Description: "daily work"}})
+			},
+			method: http.MethodPost, path: "/v1/projects",
+			body:     `{"api":"v1","project":{"name":"work","description":"daily work"}}`,
+			response: &v1.CreateProjectResponse{},
+			setup:    projects,
+		},
+		{
+			name: "CreateProject existing name",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.CreateProject(ctx, &v1.CreateProjectRequest{Api: "v1", Project: &v1.Project{Name: "home"}})
+			},
+			method: http.MethodPost, path: "/v1/projects",
+			body:     `{"api":"v1","project":{"name":"home"}}`,
+			response: &v1.CreateProjectResponse{},
+			wantCode: codes.AlreadyExists,
+			setup:    projects,
+		},
+		{
+			name: "CreateProject without name",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.CreateProject(ctx, &v1.CreateProjectRequest{Api: "v1", Project: &v1.Project{}})
+			},
+			method: http.MethodPost, path: "/v1/projects",
+			body:     `{"api":"v1","project":{}}`,
+			response: &v1.CreateProjectResponse{},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "ReadProject",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.ReadProject(ctx, &v1.ReadProjectRequest{Api: "v1", Id: 1})
+			},
+			method: http.MethodGet, path: "/v1/projects/1?api=v1",
+			response: &v1.ReadProjectResponse{},
+			setup:    projects,
+		},
+		{
+			name: "ReadProject not found",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.ReadProject(ctx, &v1.ReadProjectRequest{Api: "v1", Id: 100})
+			},
+			method: http.MethodGet, path: "/v1/projects/100?api=v1",
+			response: &v1.ReadProjectResponse{},
+			wantCode: codes.NotFound,
+			setup:    projects,
+		},
+		{
+			name: "UpdateProject",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.UpdateProject(ctx, &v1.UpdateProjectRequest{Api: "v1", Project: &v1.Project{Id: 1, Name: "house"}})
+			},
+			method: http.MethodPut, path: "/v1/projects/1",
+			body:     `{"api":"v1","project":{"name":"house"}}`,
+			response: &v1.UpdateProjectResponse{},
+			setup:    projects,
+		},
+		{
+			name: "UpdateProject with PATCH",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.UpdateProject(ctx, &v1.UpdateProjectRequest{Api: "v1", Project: &v1.Project{Id: 2, Name: "empty", Description: "patched"}})
+			},
+			method: http.MethodPatch, path: "/v1/projects/2",
+			body:     `{"api":"v1","project":{"name":"empty","description":"patched"}}`,
+			response: &v1.UpdateProjectResponse{},
+			setup:    projects,
+		},
+		{
+			name: "UpdateProject existing name",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.UpdateProject(ctx, &v1.UpdateProjectRequest{Api: "v1", Project: &v1.Project{Id: 2, Name: "home"}})
+			},
+			method: http.MethodPut, path: "/v1/projects/2",
+			body:     `{"api":"v1","project":{"name":"home"}}`,
+			response: &v1.UpdateProjectResponse{},
+			wantCode: codes.AlreadyExists,
+			setup:    projects,
+		},
+		{
+			name: "UpdateProject not found",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.UpdateProject(ctx, &v1.UpdateProjectRequest{Api: "v1", Project: &v1.Project{Id: 100, Name: "house"}})
+			},
+			method: http.MethodPut, path: "/v1/projects/100",
+			body:     `{"api":"v1","project":{"name":"house"}}`,
+			response: &v1.UpdateProjectResponse{},
+			wantCode: codes.NotFound,
+			setup:    projects,
+		},
+		{
+			name: "DeleteProject",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.DeleteProject(ctx, &v1.DeleteProjectRequest{Api: "v1", Id: 2})
+			},
+			method: http.MethodDelete, path: "/v1/projects/2?api=v1",
+			response: &v1.DeleteProjectResponse{},
+			setup:    projects,
+		},
+		{
+			// tasks of project restrict the delete by default
+			name: "DeleteProject with tasks",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.DeleteProject(ctx, &v1.DeleteProjectRequest{Api: "v1", Id: 1})
+			},
+			method: http.MethodDelete, path: "/v1/projects/1?api=v1",
+			response: &v1.DeleteProjectResponse{},
+			wantCode: codes.FailedPrecondition,
+			setup:    projects,
+		},
+		{
+			name: "DeleteProject with tasks cascade",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.DeleteProject(ctx, &v1.DeleteProjectRequest{Api: "v1", Id: 1, Mode: v1.ProjectDeleteMode_CASCADE})
+			},
+			method: http.MethodDelete, path: "/v1/projects/1?api=v1&mode=CASCADE",
+			response: &v1.DeleteProjectResponse{},
+			setup:    projects,
+		},
+		{
+			// Delete of missing project succeeds with zero deleted count
+			name: "DeleteProject missing project",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.DeleteProject(ctx, &v1.DeleteProjectRequest{Api: "v1", Id: 100})
+			},
+			method: http.MethodDelete, path: "/v1/projects/100?api=v1",
+			response: &v1.DeleteProjectResponse{},
+			setup:    projects,
+		},
+		{
+			name: "ListProjects",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.ListProjects(ctx, &v1.ListProjectsRequest{Api: "v1"})
+			},
+			method: http.MethodGet, path: "/v1/projects?api=v1",
+			response: &v1.ListProjectsResponse{},
+			setup:    projects,
+		},
+		{
+			name: "ReadAll of project",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.ReadAll(ctx, &v1.ReadAllRequest{Api: "v1", ProjectId: 1})
+			},
+			method: http.MethodGet, path: "/v1/projects/1/todos?api=v1",
+			response: &v1.ReadAllResponse{},
+			setup:    projects,
+		},
+		{
+			name: "ReadAll of project not found",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.ReadAll(ctx, &v1.ReadAllRequest{Api: "v1", ProjectId: 100})
+			},
+			method: http.MethodGet, path: "/v1/projects/100/todos?api=v1",
+			response: &v1.ReadAllResponse{},
+			wantCode: codes.NotFound,
+			setup:    projects,
+		},
+		{
+			name: "ReadAll with tag",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.ReadAll(ctx, &v1.ReadAllRequest{Api: "v1", Tags: []string{"urgent"}})
+			},
+			method: http.MethodGet, path: "/v1/todo/all?api=v1&tags=urgent",
+			response: &v1.ReadAllResponse{},
+			setup:    tagged,
+		},
+		{
+			name: "ReadAll with tags",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.ReadAll(ctx, &v1.ReadAllRequest{Api: "v1", Tags: []string{"urgent", "home"}})
+			},
+			method: http.MethodGet, path: "/v1/todo/all?api=v1&tags=urgent&tags=home",
+			response: &v1.ReadAllResponse{},
+			setup:    tagged,
+		},
+		{
+			name: "ReadAll with unknown tag",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.ReadAll(ctx, &v1.ReadAllRequest{Api: "v1", Tags: []string{"unknown"}})
+			},
+			method: http.MethodGet, path: "/v1/todo/all?api=v1&tags=unknown",
+			response: &v1.ReadAllResponse{},
+			setup:    tagged,
+		},
+		{
+			name: "ListTags",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.ListTags(ctx, &v1.ListTagsRequest{Api: "v1"})
+			},
+			method: http.MethodGet, path: "/v1/todo:tags?api=v1",
+			response: &v1.ListTagsResponse{},
+			setup:    tagged,
+		},
+		{
+			name: "ListTags without tags",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.ListTags(ctx, &v1.ListTagsRequest{Api: "v1"})
+			},
+			method: http.MethodGet, path: "/v1/todo:tags?api=v1",
+			response: &v1.ListTagsResponse{},
+		},
+		{
+			name: "Create with invalid tag",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.Create(ctx, &v1.CreateRequest{Api: "v1", ToDo: &v1.ToDo{Title: "new", Reminder: reminder, Tags: []string{"a,b"}}})
+			},
+			method: http.MethodPost, path: "/v1/todo",
+			body:     `{"api":"v1","toDo":{"title":"new","reminder":"` + rem + `","tags":["a,b"]}}`,
+			response: &v1.CreateResponse{},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "ListRevisions",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.ListRevisions(ctx, &v1.ListRevisionsRequest{Api: "v1", Id: 1})
+			},
+			method: http.MethodGet, path: "/v1/todo/1/revisions?api=v1",
+			response:  &v1.ListRevisionsResponse{},
+			setup:     revised,
+			normalize: clearTimes,
+		},
+		{
+			name: "ListRevisions not found",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.ListRevisions(ctx, &v1.ListRevisionsRequest{Api: "v1", Id: 100})
+			},
+			method: http.MethodGet, path: "/v1/todo/100/revisions?api=v1",
+			response: &v1.ListRevisionsResponse{},
+			wantCode: codes.NotFound,
+		},
+		{
+			name: "GetRevision",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.GetRevision(ctx, &v1.GetRevisionRequest{Api: "v1", Id: 1, Revision: 1})
+			},
+			method: http.MethodGet, path: "/v1/todo/1/revisions/1?api=v1",
+			response:  &v1.GetRevisionResponse{},
+			setup:     revised,
+			normalize: clearTimes,
+		},
+		{
+			name: "GetRevision not found",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.GetRevision(ctx, &v1.GetRevisionRequest{Api: "v1", Id: 1, Revision: 100})
+			},
+			method: http.MethodGet, path: "/v1/todo/1/revisions/100?api=v1",
+			response: &v1.GetRevisionResponse{},
+			wantCode: codes.NotFound,
+			setup:    revised,
+		},
+		{
+			name: "RestoreRevision",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.RestoreRevision(ctx, &v1.RestoreRevisionRequest{Api: "v1", Id: 1, Revision: 1})
+			},
+			method: http.MethodPost, path: "/v1/todo/1/revisions/1:restore",
+			body:     `{"api":"v1"}`,
+			response: &v1.RestoreRevisionResponse{},
+			setup:    revised,
+		},
+		{
+			name: "RestoreRevision of missing revision",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.RestoreRevision(ctx, &v1.RestoreRevisionRequest{Api: "v1", Id: 1, Revision: 100})
+			},
+			method: http.MethodPost, path: "/v1/todo/1/revisions/100:restore",
+			body:     `{"api":"v1"}`,
+			response: &v1.RestoreRevisionResponse{},
+			wantCode: codes.NotFound,
+			setup:    revised,
+		},
+		{
+			name: "RestoreRevision of missing task",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.RestoreRevision(ctx, &v1.RestoreRevisionRequest{Api: "v1", Id: 100, Revision: 1})
+			},
+			method: http.MethodPost, path: "/v1/todo/100/revisions/1:restore",
+			body:     `{"api":"v1"}`,
+			response: &v1.RestoreRevisionResponse{},
+			wantCode: codes.NotFound,
+		},
+		{
+			name: "Undelete",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.Undelete(ctx, &v1.UndeleteRequest{Api: "v1", Id: 2})
+			},
+			method: http.MethodPost, path: "/v1/todo/2:undelete",
+			body:     `{"api":"v1"}`,
+			response: &v1.UndeleteResponse{},
+			setup:    trashed,
+		},
+		{
+			name: "Undelete task not in trash",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.Undelete(ctx, &v1.UndeleteRequest{Api: "v1", Id: 1})
+			},
+			method: http.MethodPost, path: "/v1/todo/1:undelete",
+			body:     `{"api":"v1"}`,
+			response: &v1.UndeleteResponse{},
+			wantCode: codes.NotFound,
+			setup:    trashed,
+		},
+		{
+			name: "ListDeleted",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.ListDeleted(ctx, &v1.ListDeletedRequest{Api: "v1"})
+			},
+			method: http.MethodGet, path: "/v1/todo:deleted?api=v1",
+			response:  &v1.ListDeletedResponse{},
+			setup:     trashed,
+			normalize: clearTimes,
+		},
+		{
+			name: "ListDeleted empty trash",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.ListDeleted(ctx, &v1.ListDeletedRequest{Api: "v1"})
+			},
+			method: http.MethodGet, path: "/v1/todo:deleted?api=v1",
+			response: &v1.ListDeletedResponse{},
+		},
+		{
+			// task in trash is not updated until it is restored
+			name: "Update task in trash",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.Update(ctx, &v1.UpdateRequest{Api: "v1", ToDo: &v1.ToDo{Id: 2, Title: "updated", Reminder: reminder}})
+			},
+			method: http.MethodPut, path: "/v1/todo/2",
+			body:     `{"api":"v1","toDo":{"title":"updated","reminder":"` + rem + `"}}`,
+			response: &v1.UpdateResponse{},
+			wantCode: codes.NotFound,
+			setup:    trashed,
+		},
+		{
+			name: "Complete",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.Complete(ctx, &v1.CompleteRequest{Api: "v1", Id: 1})
+			},
+			method: http.MethodPost, path: "/v1/todo/1:complete",
+			body:     `{"api":"v1"}`,
+			response: &v1.CompleteResponse{},
+			setup:    recurring,
+		},
+		{
+			name: "Complete recurring task",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.Complete(ctx, &v1.CompleteRequest{Api: "v1", Id: 3})
+			},
+			method: http.MethodPost, path: "/v1/todo/3:complete",
+			body:     `{"api":"v1"}`,
+			response: &v1.CompleteResponse{},
+			setup:    recurring,
+		},
+		{
+			name: "Complete task done already",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.Complete(ctx, &v1.CompleteRequest{Api: "v1", Id: 2})
+			},
+			method: http.MethodPost, path: "/v1/todo/2:complete",
+			body:     `{"api":"v1"}`,
+			response: &v1.CompleteResponse{},
+			wantCode: codes.FailedPrecondition,
+			setup:    recurring,
+		},
+		{
+			name: "Complete missing task",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.Complete(ctx, &v1.CompleteRequest{Api: "v1", Id: 100})
+			},
+			method: http.MethodPost, path: "/v1/todo/100:complete",
+			body:     `{"api":"v1"}`,
+			response: &v1.CompleteResponse{},
+			wantCode: codes.NotFound,
+		},
+		{
+			name: "ListOccurrences",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.ListOccurrences(ctx, &v1.ListOccurrencesRequest{Api: "v1", Start: start, End: end})
+			},
+			method: http.MethodGet,
+			path: "/v1/todo:occurrences?api=v1&start=" + timestamp(t, "2019-06-01T00:00:00Z") +
+				"&end=" + timestamp(t, "2019-06-03T00:00:00Z"),
+			response: &v1.ListOccurrencesResponse{},
+			setup:    recurring,
+		},
+		{
+			name: "ListOccurrences of tasks",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.ListOccurrences(ctx, &v1.ListOccurrencesRequest{Api: "v1", Start: start, End: end, Ids: []int64{1, 3}})
+			},
+			method: http.MethodGet,
+			path: "/v1/todo:occurrences?api=v1&start=" + timestamp(t, "2019-06-01T00:00:00Z") +
+				"&end=" + timestamp(t, "2019-06-03T00:00:00Z") + "&ids=1&ids=3",
+			response: &v1.ListOccurrencesResponse{},
+			setup:    recurring,
+		},
+		{
+			name: "ListOccurrences without end",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.ListOccurrences(ctx, &v1.ListOccurrencesRequest{Api: "v1", Start: start})
+			},
+			method: http.MethodGet, path: "/v1/todo:occurrences?api=v1&start=" + timestamp(t, "2019-06-01T00:00:00Z"),
+			response: &v1.ListOccurrencesResponse{},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "ListOccurrences end before start",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.ListOccurrences(ctx, &v1.ListOccurrencesRequest{Api: "v1", Start: end, End: start})
+			},
+			method: http.MethodGet,
+			path: "/v1/todo:occurrences?api=v1&start=" + timestamp(t, "2019-06-03T00:00:00Z") +
+				"&end=" + timestamp(t, "2019-06-01T00:00:00Z"),
+			response: &v1.ListOccurrencesResponse{},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "ListAuditEvents",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.ListAuditEvents(ctx, &v1.ListAuditEventsRequest{Api: "v1"})
+			},
+			method: http.MethodGet, path: "/v1/todo:audit?api=v1",
+			response:  &v1.ListAuditEventsResponse{},
+			setup:     revised,
+			normalize: clearTimes,
+		},
+		{
+			name: "ListAuditEvents of task",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.ListAuditEvents(ctx, &v1.ListAuditEventsRequest{Api: "v1", ToDoId: 1, Start: start})
+			},
+			method: http.MethodGet, path: "/v1/todo:audit?api=v1&toDoId=1&start=" + timestamp(t, "2019-06-01T00:00:00Z"),
+			response:  &v1.ListAuditEventsResponse{},
+			setup:     revised,
+			normalize: clearTimes,
+		},
+		{
+			name: "ListAuditEvents end not after start",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) (proto.Message, error) {
+				return c.ListAuditEvents(ctx, &v1.ListAuditEventsRequest{Api: "v1", Start: start, End: start})
+			},
+			method: http.MethodGet,
+			path: "/v1/todo:audit?api=v1&start=" + timestamp(t, "2019-06-01T00:00:00Z") +
+				"&end=" + timestamp(t, "2019-06-01T00:00:00Z"),
+			response: &v1.ListAuditEventsResponse{},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, sc := range scenarios {
+		t.Run(sc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			// gRPC
+			grpcStack := todotest.Start(t, todotest.Config{})
+			defer grpcStack.Close()
+			grpcStack.Seed(t, seed...)
+			setup(ctx, t, sc, grpcStack)
+			grpcRes, err := sc.call(ctx, grpcStack.Client)
+			grpcStatus := status.Convert(err)
+			if grpcStatus.Code() != sc.wantCode {
+				t.Fatalf("gRPC code = %v, want %v (%v)", grpcStatus.Code(), sc.wantCode, err)
+			}
+
+			// HTTP/REST
+			restStack := todotest.Start(t, todotest.Config{})
+			defer restStack.Close()
+			restStack.Seed(t, seed...)
+			setup(ctx, t, sc, restStack)
+			req, err := http.NewRequest(sc.method, restStack.URL+sc.path, strings.NewReader(sc.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			body, err := ioutil.ReadAll(resp.Body)
+			resp.Body.Close()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if want := runtime.HTTPStatusFromCode(sc.wantCode); resp.StatusCode != want {
+				t.Fatalf("HTTP status = %v, want %v: %s", resp.StatusCode, want, body)
+			}
+
+			if sc.wantCode != codes.OK {
+				var e struct {
+					Code    codes.Code `json:"code"`
+					Message string     `json:"message"`
+				}
+				if err := json.Unmarshal(body, &e); err != nil {
+					t.Fatalf("failed to decode error body %s: %v", body, err)
+				}
+				if e.Code != grpcStatus.Code() || e.Message != grpcStatus.Message() {
+					t.Errorf("HTTP error = %v %q, gRPC error = %v %q", e.Code, e.Message, grpcStatus.Code(), grpcStatus.Message())
+				}
+			} else {
+				restRes := sc.response
+				if err := jsonpb.UnmarshalString(string(body), restRes); err != nil {
+					t.Fatalf("failed to decode response %s: %v", body, err)
+				}
+				if sc.normalize != nil {
+					sc.normalize(grpcRes)
+					sc.normalize(restRes)
+				}
+				if !proto.Equal(grpcRes, restRes) {
+					t.Errorf("HTTP response = %v, gRPC response = %v", restRes, grpcRes)
+				}
+			}
+
+			// both transports must leave the same state behind
+			grpcAll, err := grpcStack.Client.ReadAll(ctx, &v1.ReadAllRequest{Api: "v1"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			restAll, err := restStack.Client.ReadAll(ctx, &v1.ReadAllRequest{Api: "v1"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !proto.Equal(grpcAll, restAll) {
+				t.Errorf("state after HTTP call = %v, after gRPC call = %v", restAll, grpcAll)
+			}
+			grpcProjects, err := grpcStack.Client.ListProjects(ctx, &v1.ListProjectsRequest{Api: "v1"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			restProjects, err := restStack.Client.ListProjects(ctx, &v1.ListProjectsRequest{Api: "v1"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !proto.Equal(grpcProjects, restProjects) {
+				t.Errorf("projects after HTTP call = %v, after gRPC call = %v", restProjects, grpcProjects)
+			}
+		})
+	}
+}
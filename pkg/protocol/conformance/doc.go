@@ -0,0 +1,6 @@
+// Package conformance verifies that HTTP/REST gateway and gRPC server behave identically.
+//
+// Every scenario is run against fresh in-process server started by todotest once through v1.ToDoServiceClient
+// and once as plain HTTP request. Responses must be equal, HTTP status must match
+// gRPC status code as mapped by gateway, and error bodies must carry the same code and message.
+package conformance
@@ -0,0 +1,99 @@
+package conformance
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
+	"go.smartmachine.io/go-grpc-api/pkg/testing/todotest"
+)
+
+// dtstamp matches creation time of iCalendar file, it differs between exports
+var dtstamp = regexp.MustCompile(`(?m)^DTSTAMP:.*\r?\n`)
+
+// exportGRPC receives exported file from gRPC stream
+func exportGRPC(ctx context.Context, c v1.ToDoServiceClient, format string) (contentType string, data []byte, err error) {
+	stream, err := c.Export(ctx, &v1.ExportRequest{Api: "v1", Format: format})
+	if err != nil {
+		return "", nil, err
+	}
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			return contentType, data, nil
+		}
+		if err != nil {
+			return "", nil, err
+		}
+		contentType = chunk.ContentType
+		data = append(data, chunk.Data...)
+	}
+}
+
+func TestExportConformance(t *testing.T) {
+	ctx := context.Background()
+	reminder, _ := ptypes.TimestampProto(time.Date(2019, 6, 1, 10, 0, 0, 0, time.UTC))
+
+	srv := todotest.Start(t, todotest.Config{})
+	defer srv.Close()
+	srv.Seed(t,
+		&v1.ToDo{Title: "first", Description: "first task, with comma", Reminder: reminder, Tags: []string{"home"}},
+		&v1.ToDo{Title: "second", Description: "second \"quoted\" task", Reminder: reminder, Recurrence: "FREQ=DAILY;COUNT=2"},
+	)
+
+	for _, tt := range []struct {
+		name     string
+		format   string
+		wantCode codes.Code
+	}{
+		{name: "Default", format: ""},
+		{name: "CSV", format: "csv"},
+		{name: "JSON Lines", format: "jsonl"},
+		{name: "iCalendar", format: "ics"},
+		{name: "Unsupported", format: "xml", wantCode: codes.InvalidArgument},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			grpcType, grpcData, err := exportGRPC(ctx, srv.Client, tt.format)
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("gRPC code = %v, want %v (%v)", status.Code(err), tt.wantCode, err)
+			}
+
+			resp, err := http.Get(srv.URL + "/v1/todo:export?api=v1&format=" + tt.format)
+			if err != nil {
+				t.Fatal(err)
+			}
+			body, err := ioutil.ReadAll(resp.Body)
+			resp.Body.Close()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if want := runtime.HTTPStatusFromCode(tt.wantCode); resp.StatusCode != want {
+				t.Fatalf("HTTP status = %v, want %v: %s", resp.StatusCode, want, body)
+			}
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			if len(grpcData) == 0 {
+				t.Fatal("gRPC export is empty")
+			}
+			if restType := resp.Header.Get("Content-Type"); restType != grpcType {
+				t.Errorf("HTTP content type = %q, gRPC content type = %q", restType, grpcType)
+			}
+			restData, grpcData := dtstamp.ReplaceAll(body, nil), dtstamp.ReplaceAll(grpcData, nil)
+			if string(restData) != string(grpcData) {
+				t.Errorf("HTTP body = %q, gRPC body = %q", restData, grpcData)
+			}
+		})
+	}
+}
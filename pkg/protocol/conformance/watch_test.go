@@ -0,0 +1,156 @@
+package conformance
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
+	"go.smartmachine.io/go-grpc-api/pkg/testing/todotest"
+)
+
+// watchEvents receives n changes from the stream
+func watchEvents(t *testing.T, stream v1.ToDoService_WatchClient, n int) []*v1.Event {
+	var events []*v1.Event
+	for len(events) < n {
+		res, err := stream.Recv()
+		if err != nil {
+			t.Fatalf("Recv() error = %v", err)
+		}
+		events = append(events, res.Event)
+	}
+	return events
+}
+
+func TestWatch(t *testing.T) {
+	srv := todotest.Start(t, todotest.Config{})
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	reminder, _ := ptypes.TimestampProto(time.Date(2019, 6, 1, 10, 0, 0, 0, time.UTC))
+	ids := srv.Seed(t, &v1.ToDo{Title: "first", Reminder: reminder}, &v1.ToDo{Title: "second", Reminder: reminder})
+	if _, err := srv.Client.Update(ctx, &v1.UpdateRequest{Api: "v1",
+		ToDo: &v1.ToDo{Id: ids[0], Title: "first updated", Reminder: reminder}}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := srv.Client.Delete(ctx, &v1.DeleteRequest{Api: "v1", Id: ids[1]}); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		req      *v1.WatchRequest
+		wantSeqs []int64
+		wantCode codes.Code
+	}{
+		{name: "Resume", req: &v1.WatchRequest{Api: "v1", AfterSequence: 1}, wantSeqs: []int64{2, 3, 4}},
+		{name: "Filter by type", req: &v1.WatchRequest{Api: "v1", AfterSequence: 1,
+			Types: []v1.EventType{v1.EventType_UPDATED, v1.EventType_DELETED}}, wantSeqs: []int64{3, 4}},
+		{name: "Filter by ID", req: &v1.WatchRequest{Api: "v1", AfterSequence: 1, Ids: []int64{ids[1]}}, wantSeqs: []int64{2, 4}},
+		{name: "Unsupported API", req: &v1.WatchRequest{Api: "v2"}, wantCode: codes.Unimplemented},
+	}
+	clients := map[string]v1.ToDoServiceClient{"gRPC": srv.Client, "REST": srv.REST}
+	for _, tt := range tests {
+		for transport, client := range clients {
+			t.Run(tt.name+"/"+transport, func(t *testing.T) {
+				ctx, cancel := context.WithCancel(ctx)
+				defer cancel()
+
+				stream, err := client.Watch(ctx, tt.req)
+				if err == nil && tt.wantCode != codes.OK {
+					_, err = stream.Recv()
+				}
+				if status.Code(err) != tt.wantCode {
+					t.Fatalf("Watch() error = %v, want code %v", err, tt.wantCode)
+				}
+				if err != nil {
+					return
+				}
+
+				events := watchEvents(t, stream, len(tt.wantSeqs))
+				for i, e := range events {
+					if e.Sequence != tt.wantSeqs[i] {
+						t.Errorf("event %d sequence = %d, want %d", i, e.Sequence, tt.wantSeqs[i])
+					}
+				}
+			})
+		}
+	}
+
+	// live changes are received by both transports the same
+	var streams []v1.ToDoService_WatchClient
+	for _, transport := range []string{"gRPC", "REST"} {
+		stream, err := clients[transport].Watch(ctx, &v1.WatchRequest{Api: "v1"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		streams = append(streams, stream)
+	}
+	id := srv.Seed(t, &v1.ToDo{Title: "third", Reminder: reminder})[0]
+	want := &v1.ToDo{Id: id, Title: "third", Reminder: reminder}
+	var got []*v1.Event
+	for _, stream := range streams {
+		e := watchEvents(t, stream, 1)[0]
+		if e.Type != v1.EventType_CREATED || !proto.Equal(e.ToDo, want) {
+			t.Errorf("live event = %v, want CREATED %v", e, want)
+		}
+		got = append(got, e)
+	}
+	if !proto.Equal(got[0], got[1]) {
+		t.Errorf("gRPC event %v differs from REST event %v", got[0], got[1])
+	}
+}
+
+func TestWatch_ServerSentEvents(t *testing.T) {
+	srv := todotest.Start(t, todotest.Config{})
+	defer srv.Close()
+
+	reminder, _ := ptypes.TimestampProto(time.Date(2019, 6, 1, 10, 0, 0, 0, time.UTC))
+	srv.Seed(t, &v1.ToDo{Title: "first", Reminder: reminder}, &v1.ToDo{Title: "second", Reminder: reminder})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	req, err := http.NewRequest(http.MethodGet, srv.URL+"/v1/todo:watch", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Accept", "text/event-stream")
+	// EventSource reconnecting after the first event
+	req.Header.Set("Last-Event-ID", "1")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if ct := resp.Header.Get("Content-Type"); ct != "text/event-stream" {
+		t.Errorf("Content-Type = %q, want text/event-stream", ct)
+	}
+
+	var lines []string
+	r := bufio.NewReader(resp.Body)
+	for len(lines) < 4 {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			t.Fatalf("failed to read event: %v", err)
+		}
+		lines = append(lines, strings.TrimSuffix(line, "\n"))
+	}
+	if lines[0] != "id: 2" || lines[1] != "event: CREATED" || lines[3] != "" {
+		t.Fatalf("unexpected event:\n%s", strings.Join(lines, "\n"))
+	}
+	if !strings.HasPrefix(lines[2], "data: {") || !strings.Contains(lines[2], `"title":"second"`) {
+		t.Errorf("unexpected event data: %s", lines[2])
+	}
+}
@@ -0,0 +1,51 @@
+package middleware
+
+import (
+	"context"
+	"crypto/subtle"
+	"strings"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// adminServicePrefix is prefix of full method names of v1.AdminService
+const adminServicePrefix = "/v1.AdminService/"
+
+// checkAdminToken authorizes call of admin service by bearer token
+func checkAdminToken(ctx context.Context, token string) error {
+	if len(token) == 0 {
+		return status.Error(codes.PermissionDenied, "admin API is disabled, admin token is not configured")
+	}
+	t, err := grpc_auth.AuthFromMD(ctx, "bearer")
+	if err != nil {
+		return err
+	}
+	if subtle.ConstantTimeCompare([]byte(t), []byte(token)) != 1 {
+		return status.Error(codes.Unauthenticated, "invalid admin token")
+	}
+	return nil
+}
+
+// AddAdminAuth adds interceptors requiring "authorization: bearer <token>" metadata on every admin service call.
+// Admin service is disabled if token is empty.
+func AddAdminAuth(token string, chain *Chain) {
+	chain.Unary(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if strings.HasPrefix(info.FullMethod, adminServicePrefix) {
+			if err := checkAdminToken(ctx, token); err != nil {
+				return nil, err
+			}
+		}
+		return handler(ctx, req)
+	})
+	chain.Stream(func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if strings.HasPrefix(info.FullMethod, adminServicePrefix) {
+			if err := checkAdminToken(ss.Context(), token); err != nil {
+				return err
+			}
+		}
+		return handler(srv, ss)
+	})
+}
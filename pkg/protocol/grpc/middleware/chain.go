@@ -0,0 +1,32 @@
+package middleware
+
+import (
+	"github.com/grpc-ecosystem/go-grpc-middleware"
+	"google.golang.org/grpc"
+)
+
+// Chain collects server interceptors added by middleware.
+// gRPC server accepts a single unary and a single stream interceptor only,
+// so all of them are installed together as one chain.
+type Chain struct {
+	unary  []grpc.UnaryServerInterceptor
+	stream []grpc.StreamServerInterceptor
+}
+
+// Unary appends unary interceptors to the chain
+func (c *Chain) Unary(i ...grpc.UnaryServerInterceptor) {
+	c.unary = append(c.unary, i...)
+}
+
+// Stream appends stream interceptors to the chain
+func (c *Chain) Stream(i ...grpc.StreamServerInterceptor) {
+	c.stream = append(c.stream, i...)
+}
+
+// ServerOptions returns grpc.Server config options installing the chain
+func (c *Chain) ServerOptions(opts []grpc.ServerOption) []grpc.ServerOption {
+	return append(opts,
+		grpc_middleware.WithUnaryServerChain(c.unary...),
+		grpc_middleware.WithStreamServerChain(c.stream...),
+	)
+}
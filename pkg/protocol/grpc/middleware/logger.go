@@ -1,6 +1,9 @@
 package middleware
 
 import (
+	"context"
+	"sync"
+
 	"github.com/grpc-ecosystem/go-grpc-middleware"
 	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap"
 	"github.com/grpc-ecosystem/go-grpc-middleware/tags"
@@ -8,6 +11,8 @@ import (
 	"go.uber.org/zap/zapcore"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+
+	"go.smartmachine.io/go-grpc-api/pkg/logger"
 )
 
 // codeToLevel redirects OK to DEBUG level logging instead of INFO
@@ -20,26 +25,47 @@ func codeToLevel(code codes.Code) zapcore.Level {
 	return grpc_zap.DefaultCodeToLevel(code)
 }
 
-// AddLogging returns grpc.Server config option that turn on logging.
-func AddLogging(logger *zap.Logger, opts []grpc.ServerOption) []grpc.ServerOption {
-	// Shared options for the logger, with a custom gRPC code to log level function.
+// replaceGrpcLogger replaces logger of gRPC library once, it is global and read by transports of running servers
+var replaceGrpcLogger sync.Once
+
+// AddLogging adds interceptors that turn on logging.
+// Every call is logged by logger named after the called method,
+// so log level can be overridden per service or per RPC at runtime.
+// Call scoped logger with request ID, method, caller identity and trace ID
+// is available to service handlers by logger.FromContext.
+func AddLogging(log *zap.Logger, chain *Chain) {
+	// Shared options for the logger, with a custom gRPC code to log level function
+	// and request duration logged under the same key as HTTP/REST requests.
 	o := []grpc_zap.Option{
 		grpc_zap.WithLevels(codeToLevel),
+		grpc_zap.WithDurationField(logger.Duration),
 	}
 	// Make sure that log statements internal to gRPC library are logged using the zapLogger as well.
-	grpc_zap.ReplaceGrpcLogger(logger)
+	// The first server sets it, servers started later in the same process keep it.
+	replaceGrpcLogger.Do(func() {
+		grpc_zap.ReplaceGrpcLogger(log)
+	})
 
 	// Add unary interceptor
-	opts = append(opts, grpc_middleware.WithUnaryServerChain(
+	chain.Unary(
 		grpc_ctxtags.UnaryServerInterceptor(grpc_ctxtags.WithFieldExtractor(grpc_ctxtags.CodeGenRequestFieldExtractor)),
-		grpc_zap.UnaryServerInterceptor(logger, o...),
-	))
+		unaryRequestTags,
+		func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			l := log.Named(info.FullMethod)
+			ctx = withCallLogger(ctx, l, info.FullMethod)
+			return grpc_zap.UnaryServerInterceptor(l, o...)(ctx, req, info, handler)
+		},
+	)
 
-	// Add stream interceptor (added as an example here)
-	opts = append(opts, grpc_middleware.WithStreamServerChain(
+	// Add stream interceptor for streaming RPCs, e.g. Watch and Import
+	chain.Stream(
 		grpc_ctxtags.StreamServerInterceptor(grpc_ctxtags.WithFieldExtractor(grpc_ctxtags.CodeGenRequestFieldExtractor)),
-		grpc_zap.StreamServerInterceptor(logger, o...),
-	))
-
-	return opts
+		streamRequestTags,
+		func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			l := log.Named(info.FullMethod)
+			wrapped := grpc_middleware.WrapServerStream(ss)
+			wrapped.WrappedContext = withCallLogger(ss.Context(), l, info.FullMethod)
+			return grpc_zap.StreamServerInterceptor(l, o...)(srv, wrapped, info, handler)
+		},
+	)
 }
@@ -0,0 +1,106 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"go.smartmachine.io/go-grpc-api/pkg/logger"
+	"go.smartmachine.io/go-grpc-api/pkg/requestid"
+)
+
+// serverStream is server stream of the call with ctx
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context returns context of the call
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+// handlerFields returns fields of entry logged by service handler through logger.FromContext
+func handlerFields(t *testing.T, entries *observer.ObservedLogs) map[string]interface{} {
+	for _, e := range entries.All() {
+		if e.Message == "handled" {
+			return e.ContextMap()
+		}
+	}
+	t.Fatalf("handler entry not logged, got %+v", entries.All())
+	return nil
+}
+
+func TestAddLogging_CallLogger(t *testing.T) {
+	tests := []struct {
+		name          string
+		md            metadata.MD
+		wantRequestID string
+		wantCaller    string
+	}{
+		{
+			name: "Generated request ID",
+		},
+		{
+			name:          "Request ID forwarded by gateway",
+			md:            metadata.Pairs(requestid.MetadataKey, "rest-1", requestid.CallerMetadataKey, "alice"),
+			wantRequestID: "rest-1",
+			wantCaller:    "alice",
+		},
+	}
+	for _, tt := range tests {
+		for _, kind := range []string{"unary", "stream"} {
+			t.Run(tt.name+" "+kind, func(t *testing.T) {
+				core, entries := observer.New(zapcore.DebugLevel)
+				var chain Chain
+				AddLogging(zap.New(core), &chain)
+
+				ctx := metadata.NewIncomingContext(context.Background(), tt.md)
+				handled := func(ctx context.Context) {
+					logger.FromContext(ctx).Info("handled")
+				}
+				var err error
+				if kind == "unary" {
+					_, err = grpc_middleware.ChainUnaryServer(chain.unary...)(ctx, nil,
+						&grpc.UnaryServerInfo{FullMethod: "/v1.ToDoService/Create"},
+						func(ctx context.Context, req interface{}) (interface{}, error) {
+							handled(ctx)
+							return nil, nil
+						})
+				} else {
+					err = grpc_middleware.ChainStreamServer(chain.stream...)(nil, &serverStream{ctx: ctx},
+						&grpc.StreamServerInfo{FullMethod: "/v1.ToDoService/Create"},
+						func(srv interface{}, ss grpc.ServerStream) error {
+							handled(ss.Context())
+							return nil
+						})
+				}
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				fields := handlerFields(t, entries)
+				id, _ := fields[logger.FieldRequestID].(string)
+				if len(id) == 0 || (len(tt.wantRequestID) > 0 && id != tt.wantRequestID) {
+					t.Errorf("request ID = %q, want %q", id, tt.wantRequestID)
+				}
+				if fields["grpc.service"] != "v1.ToDoService" || fields["grpc.method"] != "Create" {
+					t.Errorf("method fields = %v, %v, want v1.ToDoService, Create", fields["grpc.service"], fields["grpc.method"])
+				}
+				caller, _ := fields[logger.FieldCallerID].(string)
+				if caller != tt.wantCaller {
+					t.Errorf("caller = %q, want %q", caller, tt.wantCaller)
+				}
+				if name := entries.All()[0].LoggerName; name != "/v1.ToDoService/Create" {
+					t.Errorf("logger name = %q, want method name", name)
+				}
+			})
+		}
+	}
+}
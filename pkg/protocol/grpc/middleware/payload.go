@@ -0,0 +1,60 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+
+	"go.smartmachine.io/go-grpc-api/pkg/logger"
+)
+
+// logPayload logs message with fields marked by (log.redact) option redacted
+func logPayload(ctx context.Context, msg string, key string, m interface{}, maxSize int) {
+	if pm, ok := m.(proto.Message); ok {
+		logger.FromContext(ctx).Info(msg, zap.String(key, logger.Payload(pm, maxSize)))
+	}
+}
+
+// payloadServerStream logs every message received and sent by the stream
+type payloadServerStream struct {
+	grpc.ServerStream
+	maxSize int
+}
+
+// SendMsg logs and sends message
+func (s *payloadServerStream) SendMsg(m interface{}) error {
+	err := s.ServerStream.SendMsg(m)
+	if err == nil {
+		logPayload(s.Context(), "server response payload", "grpc.response.content", m, s.maxSize)
+	}
+	return err
+}
+
+// RecvMsg receives and logs message
+func (s *payloadServerStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil {
+		logPayload(s.Context(), "server request payload", "grpc.request.content", m, s.maxSize)
+	}
+	return err
+}
+
+// AddPayloadLogging adds interceptors logging request and response payloads.
+// Values of fields marked with (log.redact) option are redacted
+// and payloads are truncated to maxSize bytes (0 means no limit).
+// It must be added after AddLogging so payloads are logged by call scoped logger.
+func AddPayloadLogging(maxSize int, chain *Chain) {
+	chain.Unary(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		logPayload(ctx, "server request payload", "grpc.request.content", req, maxSize)
+		resp, err := handler(ctx, req)
+		if err == nil {
+			logPayload(ctx, "server response payload", "grpc.response.content", resp, maxSize)
+		}
+		return resp, err
+	})
+	chain.Stream(func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &payloadServerStream{ServerStream: ss, maxSize: maxSize})
+	})
+}
@@ -0,0 +1,89 @@
+package middleware
+
+import (
+	"context"
+	"crypto/subtle"
+	"path"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"github.com/grpc-ecosystem/go-grpc-middleware/tags"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"go.smartmachine.io/go-grpc-api/pkg/logger"
+	"go.smartmachine.io/go-grpc-api/pkg/requestid"
+)
+
+// firstValue returns first value of the incoming metadata key
+func firstValue(md metadata.MD, key string) string {
+	if v := md.Get(key); len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
+
+// principal returns common name of verified TLS client certificate or identity authenticated
+// and forwarded by the gateway of this process, empty if the caller is not authenticated
+func principal(ctx context.Context, md metadata.MD) string {
+	if p, ok := peer.FromContext(ctx); ok {
+		if info, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+			if chains := info.State.VerifiedChains; len(chains) > 0 && len(chains[0]) > 0 {
+				return chains[0][0].Subject.CommonName
+			}
+		}
+	}
+	token := firstValue(md, requestid.GatewayMetadataKey)
+	if len(token) > 0 && subtle.ConstantTimeCompare([]byte(token), []byte(requestid.GatewayToken())) == 1 {
+		return firstValue(md, requestid.PrincipalMetadataKey)
+	}
+	return ""
+}
+
+// tagRequest sets request ID, caller identity and trace ID tags of the call.
+// Principal tag is set for authenticated callers only.
+// Request ID forwarded by HTTP/REST gateway is reused, new one is generated otherwise.
+func tagRequest(ctx context.Context) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	id := firstValue(md, requestid.MetadataKey)
+	if len(id) == 0 {
+		id = requestid.New()
+	}
+
+	tags := grpc_ctxtags.Extract(ctx)
+	tags.Set(logger.FieldRequestID, id)
+	if p := principal(ctx, md); len(p) > 0 {
+		tags.Set(logger.FieldPrincipal, p)
+		tags.Set(logger.FieldCallerID, p)
+	} else if caller := firstValue(md, requestid.CallerMetadataKey); len(caller) > 0 {
+		tags.Set(logger.FieldCallerID, caller)
+	}
+	if trace := requestid.TraceID(firstValue(md, requestid.TraceMetadataKey)); len(trace) > 0 {
+		tags.Set(logger.FieldTraceID, trace)
+	}
+}
+
+// unaryRequestTags tags unary call, must run after grpc_ctxtags interceptor
+func unaryRequestTags(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	tagRequest(ctx)
+	return handler(ctx, req)
+}
+
+// streamRequestTags tags stream call, must run after grpc_ctxtags interceptor
+func streamRequestTags(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	tagRequest(ss.Context())
+	return handler(srv, ss)
+}
+
+// withCallLogger returns copy of ctx holding call scoped logger with method and request tags,
+// so it is available to service handlers by logger.FromContext
+func withCallLogger(ctx context.Context, log *zap.Logger, fullMethod string) context.Context {
+	fields := append(ctxzap.TagsToFields(ctx),
+		zap.String("grpc.service", path.Dir(fullMethod)[1:]),
+		zap.String("grpc.method", path.Base(fullMethod)),
+	)
+	return logger.WithContext(ctx, log.With(fields...))
+}
@@ -0,0 +1,90 @@
+package middleware
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net"
+	"testing"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware"
+	"github.com/grpc-ecosystem/go-grpc-middleware/tags"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"go.smartmachine.io/go-grpc-api/pkg/logger"
+	"go.smartmachine.io/go-grpc-api/pkg/requestid"
+)
+
+// callTags runs unary call of ctx through logging chain and returns tags seen by the handler
+func callTags(t *testing.T, ctx context.Context) map[string]interface{} {
+	var chain Chain
+	AddLogging(zap.NewNop(), &chain)
+	var values map[string]interface{}
+	_, err := grpc_middleware.ChainUnaryServer(chain.unary...)(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/v1.ToDoService/Create"},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			values = grpc_ctxtags.Extract(ctx).Values()
+			return nil, nil
+		})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return values
+}
+
+func TestTagRequest_Principal(t *testing.T) {
+	tlsPeer := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{}, AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{
+		VerifiedChains: [][]*x509.Certificate{{{Subject: pkix.Name{CommonName: "carol"}}}},
+	}}})
+
+	tests := []struct {
+		name          string
+		ctx           context.Context
+		md            metadata.MD
+		wantPrincipal string
+		wantCaller    string
+	}{
+		{
+			name:       "Declared caller",
+			ctx:        context.Background(),
+			md:         metadata.Pairs(requestid.CallerMetadataKey, "alice"),
+			wantCaller: "alice",
+		},
+		{
+			name:          "TLS client certificate",
+			ctx:           tlsPeer,
+			md:            metadata.Pairs(requestid.CallerMetadataKey, "alice"),
+			wantPrincipal: "carol",
+			wantCaller:    "carol",
+		},
+		{
+			name: "Principal forwarded by gateway",
+			ctx:  context.Background(),
+			md: metadata.Pairs(requestid.PrincipalMetadataKey, "carol",
+				requestid.GatewayMetadataKey, requestid.GatewayToken()),
+			wantPrincipal: "carol",
+			wantCaller:    "carol",
+		},
+		{
+			name: "Forged principal",
+			ctx:  context.Background(),
+			md: metadata.Pairs(requestid.PrincipalMetadataKey, "carol",
+				requestid.GatewayMetadataKey, "guess", requestid.CallerMetadataKey, "mallory"),
+			wantCaller: "mallory",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values := callTags(t, metadata.NewIncomingContext(tt.ctx, tt.md))
+			principal, _ := values[logger.FieldPrincipal].(string)
+			caller, _ := values[logger.FieldCallerID].(string)
+			if principal != tt.wantPrincipal || caller != tt.wantCaller {
+				t.Errorf("principal = %q, caller = %q, want %q, %q", principal, caller, tt.wantPrincipal, tt.wantCaller)
+			}
+		})
+	}
+}
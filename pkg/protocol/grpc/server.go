@@ -7,28 +7,61 @@ import (
 	"net"
 	"os"
 	"os/signal"
+	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/keepalive"
 
 	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
 )
 
-// RunServer runs gRPC service to publish ToDo service
-func RunServer(ctx context.Context, v1API v1.ToDoServiceServer, port string) error {
-	listen, err := net.Listen("tcp", ":"+port)
-	if err != nil {
-		return err
-	}
+// Config is configuration of gRPC server middleware
+type Config struct {
+	// AdminToken is bearer token required by Admin service, empty token disables it
+	AdminToken string
 
+	// LogPayload turns on logging of request/response payloads
+	LogPayload bool
+	// LogPayloadMaxSize is size in bytes logged payloads are truncated to, 0 means no limit
+	LogPayloadMaxSize int
+}
+
+// NewServer creates gRPC server publishing ToDo and Admin services
+func NewServer(v1API v1.ToDoServiceServer, adminAPI v1.AdminServiceServer, cfg Config) *grpc.Server {
 	// gRPC server statup options
-	opts := []grpc.ServerOption{}
+	opts := []grpc.ServerOption{
+		// accept keepalive pings of pkg/client sent every 30 seconds
+		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             10 * time.Second,
+			PermitWithoutStream: true,
+		}),
+	}
 
 	// add middleware
-	opts = middleware.AddLogging(logger.Log, opts)
+	var chain middleware.Chain
+	middleware.AddLogging(logger.Log, &chain)
+	// admin calls are authenticated before their payloads are logged
+	middleware.AddAdminAuth(cfg.AdminToken, &chain)
+	if cfg.LogPayload {
+		middleware.AddPayloadLogging(cfg.LogPayloadMaxSize, &chain)
+	}
+	opts = chain.ServerOptions(opts)
 
 	// register service
 	server := grpc.NewServer(opts...)
 	v1.RegisterToDoServiceServer(server, v1API)
+	v1.RegisterAdminServiceServer(server, adminAPI)
+	return server
+}
+
+// RunServer runs gRPC service to publish ToDo and Admin services
+func RunServer(ctx context.Context, v1API v1.ToDoServiceServer, adminAPI v1.AdminServiceServer, port string, cfg Config) error {
+	listen, err := net.Listen("tcp", ":"+port)
+	if err != nil {
+		return err
+	}
+
+	server := NewServer(v1API, adminAPI, cfg)
 
 	// graceful shutdown
 	c := make(chan os.Signal, 1)
@@ -47,4 +80,4 @@ func RunServer(ctx context.Context, v1API v1.ToDoServiceServer, port string) err
 	// start gRPC server
 	logger.Log.Info("starting gRPC server...")
 	return server.Serve(listen)
-}
\ No newline at end of file
+}
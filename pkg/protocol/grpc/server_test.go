@@ -0,0 +1,46 @@
+package grpc_test
+
+import (
+	"context"
+	"testing"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
+	"go.smartmachine.io/go-grpc-api/pkg/logger"
+	"go.smartmachine.io/go-grpc-api/pkg/testing/todotest"
+)
+
+func TestNewServer_AdminAuthBeforePayloadLogging(t *testing.T) {
+	core, entries := observer.New(zapcore.DebugLevel)
+	saved := logger.Log
+	logger.Log = zap.New(core)
+	defer func() {
+		logger.Log = saved
+	}()
+
+	srv := todotest.Start(t, todotest.Config{AdminToken: "secret", LogPayload: true})
+	defer srv.Close()
+
+	ctx := context.Background()
+	req := &v1.CreateWebhookRequest{Webhook: &v1.Webhook{Url: "http://example.com/unauthenticated"}}
+	if _, err := srv.Admin.CreateWebhook(ctx, req); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("CreateWebhook() without token error = %v, want Unauthenticated", err)
+	}
+	if logged := entries.FilterMessage("server request payload").Len(); logged > 0 {
+		t.Errorf("payload of unauthenticated call logged %d times", logged)
+	}
+
+	ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "bearer secret")
+	if _, err := srv.Admin.ListWebhooks(ctx, &v1.ListWebhooksRequest{}); err != nil {
+		t.Fatalf("ListWebhooks() error = %v", err)
+	}
+	if logged := entries.FilterMessage("server request payload").Len(); logged != 1 {
+		t.Errorf("payload of authenticated call logged %d times, want 1", logged)
+	}
+}
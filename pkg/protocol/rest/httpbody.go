@@ -0,0 +1,158 @@
+package rest
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+)
+
+const (
+	// contentTypeMetadataKey is header metadata key server streaming google.api.HttpBody sets
+	// content type of response with
+	contentTypeMetadataKey = "x-content-type"
+	// contentDispositionMetadataKey is header metadata key passed as Content-Disposition header
+	contentDispositionMetadataKey = "content-disposition"
+
+	// DefaultMaxRequestSize is default size in bytes request bodies are limited to
+	DefaultMaxRequestSize = 32 << 20
+)
+
+// streamMarshaler is default marshaler of gateway. It marshals messages as JSON,
+// except google.api.HttpBody messages and streams of them which are written as raw response body.
+// google.api.HttpBody request body, e.g. uploaded file, is read as raw request body too.
+// Gateway writes delimiter after every stream message, so JSON stream messages
+// end with newline here and HttpBody chunks are written as is.
+type streamMarshaler struct {
+	runtime.JSONPb
+}
+
+// Delimiter is empty, messages are delimited by Marshal
+func (m *streamMarshaler) Delimiter() []byte {
+	return nil
+}
+
+// Marshal marshals message or stream chunk
+func (m *streamMarshaler) Marshal(v interface{}) ([]byte, error) {
+	if body, ok := v.(*httpbody.HttpBody); ok {
+		return body.Data, nil
+	}
+	chunk, ok := v.(map[string]proto.Message)
+	if !ok {
+		return m.JSONPb.Marshal(v)
+	}
+	if body, ok := chunk["result"].(*httpbody.HttpBody); ok {
+		return body.Data, nil
+	}
+	b, err := m.JSONPb.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := chunk["result"]; ok {
+		b = append(b, '\n')
+	}
+	return b, nil
+}
+
+// NewDecoder returns decoder of request body
+func (m *streamMarshaler) NewDecoder(r io.Reader) runtime.Decoder {
+	// JSON decoder is shared, client stream is decoded by one decoder
+	dec := m.JSONPb.NewDecoder(r)
+	return runtime.DecoderFunc(func(v interface{}) error {
+		// body field of request is decoded by pointer to the field
+		field, ok := v.(**httpbody.HttpBody)
+		if !ok {
+			return dec.Decode(v)
+		}
+		data, err := ioutil.ReadAll(r)
+		if err != nil {
+			return err
+		}
+		*field = &httpbody.HttpBody{Data: data}
+		return nil
+	})
+}
+
+// limitedBody is request body failing reads beyond its size limit
+type limitedBody struct {
+	io.ReadCloser
+	maxSize int64
+	read    int64
+}
+
+// Read reads body, error of read beyond limit tells the limit
+func (b *limitedBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.read += int64(n)
+	if err != nil && err != io.EOF && b.read >= b.maxSize {
+		err = fmt.Errorf("request body is larger than %d bytes", b.maxSize)
+	}
+	return n, err
+}
+
+// limitBody limits size of request bodies, e.g. uploaded files, to maxSize bytes, 0 means no limit.
+// Gateway fails decoding of larger body with InvalidArgument.
+func limitBody(maxSize int64, h http.Handler) http.Handler {
+	if maxSize <= 0 {
+		return h
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Body != nil {
+			r.Body = &limitedBody{ReadCloser: http.MaxBytesReader(w, r.Body, maxSize), maxSize: maxSize}
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
+// uploadFormats are import formats of uploaded files by media type
+var uploadFormats = map[string]string{
+	"text/csv":      "csv",
+	"text/calendar": "ics",
+}
+
+// routeFileUpload passes CSV and iCalendar files uploaded to /v1/todo:import to ImportFile,
+// Import of the same route reads JSON stream only. Format of uploaded file is taken from its content type
+// unless format query parameter is set.
+func routeFileUpload(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || (r.URL.Path != "/v1/todo:import" && r.URL.Path != "/v1/todo:importFile") {
+			h.ServeHTTP(w, r)
+			return
+		}
+		mt, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		format, ok := uploadFormats[mt]
+		if !ok {
+			h.ServeHTTP(w, r)
+			return
+		}
+
+		u := *r.URL
+		u.Path = "/v1/todo:importFile"
+		query := u.Query()
+		if len(query.Get("format")) == 0 {
+			query.Set("format", format)
+			u.RawQuery = query.Encode()
+		}
+		r2 := new(http.Request)
+		*r2 = *r
+		r2.URL = &u
+		h.ServeHTTP(w, r2)
+	})
+}
+
+// outgoingHeader maps header metadata to HTTP headers.
+// Content type of HttpBody stream is set by startStream instead.
+func outgoingHeader(key string) (string, bool) {
+	switch key {
+	case contentTypeMetadataKey:
+		return "", false
+	case contentDispositionMetadataKey:
+		return "Content-Disposition", true
+	}
+	return runtime.MetadataHeaderPrefix + key, true
+}
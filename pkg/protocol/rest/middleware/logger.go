@@ -6,10 +6,62 @@ import (
 	"time"
 
 	"go.uber.org/zap"
+
+	"go.smartmachine.io/go-grpc-api/pkg/logger"
+	"go.smartmachine.io/go-grpc-api/pkg/requestid"
 )
 
-// AddLogger logs request/response pair
-func AddLogger(logger *zap.Logger, h http.Handler) http.Handler {
+// statusRecorder remembers status code written by the wrapped handler
+type statusRecorder struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+}
+
+// WriteHeader records status code and passes it to the wrapped writer.
+// Status written after headers were sent, e.g. by stream failing after flush, is ignored.
+func (r *statusRecorder) WriteHeader(status int) {
+	if r.wroteHeader {
+		return
+	}
+	r.wroteHeader = true
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// Write sends body, headers are sent with status OK if not sent yet
+func (r *statusRecorder) Write(b []byte) (int, error) {
+	r.wroteHeader = true
+	return r.ResponseWriter.Write(b)
+}
+
+// Flush passes flush to the wrapped writer if supported
+func (r *statusRecorder) Flush() {
+	r.wroteHeader = true
+	if f, ok := r.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Principal returns common name of verified TLS client certificate, empty if the caller is not authenticated
+func Principal(r *http.Request) string {
+	if r.TLS != nil && len(r.TLS.VerifiedChains) > 0 && len(r.TLS.VerifiedChains[0]) > 0 {
+		return r.TLS.VerifiedChains[0][0].Subject.CommonName
+	}
+	return ""
+}
+
+// CallerID returns common name of verified TLS client certificate or caller identity passed in X-Caller-Id header
+func CallerID(r *http.Request) string {
+	if principal := Principal(r); len(principal) > 0 {
+		return principal
+	}
+	return r.Header.Get(requestid.CallerMetadataKey)
+}
+
+// AddLogger logs request/response pair.
+// Request scoped logger is available to handlers by logger.FromContext.
+func AddLogger(log *zap.Logger, h http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
 
@@ -30,37 +82,38 @@ func AddLogger(logger *zap.Logger, h http.Handler) http.Handler {
 		} else {
 			scheme = "http"
 		}
-		proto := r.Proto
-		method := r.Method
-		remoteAddr := r.RemoteAddr
-		userAgent := r.UserAgent()
 		uri := strings.Join([]string{scheme, "://", r.Host, r.RequestURI}, "")
 
+		fields := []zap.Field{
+			zap.String(logger.FieldSystem, "http"),
+			zap.String(logger.FieldSpanKind, "server"),
+			zap.String(logger.FieldRequestID, id),
+			zap.String(logger.FieldHTTPScheme, scheme),
+			zap.String(logger.FieldHTTPProto, r.Proto),
+			zap.String(logger.FieldHTTPMethod, r.Method),
+			zap.String(logger.FieldPeerAddress, r.RemoteAddr),
+			zap.String(logger.FieldHTTPUserAgent, r.UserAgent()),
+			zap.String(logger.FieldHTTPURI, uri),
+		}
+		if caller := CallerID(r); len(caller) > 0 {
+			fields = append(fields, zap.String(logger.FieldCallerID, caller))
+		}
+		if trace := requestid.TraceID(r.Header.Get(requestid.TraceMetadataKey)); len(trace) > 0 {
+			fields = append(fields, zap.String(logger.FieldTraceID, trace))
+		}
+
 		// Log HTTP request
-		logger.Info("request started",
-			zap.String("request-id", id),
-			zap.String("http-scheme", scheme),
-			zap.String("http-proto", proto),
-			zap.String("http-method", method),
-			zap.String("remote-addr", remoteAddr),
-			zap.String("user-agent", userAgent),
-			zap.String("uri", uri),
-		)
+		log.Info("request started", fields...)
 
 		t1 := time.Now()
 
-		h.ServeHTTP(w, r)
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		h.ServeHTTP(rec, r.WithContext(logger.WithContext(ctx, log.With(fields...))))
 
 		// Log HTTP response
-		logger.Info("request completed",
-			zap.String("request-id", id),
-			zap.String("http-scheme", scheme),
-			zap.String("http-proto", proto),
-			zap.String("http-method", method),
-			zap.String("remote-addr", remoteAddr),
-			zap.String("user-agent", userAgent),
-			zap.String("uri", uri),
-			zap.Float64("elapsed-ms", float64(time.Since(t1).Nanoseconds())/1000000.0),
-		)
+		log.Info("request completed", append(fields,
+			zap.Int(logger.FieldHTTPStatus, rec.status),
+			logger.Duration(time.Since(t1)),
+		)...)
 	})
-}
\ No newline at end of file
+}
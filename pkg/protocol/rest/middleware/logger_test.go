@@ -0,0 +1,83 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+
+	"go.smartmachine.io/go-grpc-api/pkg/logger"
+	"go.smartmachine.io/go-grpc-api/pkg/requestid"
+)
+
+func TestAddLogger_RequestLogger(t *testing.T) {
+	tests := []struct {
+		name       string
+		header     http.Header
+		wantCaller string
+		wantTrace  string
+	}{
+		{
+			name: "Anonymous",
+		},
+		{
+			name: "Declared caller and trace",
+			header: http.Header{
+				"X-Caller-Id": {"alice"},
+				"Traceparent": {"00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01"},
+			},
+			wantCaller: "alice",
+			wantTrace:  "0af7651916cd43dd8448eb211c80319c",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			core, entries := observer.New(zapcore.DebugLevel)
+			var fields map[string]interface{}
+			handler := AddRequestID(AddLogger(zap.New(core), http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				logger.FromContext(r.Context()).Info("handled")
+				fields = entries.FilterMessage("handled").All()[0].ContextMap()
+				w.WriteHeader(http.StatusTeapot)
+			})))
+
+			r := httptest.NewRequest(http.MethodGet, "/v1/todo/all", nil)
+			for k, v := range tt.header {
+				r.Header[k] = v
+			}
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+
+			id := w.Header().Get(RequestIDHeader)
+			if len(id) == 0 || fields[logger.FieldRequestID] != id {
+				t.Errorf("request ID = %v, want %q of response header", fields[logger.FieldRequestID], id)
+			}
+			if fields[logger.FieldHTTPMethod] != http.MethodGet || fields[logger.FieldHTTPURI] != "http://example.com/v1/todo/all" {
+				t.Errorf("method fields = %v, %v", fields[logger.FieldHTTPMethod], fields[logger.FieldHTTPURI])
+			}
+			caller, _ := fields[logger.FieldCallerID].(string)
+			trace, _ := fields[logger.FieldTraceID].(string)
+			if caller != tt.wantCaller || trace != tt.wantTrace {
+				t.Errorf("caller = %q, trace = %q, want %q, %q", caller, trace, tt.wantCaller, tt.wantTrace)
+			}
+
+			completed := entries.FilterMessage("request completed").All()
+			if len(completed) != 1 || completed[0].ContextMap()[logger.FieldHTTPStatus] != int64(http.StatusTeapot) {
+				t.Errorf("request completed entries = %+v, want status %d", completed, http.StatusTeapot)
+			}
+		})
+	}
+}
+
+func TestCallerID(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set(requestid.CallerMetadataKey, "alice")
+	if got := CallerID(r); got != "alice" {
+		t.Errorf("CallerID() = %q, want declared caller", got)
+	}
+	if got := Principal(r); got != "" {
+		t.Errorf("Principal() = %q, want empty for declared caller", got)
+	}
+}
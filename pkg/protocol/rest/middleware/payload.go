@@ -0,0 +1,116 @@
+package middleware
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"go.smartmachine.io/go-grpc-api/pkg/logger"
+)
+
+const (
+	// payloadCaptureLimit is the largest payload captured for logging.
+	// Redaction needs the complete JSON document, so larger payloads are logged by size only.
+	payloadCaptureLimit = 1 << 20
+
+	// adminPathPrefix is prefix of admin service routes, admin token of their calls is checked by gRPC server
+	adminPathPrefix = "/v1/admin/"
+)
+
+// payloadRecorder captures response status and body written by the wrapped handler
+type payloadRecorder struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+	size   int
+}
+
+// WriteHeader captures and writes response status
+func (r *payloadRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// Write captures and writes response body
+func (r *payloadRecorder) Write(b []byte) (int, error) {
+	r.size += len(b)
+	if r.body.Len()+len(b) <= payloadCaptureLimit {
+		r.body.Write(b)
+	}
+	return r.ResponseWriter.Write(b)
+}
+
+// Flush passes flush to the wrapped writer if supported
+func (r *payloadRecorder) Flush() {
+	if f, ok := r.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// content returns captured body for logging
+func (r *payloadRecorder) content(redactor *logger.JSONRedactor, maxSize int) string {
+	if r.size > payloadCaptureLimit {
+		return fmt.Sprintf("[%d bytes]", r.size)
+	}
+	return redactor.Payload(r.body.Bytes(), maxSize)
+}
+
+// AddPayloadLogger logs request and response bodies.
+// Properties named like fields marked with (log.redact) option are redacted
+// and payloads are truncated to maxSize bytes (0 means no limit).
+// Like in gRPC, payloads of admin calls are logged only if the admin token is accepted.
+// It must be wrapped by AddLogger so payloads are logged by request scoped logger.
+func AddPayloadLogger(redactor *logger.JSONRedactor, maxSize int, h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		log := logger.FromContext(r.Context())
+
+		var body []byte
+		if r.Body != nil {
+			// read at most payloadCaptureLimit+1 bytes and put them back in front of the rest of the body
+			var err error
+			body, err = ioutil.ReadAll(io.LimitReader(r.Body, payloadCaptureLimit+1))
+			if err != nil {
+				http.Error(w, "failed to read request body", http.StatusBadRequest)
+				return
+			}
+			r.Body = struct {
+				io.Reader
+				io.Closer
+			}{io.MultiReader(bytes.NewReader(body), r.Body), r.Body}
+		}
+
+		// request payload of admin call is logged after gRPC server checked its admin token
+		admin := strings.HasPrefix(r.URL.Path, adminPathPrefix)
+		if !admin {
+			logRequest(log, redactor, maxSize, body)
+		}
+
+		rec := &payloadRecorder{ResponseWriter: w, status: http.StatusOK}
+		h.ServeHTTP(rec, r)
+
+		if admin {
+			// Unauthenticated and PermissionDenied of admin token check
+			if rec.status == http.StatusUnauthorized || rec.status == http.StatusForbidden {
+				return
+			}
+			logRequest(log, redactor, maxSize, body)
+		}
+		if rec.size > 0 {
+			log.Info("response payload", zap.String("http.response.content", rec.content(redactor, maxSize)))
+		}
+	})
+}
+
+// logRequest logs captured request body
+func logRequest(log *zap.Logger, redactor *logger.JSONRedactor, maxSize int, body []byte) {
+	if len(body) > payloadCaptureLimit {
+		log.Info("request payload", zap.String("http.request.content", fmt.Sprintf("[more than %d bytes]", payloadCaptureLimit)))
+	} else if len(body) > 0 {
+		log.Info("request payload", zap.String("http.request.content", redactor.Payload(body, maxSize)))
+	}
+}
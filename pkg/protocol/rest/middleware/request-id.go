@@ -2,16 +2,10 @@ package middleware
 
 import (
 	"context"
-	"crypto/rand"
-	"encoding/base64"
-	"fmt"
 	"net/http"
-	"os"
-	"strings"
-	"sync/atomic"
-)
 
-// Code is taken from: https://github.com/go-chi/chi/blob/master/middleware/request_id.go
+	"go.smartmachine.io/go-grpc-api/pkg/requestid"
+)
 
 // Key to use when setting the request ID.
 type ctxKeyRequestID int
@@ -19,41 +13,18 @@ type ctxKeyRequestID int
 // RequestIDKey is the key that holds th unique request ID in a request context.
 const RequestIDKey ctxKeyRequestID = 0
 
-var (
-	// prefix is const prefix for request ID
-	prefix string
-
-	// reqID is counter for request ID
-	reqID uint64
-)
-
-// init Initializes constant part of request ID
-func init() {
-	hostname, err := os.Hostname()
-	if hostname == "" || err != nil {
-		hostname = "localhost"
-	}
-	var buf [12]byte
-	var b64 string
-	for len(b64) < 10 {
-		_, _ = rand.Read(buf[:])
-		b64 = base64.StdEncoding.EncodeToString(buf[:])
-		b64 = strings.NewReplacer("+", "", "/", "").Replace(b64)
-	}
-
-	prefix = fmt.Sprintf("%s/%s", hostname, b64[0:10])
-}
+// RequestIDHeader is HTTP header returning request ID to the caller
+const RequestIDHeader = "X-Request-Id"
 
 // RequestID is a middleware that injects a request ID into the context of each
-// request. A request ID is a string of the form "host.example.com/random-0001",
-// where "random" is a base62 random string that uniquely identifies this go
-// process, and where the last number is an atomically incremented request
-// counter.
+// request and returns it to the caller in X-Request-Id response header.
+// See requestid.New for the format of request ID.
 func AddRequestID(h http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		myid := atomic.AddUint64(&reqID, 1)
+		id := requestid.New()
 		ctx := r.Context()
-		ctx = context.WithValue(ctx, RequestIDKey, fmt.Sprintf("%s-%06d", prefix, myid))
+		ctx = context.WithValue(ctx, RequestIDKey, id)
+		w.Header().Set(RequestIDHeader, id)
 		h.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
@@ -4,32 +4,114 @@ import (
 	"context"
 	"go.smartmachine.io/go-grpc-api/pkg/logger"
 	"go.smartmachine.io/go-grpc-api/pkg/protocol/rest/middleware"
+	"go.smartmachine.io/go-grpc-api/pkg/requestid"
 	"go.uber.org/zap"
 	"net/http"
 	"os"
 	"os/signal"
 	"time"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 
 	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
 )
 
+// forwardRequest passes request ID, caller identity and trace context to gRPC server
+// so calls made through the gateway are logged with the same request ID.
+// Authenticated identity is passed with gateway token, so gRPC server can tell it from one declared by caller.
+func forwardRequest(ctx context.Context, r *http.Request) metadata.MD {
+	md := metadata.Pairs(requestid.MetadataKey, middleware.GetReqID(ctx))
+	if caller := middleware.CallerID(r); len(caller) > 0 {
+		md.Set(requestid.CallerMetadataKey, caller)
+	}
+	if principal := middleware.Principal(r); len(principal) > 0 {
+		md.Set(requestid.PrincipalMetadataKey, principal)
+		md.Set(requestid.GatewayMetadataKey, requestid.GatewayToken())
+	}
+	if trace := r.Header.Get(requestid.TraceMetadataKey); len(trace) > 0 {
+		md.Set(requestid.TraceMetadataKey, trace)
+	}
+	// reconnecting server-sent events client resumes Watch after last received event
+	if id := r.Header.Get("Last-Event-ID"); len(id) > 0 {
+		md.Set("last-event-id", id)
+	}
+	return md
+}
+
+// startStream sends response headers as soon as server stream is open,
+// so clients know the call succeeded before the first message, e.g. before the first change is watched.
+// Content type of google.api.HttpBody stream is taken from header metadata.
+// Failed calls end with trailers only, so headers are sent only if server sent header metadata.
+func startStream(ctx context.Context, w http.ResponseWriter, resp proto.Message) error {
+	// stream forwarding calls options with nil message before the first message
+	if resp != nil {
+		return nil
+	}
+	md, ok := runtime.ServerMetadataFromContext(ctx)
+	if !ok || len(md.HeaderMD) == 0 {
+		return nil
+	}
+	if ct := md.HeaderMD.Get(contentTypeMetadataKey); len(ct) > 0 {
+		w.Header().Set("Content-Type", ct[0])
+	}
+	if f, ok := w.(http.Flusher); ok {
+		f.Flush()
+	}
+	return nil
+}
+
+// Config is configuration of HTTP/REST gateway middleware
+type Config struct {
+	// LogPayload turns on logging of request/response payloads
+	LogPayload bool
+	// LogPayloadMaxSize is size in bytes logged payloads are truncated to, 0 means no limit
+	LogPayloadMaxSize int
+	// MaxRequestSize is size in bytes request bodies, e.g. uploaded files, are limited to, 0 means no limit
+	MaxRequestSize int64
+}
+
+// NewHandler creates HTTP/REST gateway handler calling gRPC server at endpoint.
+// Connections are closed when ctx is done.
+func NewHandler(ctx context.Context, endpoint string, opts []grpc.DialOption, cfg Config) (http.Handler, error) {
+	mux := runtime.NewServeMux(
+		runtime.WithMetadata(forwardRequest),
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &streamMarshaler{runtime.JSONPb{OrigName: true}}),
+		runtime.WithMarshalerOption(eventStreamContentType, &eventStreamMarshaler{runtime.JSONPb{OrigName: true}}),
+		runtime.WithOutgoingHeaderMatcher(outgoingHeader),
+		runtime.WithForwardResponseOption(startStream),
+	)
+	if err := v1.RegisterToDoServiceHandlerFromEndpoint(ctx, mux, endpoint, opts); err != nil {
+		return nil, err
+	}
+	if err := v1.RegisterAdminServiceHandlerFromEndpoint(ctx, mux, endpoint, opts); err != nil {
+		return nil, err
+	}
+
+	var handler http.Handler = routeFileUpload(mux)
+	if cfg.LogPayload {
+		redactor := logger.NewJSONRedactor(&v1.ToDo{}, &v1.LogLevel{})
+		handler = middleware.AddPayloadLogger(redactor, cfg.LogPayloadMaxSize, handler)
+	}
+	handler = limitBody(cfg.MaxRequestSize, handler)
+	return middleware.AddRequestID(middleware.AddLogger(logger.Log.Named("rest"), handler)), nil
+}
+
 // RunServer runs HTTP/REST gateway
-func RunServer(ctx context.Context, grpcPort, httpPort string) error {
+func RunServer(ctx context.Context, grpcPort, httpPort string, cfg Config) error {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	mux := runtime.NewServeMux()
-	opts := []grpc.DialOption{grpc.WithInsecure()}
-	if err := v1.RegisterToDoServiceHandlerFromEndpoint(ctx, mux, "localhost:"+grpcPort, opts); err != nil {
+	handler, err := NewHandler(ctx, "localhost:"+grpcPort, []grpc.DialOption{grpc.WithInsecure()}, cfg)
+	if err != nil {
 		logger.Log.Fatal("failed to start HTTP gateway", zap.String("reason", err.Error()))
 	}
 
 	srv := &http.Server{
 		Addr:    ":" + httpPort,
-		Handler: middleware.AddRequestID(middleware.AddLogger(logger.Log, mux)),
+		Handler: handler,
 	}
 
 	// graceful shutdown
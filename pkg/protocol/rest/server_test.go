@@ -0,0 +1,60 @@
+package rest_test
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+
+	"go.smartmachine.io/go-grpc-api/pkg/logger"
+	"go.smartmachine.io/go-grpc-api/pkg/testing/todotest"
+)
+
+func TestNewHandler_AdminAuthBeforePayloadLogging(t *testing.T) {
+	core, entries := observer.New(zapcore.DebugLevel)
+	saved := logger.Log
+	logger.Log = zap.New(core)
+	defer func() {
+		logger.Log = saved
+	}()
+
+	srv := todotest.Start(t, todotest.Config{AdminToken: "secret", LogPayload: true})
+	defer srv.Close()
+
+	call := func(token string) int {
+		r, err := http.NewRequest(http.MethodPost, srv.URL+"/v1/admin/webhooks",
+			strings.NewReader(`{"webhook":{"url":"http://example.com/hook"}}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(token) > 0 {
+			r.Header.Set("Authorization", "Bearer "+token)
+		}
+		resp, err := http.DefaultClient.Do(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+	payloads := func() int {
+		return entries.FilterMessage("request payload").Len() + entries.FilterMessage("response payload").Len()
+	}
+
+	if code := call("wrong"); code != http.StatusUnauthorized {
+		t.Fatalf("CreateWebhook with wrong token status = %d, want %d", code, http.StatusUnauthorized)
+	}
+	if logged := payloads(); logged > 0 {
+		t.Errorf("payloads of unauthenticated call logged %d times", logged)
+	}
+
+	if code := call("secret"); code != http.StatusOK {
+		t.Fatalf("CreateWebhook status = %d, want %d", code, http.StatusOK)
+	}
+	if logged := payloads(); logged != 2 {
+		t.Errorf("payloads of authenticated call logged %d times, want 2", logged)
+	}
+}
@@ -0,0 +1,61 @@
+package rest
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+
+	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
+)
+
+// eventStreamContentType is media type of server-sent events
+const eventStreamContentType = "text/event-stream"
+
+// eventStreamMarshaler renders Watch stream as server-sent events
+// (https://html.spec.whatwg.org/multipage/server-sent-events.html) for requests accepting text/event-stream.
+// Every change is sent as event named by its type with sequence number as event ID,
+// so reconnecting EventSource resumes by Last-Event-ID header.
+// Messages other than stream chunks are marshaled as JSON.
+type eventStreamMarshaler struct {
+	runtime.JSONPb
+}
+
+// ContentType returns media type of server-sent events
+func (m *eventStreamMarshaler) ContentType() string {
+	return eventStreamContentType
+}
+
+// Delimiter ends event with empty line
+func (m *eventStreamMarshaler) Delimiter() []byte {
+	return []byte("\n")
+}
+
+// Marshal renders stream chunk as event
+func (m *eventStreamMarshaler) Marshal(v interface{}) ([]byte, error) {
+	chunk, ok := v.(map[string]proto.Message)
+	if !ok {
+		return m.JSONPb.Marshal(v)
+	}
+
+	var buf bytes.Buffer
+	if res, ok := chunk["result"].(*v1.WatchResponse); ok {
+		data, err := m.JSONPb.Marshal(res.Event)
+		if err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(&buf, "id: %d\nevent: %s\ndata: %s\n", res.Event.GetSequence(), res.Event.GetType(), data)
+		return buf.Bytes(), nil
+	}
+	if serr, ok := chunk["error"]; ok {
+		data, err := m.JSONPb.Marshal(serr)
+		if err != nil {
+			return nil, err
+		}
+		// gateway writes no delimiter after error, so event is ended here
+		fmt.Fprintf(&buf, "event: error\ndata: %s\n\n", data)
+		return buf.Bytes(), nil
+	}
+	return m.JSONPb.Marshal(v)
+}
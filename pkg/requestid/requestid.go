@@ -0,0 +1,86 @@
+package requestid
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"strings"
+	"sync/atomic"
+)
+
+// Code is taken from: https://github.com/go-chi/chi/blob/master/middleware/request_id.go
+
+var (
+	// prefix is const prefix for request ID
+	prefix string
+
+	// reqID is counter for request ID
+	reqID uint64
+
+	// gatewayToken is random token of this process, see GatewayToken
+	gatewayToken string
+)
+
+// init Initializes constant part of request ID
+func init() {
+	hostname, err := os.Hostname()
+	if hostname == "" || err != nil {
+		hostname = "localhost"
+	}
+	var buf [12]byte
+	var b64 string
+	for len(b64) < 10 {
+		_, _ = rand.Read(buf[:])
+		b64 = base64.StdEncoding.EncodeToString(buf[:])
+		b64 = strings.NewReplacer("+", "", "/", "").Replace(b64)
+	}
+
+	prefix = fmt.Sprintf("%s/%s", hostname, b64[0:10])
+
+	if _, err := rand.Read(buf[:]); err != nil {
+		panic(fmt.Sprintf("failed to generate gateway token: %v", err))
+	}
+	gatewayToken = base64.RawURLEncoding.EncodeToString(buf[:])
+}
+
+// GatewayToken returns random token of this process. Gateway sends it with principal,
+// so gRPC server trusts principal forwarded by its own gateway only.
+func GatewayToken() string {
+	return gatewayToken
+}
+
+// New returns new request ID. A request ID is a string of the form "host.example.com/random-0001",
+// where "random" is a base62 random string that uniquely identifies this go
+// process, and where the last number is an atomically incremented request
+// counter.
+func New() string {
+	myid := atomic.AddUint64(&reqID, 1)
+	return fmt.Sprintf("%s-%06d", prefix, myid)
+}
+
+// Request identification passed from HTTP/REST gateway to gRPC server as gRPC metadata.
+// The keys are HTTP header names as well.
+const (
+	// MetadataKey is key of request ID
+	MetadataKey = "x-request-id"
+	// CallerMetadataKey is key of caller identity for callers not authenticated by TLS client certificate,
+	// the identity is declared by the caller and it is not verified
+	CallerMetadataKey = "x-caller-id"
+	// PrincipalMetadataKey is key of identity the gateway authenticated by TLS client certificate
+	PrincipalMetadataKey = "x-principal"
+	// GatewayMetadataKey is key of token proving principal was forwarded by the gateway of this process
+	GatewayMetadataKey = "x-gateway-token"
+	// TraceMetadataKey is key of W3C trace context, see https://www.w3.org/TR/trace-context/
+	TraceMetadataKey = "traceparent"
+)
+
+// TraceID returns trace ID from W3C traceparent value "version-traceid-parentid-flags".
+// Returns the empty string if traceparent is malformed.
+func TraceID(traceparent string) string {
+	parts := strings.Split(strings.TrimSpace(traceparent), "-")
+	if len(parts) < 4 || len(parts[1]) != 32 {
+		return ""
+	}
+	return parts[1]
+}
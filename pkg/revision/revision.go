@@ -0,0 +1,115 @@
+// Package revision keeps revision history of todo tasks.
+//
+// Every change leaving task in new state is recorded as revision in the transaction
+// of the change. Revisions of task are numbered from 1, the latest revision is
+// the current state of the task.
+package revision
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/jinzhu/gorm"
+
+	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
+)
+
+// ErrNotFound is returned if task does not have the revision
+var ErrNotFound = errors.New("revision not found")
+
+// Revision is task as it was after change
+type Revision struct {
+	// ID is unique identifier of the record
+	ID int64 `gorm:"primary_key"`
+	// ToDoID is identifier of the task
+	ToDoID int64 `gorm:"unique_index:idx_to_do_revisions_number"`
+	// Number is number of the revision
+	Number int64 `gorm:"unique_index:idx_to_do_revisions_number"`
+	// Snapshot is task in JSON
+	Snapshot string `gorm:"type:text"`
+	// CreateTime is time the revision was recorded
+	CreateTime time.Time
+}
+
+// TableName returns table of revisions
+func (Revision) TableName() string {
+	return "to_do_revisions"
+}
+
+// Migrate creates schema of revision history
+func Migrate(db *gorm.DB) error {
+	return db.AutoMigrate(&Revision{}).Error
+}
+
+// Record writes new revision of task in transaction tx and returns its number
+func Record(tx *gorm.DB, td *v1.ToDo) (int64, error) {
+	snapshot, err := (&jsonpb.Marshaler{}).MarshalToString(td)
+	if err != nil {
+		return 0, fmt.Errorf("failed to marshal task snapshot: %v", err)
+	}
+	var last struct{ Number int64 }
+	err = tx.Model(&Revision{}).Select("coalesce(max(number), 0) as number").Where("to_do_id = ?", td.Id).Scan(&last).Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to read last revision: %v", err)
+	}
+	r := &Revision{ToDoID: td.Id, Number: last.Number + 1, Snapshot: snapshot, CreateTime: time.Now().UTC()}
+	if err := tx.Create(r).Error; err != nil {
+		return 0, fmt.Errorf("failed to write revision: %v", err)
+	}
+	return r.Number, nil
+}
+
+// List returns at most limit revisions of task, newest first
+func List(db *gorm.DB, toDoID int64, limit int) ([]*Revision, error) {
+	var revisions []*Revision
+	if err := db.Where("to_do_id = ?", toDoID).Order("number desc").Limit(limit).Find(&revisions).Error; err != nil {
+		return nil, fmt.Errorf("failed to read revisions: %v", err)
+	}
+	return revisions, nil
+}
+
+// Get returns revision of task, ErrNotFound if task does not have it
+func Get(db *gorm.DB, toDoID, number int64) (*Revision, error) {
+	var r Revision
+	if err := db.Where("to_do_id = ? AND number = ?", toDoID, number).First(&r).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to read revision: %v", err)
+	}
+	return &r, nil
+}
+
+// Prune deletes revisions of tasks which do not exist anymore and returns their number
+func Prune(db *gorm.DB) (int64, error) {
+	res := db.Where("to_do_id NOT IN (?)", db.Unscoped().Table("to_dos").Select("id").QueryExpr()).Delete(&Revision{})
+	if res.Error != nil {
+		return 0, fmt.Errorf("failed to prune revisions: %v", res.Error)
+	}
+	return res.RowsAffected, nil
+}
+
+// ToDo returns task as it was in the revision
+func (r *Revision) ToDo() (*v1.ToDo, error) {
+	td := new(v1.ToDo)
+	if err := jsonpb.UnmarshalString(r.Snapshot, td); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal task snapshot: %v", err)
+	}
+	return td, nil
+}
+
+// ToPB converts revision to its API representation
+func (r *Revision) ToPB() (*v1.Revision, error) {
+	td, err := r.ToDo()
+	if err != nil {
+		return nil, err
+	}
+	ts, err := ptypes.TimestampProto(r.CreateTime)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.Revision{ToDoId: r.ToDoID, Revision: r.Number, ToDo: td, CreateTime: ts}, nil
+}
@@ -0,0 +1,424 @@
+// Package rrule parses iCalendar (RFC 5545) recurrence rules and computes their occurrences.
+//
+// Supported rule parts are FREQ (DAILY, WEEKLY, MONTHLY, YEARLY), INTERVAL, COUNT, UNTIL,
+// BYDAY, BYMONTHDAY and BYMONTH. Occurrences are computed in UTC, the first occurrence
+// is start of the series and the rest keep its time of day.
+package rrule
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Frequency is FREQ part of rule
+type Frequency int
+
+const (
+	// Daily repeats every INTERVAL days
+	Daily Frequency = iota + 1
+	// Weekly repeats every INTERVAL weeks, weeks start on Monday
+	Weekly
+	// Monthly repeats every INTERVAL months
+	Monthly
+	// Yearly repeats every INTERVAL years
+	Yearly
+)
+
+// maxPeriods is the largest number of periods searched for occurrences,
+// it ends series of rules which match no day, e.g. BYMONTH=2;BYMONTHDAY=30
+const maxPeriods = 100000
+
+var frequencies = map[string]Frequency{"DAILY": Daily, "WEEKLY": Weekly, "MONTHLY": Monthly, "YEARLY": Yearly}
+
+var weekdays = map[string]time.Weekday{
+	"MO": time.Monday, "TU": time.Tuesday, "WE": time.Wednesday, "TH": time.Thursday,
+	"FR": time.Friday, "SA": time.Saturday, "SU": time.Sunday,
+}
+
+// String returns FREQ value
+func (f Frequency) String() string {
+	for name, v := range frequencies {
+		if v == f {
+			return name
+		}
+	}
+	return strconv.Itoa(int(f))
+}
+
+// Weekday is BYDAY value, Ordinal selects n-th weekday of month, counted from the end if negative,
+// 0 selects every weekday
+type Weekday struct {
+	Ordinal int
+	Day     time.Weekday
+}
+
+// String returns BYDAY value, e.g. -1FR
+func (w Weekday) String() string {
+	day := strings.ToUpper(w.Day.String()[:2])
+	if w.Ordinal == 0 {
+		return day
+	}
+	return strconv.Itoa(w.Ordinal) + day
+}
+
+// Rule is recurrence rule
+type Rule struct {
+	Freq     Frequency
+	Interval int
+	// Count is number of occurrences including start, unlimited if 0
+	Count int
+	// Until is time of the last possible occurrence, unlimited if zero
+	Until      time.Time
+	ByDay      []Weekday
+	ByMonthDay []int
+	ByMonth    []time.Month
+}
+
+// Parse parses rule, e.g. FREQ=WEEKLY;BYDAY=MO,WE. Optional RRULE: prefix is ignored.
+func Parse(s string) (*Rule, error) {
+	s = strings.TrimPrefix(strings.TrimSpace(s), "RRULE:")
+	if len(s) == 0 {
+		return nil, errors.New("empty recurrence rule")
+	}
+	r := &Rule{Interval: 1}
+	seen := map[string]bool{}
+	for _, part := range strings.Split(s, ";") {
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 || len(kv[1]) == 0 {
+			return nil, fmt.Errorf("invalid recurrence rule part '%s'", part)
+		}
+		name, value := strings.ToUpper(kv[0]), strings.ToUpper(kv[1])
+		if seen[name] {
+			return nil, fmt.Errorf("recurrence rule part %s is repeated", name)
+		}
+		seen[name] = true
+
+		var err error
+		switch name {
+		case "FREQ":
+			var ok bool
+			if r.Freq, ok = frequencies[value]; !ok {
+				err = errors.New("expected DAILY, WEEKLY, MONTHLY or YEARLY")
+			}
+		case "INTERVAL":
+			r.Interval, err = positive(value)
+		case "COUNT":
+			r.Count, err = positive(value)
+		case "UNTIL":
+			r.Until, err = parseUntil(value)
+		case "BYDAY":
+			r.ByDay, err = parseByDay(value)
+		case "BYMONTHDAY":
+			r.ByMonthDay, err = parseList(value, 1, 31, true)
+		case "BYMONTH":
+			var months []int
+			months, err = parseList(value, 1, 12, false)
+			for _, m := range months {
+				r.ByMonth = append(r.ByMonth, time.Month(m))
+			}
+		default:
+			return nil, fmt.Errorf("unsupported recurrence rule part %s", name)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid recurrence rule part %s=%s: %v", name, kv[1], err)
+		}
+	}
+
+	if r.Freq == 0 {
+		return nil, errors.New("recurrence rule part FREQ is required")
+	}
+	if r.Count > 0 && !r.Until.IsZero() {
+		return nil, errors.New("recurrence rule parts COUNT and UNTIL are exclusive")
+	}
+	for _, d := range r.ByDay {
+		if d.Ordinal != 0 && r.Freq != Monthly && (r.Freq != Yearly || len(r.ByMonth) == 0) {
+			return nil, fmt.Errorf("BYDAY=%s is supported by MONTHLY rules and YEARLY rules with BYMONTH only", d)
+		}
+	}
+	if r.Freq == Weekly && len(r.ByMonthDay) > 0 {
+		return nil, errors.New("BYMONTHDAY is not supported by WEEKLY rules")
+	}
+	return r, nil
+}
+
+// positive parses positive integer
+func positive(s string) (int, error) {
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 1 {
+		return 0, errors.New("expected positive integer")
+	}
+	return n, nil
+}
+
+// parseUntil parses UTC date-time or date, date includes the whole day
+func parseUntil(s string) (time.Time, error) {
+	for _, layout := range []string{"20060102T150405Z", "20060102T150405"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	t, err := time.Parse("20060102", s)
+	if err != nil {
+		return time.Time{}, errors.New("expected date-time YYYYMMDDTHHMMSSZ or date YYYYMMDD")
+	}
+	return t.Add(24*time.Hour - time.Second), nil
+}
+
+// parseList parses comma separated integers from min to max, negative ones too if negative is true
+func parseList(s string, min, max int, negative bool) ([]int, error) {
+	var list []int
+	for _, v := range strings.Split(s, ",") {
+		n, err := strconv.Atoi(v)
+		abs := n
+		if n < 0 && negative {
+			abs = -n
+		}
+		if err != nil || abs < min || abs > max {
+			return nil, fmt.Errorf("value %s is out of range", v)
+		}
+		list = append(list, n)
+	}
+	return list, nil
+}
+
+// parseByDay parses comma separated weekdays with optional ordinals
+func parseByDay(s string) ([]Weekday, error) {
+	var list []Weekday
+	for _, v := range strings.Split(s, ",") {
+		if len(v) < 2 {
+			return nil, fmt.Errorf("invalid weekday %s", v)
+		}
+		day, ok := weekdays[v[len(v)-2:]]
+		if !ok {
+			return nil, fmt.Errorf("invalid weekday %s", v)
+		}
+		w := Weekday{Day: day}
+		if n := v[:len(v)-2]; len(n) > 0 {
+			ord, err := strconv.Atoi(n)
+			if err != nil || ord == 0 || ord < -5 || ord > 5 {
+				return nil, fmt.Errorf("invalid weekday ordinal %s", v)
+			}
+			w.Ordinal = ord
+		}
+		list = append(list, w)
+	}
+	return list, nil
+}
+
+// String returns rule in iCalendar format
+func (r *Rule) String() string {
+	parts := []string{"FREQ=" + r.Freq.String()}
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+	if !r.Until.IsZero() {
+		parts = append(parts, "UNTIL="+r.Until.UTC().Format("20060102T150405Z"))
+	}
+	if len(r.ByDay) > 0 {
+		days := make([]string, len(r.ByDay))
+		for i, d := range r.ByDay {
+			days[i] = d.String()
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+	if len(r.ByMonthDay) > 0 {
+		days := make([]string, len(r.ByMonthDay))
+		for i, d := range r.ByMonthDay {
+			days[i] = strconv.Itoa(d)
+		}
+		parts = append(parts, "BYMONTHDAY="+strings.Join(days, ","))
+	}
+	if len(r.ByMonth) > 0 {
+		months := make([]string, len(r.ByMonth))
+		for i, m := range r.ByMonth {
+			months[i] = strconv.Itoa(int(m))
+		}
+		parts = append(parts, "BYMONTH="+strings.Join(months, ","))
+	}
+	return strings.Join(parts, ";")
+}
+
+// Next returns the first occurrence of series starting at start which is after t,
+// false is returned if the series ends before
+func (r *Rule) Next(start, t time.Time) (time.Time, bool) {
+	var next time.Time
+	r.each(start, time.Time{}, func(o time.Time) bool {
+		if o.After(t) {
+			next = o
+			return false
+		}
+		return true
+	})
+	return next, !next.IsZero()
+}
+
+// Between returns at most limit occurrences of series starting at start from after, inclusive,
+// to before, exclusive
+func (r *Rule) Between(start, after, before time.Time, limit int) []time.Time {
+	var list []time.Time
+	r.each(start, before, func(o time.Time) bool {
+		if !o.Before(before) || len(list) == limit {
+			return false
+		}
+		if !o.Before(after) {
+			list = append(list, o)
+		}
+		return true
+	})
+	return list
+}
+
+// each calls fn with occurrences of series starting at start in order until fn returns false
+// or periods start after end, unless end is zero
+func (r *Rule) each(start, end time.Time, fn func(time.Time) bool) {
+	start = start.UTC()
+	count := 0
+	emit := func(o time.Time) bool {
+		if (r.Count > 0 && count == r.Count) || (!r.Until.IsZero() && o.After(r.Until)) {
+			return false
+		}
+		count++
+		return fn(o)
+	}
+	if !emit(start) {
+		return
+	}
+
+	for p := 0; p < maxPeriods; p++ {
+		for _, o := range r.period(start, p*r.Interval) {
+			if o.After(start) && !emit(o) {
+				return
+			}
+		}
+		next := r.periodStart(start, (p+1)*r.Interval)
+		if (!r.Until.IsZero() && next.After(r.Until)) || (!end.IsZero() && !next.Before(end)) {
+			return
+		}
+	}
+}
+
+// periodStart returns the first day of period n periods after period of start
+func (r *Rule) periodStart(start time.Time, n int) time.Time {
+	y, m, d := start.Date()
+	switch r.Freq {
+	case Daily:
+		return time.Date(y, m, d+n, 0, 0, 0, 0, time.UTC)
+	case Weekly:
+		monday := d - (int(start.Weekday())+6)%7
+		return time.Date(y, m, monday+7*n, 0, 0, 0, 0, time.UTC)
+	case Monthly:
+		return time.Date(y, m+time.Month(n), 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return time.Date(y+n, time.January, 1, 0, 0, 0, 0, time.UTC)
+	}
+}
+
+// period returns occurrences in period n periods after period of start ordered by time
+func (r *Rule) period(start time.Time, n int) []time.Time {
+	first := r.periodStart(start, n)
+	var days []time.Time
+	switch r.Freq {
+	case Daily:
+		if r.matchesDay(first) {
+			days = append(days, first)
+		}
+	case Weekly:
+		for i := 0; i < 7; i++ {
+			day := first.AddDate(0, 0, i)
+			if (len(r.ByDay) > 0 || day.Weekday() == start.Weekday()) && r.matchesDay(day) {
+				days = append(days, day)
+			}
+		}
+	case Monthly:
+		if len(r.ByMonth) == 0 || r.inMonth(first.Month()) {
+			days = r.monthDays(start, first)
+		}
+	default:
+		months := r.ByMonth
+		if len(months) == 0 && len(r.ByDay) == 0 && len(r.ByMonthDay) == 0 {
+			months = []time.Month{start.Month()}
+		} else if len(months) == 0 {
+			// weekdays and days of month of every month
+			for m := time.January; m <= time.December; m++ {
+				months = append(months, m)
+			}
+		}
+		for _, m := range months {
+			days = append(days, r.monthDays(start, time.Date(first.Year(), m, 1, 0, 0, 0, 0, time.UTC))...)
+		}
+	}
+
+	sort.Slice(days, func(i, j int) bool { return days[i].Before(days[j]) })
+	h, min, sec := start.Clock()
+	offset := time.Duration(h)*time.Hour + time.Duration(min)*time.Minute + time.Duration(sec)*time.Second +
+		time.Duration(start.Nanosecond())
+	for i := range days {
+		days[i] = days[i].Add(offset)
+	}
+	return days
+}
+
+// monthDays returns days of month starting at first selected by BYDAY and BYMONTHDAY,
+// day of month of start if neither is set
+func (r *Rule) monthDays(start, first time.Time) []time.Time {
+	last := first.AddDate(0, 1, -1).Day()
+	var days []time.Time
+	for d := 1; d <= last; d++ {
+		day := first.AddDate(0, 0, d-1)
+		ok := d == start.Day()
+		if len(r.ByDay) > 0 || len(r.ByMonthDay) > 0 {
+			ok = (len(r.ByMonthDay) == 0 || r.inMonthDays(d, last)) && (len(r.ByDay) == 0 || r.inWeekdays(d, last, day.Weekday()))
+		}
+		if ok {
+			days = append(days, day)
+		}
+	}
+	return days
+}
+
+// matchesDay checks day of DAILY or WEEKLY rule against BYDAY, BYMONTHDAY and BYMONTH
+func (r *Rule) matchesDay(day time.Time) bool {
+	last := day.AddDate(0, 1, -day.Day()).Day()
+	return (len(r.ByMonth) == 0 || r.inMonth(day.Month())) &&
+		(len(r.ByMonthDay) == 0 || r.inMonthDays(day.Day(), last)) &&
+		(len(r.ByDay) == 0 || r.inWeekdays(day.Day(), last, day.Weekday()))
+}
+
+func (r *Rule) inMonth(m time.Month) bool {
+	for _, v := range r.ByMonth {
+		if v == m {
+			return true
+		}
+	}
+	return false
+}
+
+// inMonthDays checks day d of month with last days against BYMONTHDAY
+func (r *Rule) inMonthDays(d, last int) bool {
+	for _, v := range r.ByMonthDay {
+		if v == d || last+v+1 == d {
+			return true
+		}
+	}
+	return false
+}
+
+// inWeekdays checks day d of month with last days which is weekday wd against BYDAY
+func (r *Rule) inWeekdays(d, last int, wd time.Weekday) bool {
+	for _, v := range r.ByDay {
+		if v.Day != wd {
+			continue
+		}
+		if v.Ordinal == 0 || v.Ordinal == (d-1)/7+1 || v.Ordinal == -((last-d)/7+1) {
+			return true
+		}
+	}
+	return false
+}
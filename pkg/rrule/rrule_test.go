@@ -0,0 +1,125 @@
+package rrule
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func date(y int, m time.Month, d, h int) time.Time {
+	return time.Date(y, m, d, h, 0, 0, 0, time.UTC)
+}
+
+func TestRule_Between(t *testing.T) {
+	// Saturday
+	start := date(2019, 6, 1, 9)
+
+	tests := []struct {
+		name string
+		rule string
+		want []time.Time
+	}{
+		{
+			name: "Daily",
+			rule: "FREQ=DAILY;INTERVAL=2;COUNT=3",
+			want: []time.Time{start, date(2019, 6, 3, 9), date(2019, 6, 5, 9)},
+		},
+		{
+			name: "Weekly on weekdays",
+			rule: "RRULE:FREQ=WEEKLY;BYDAY=MO,WE;UNTIL=20190612",
+			want: []time.Time{start, date(2019, 6, 3, 9), date(2019, 6, 5, 9), date(2019, 6, 10, 9), date(2019, 6, 12, 9)},
+		},
+		{
+			name: "Monthly skips short months",
+			rule: "FREQ=MONTHLY;BYMONTHDAY=31;COUNT=4",
+			want: []time.Time{start, date(2019, 7, 31, 9), date(2019, 8, 31, 9), date(2019, 10, 31, 9)},
+		},
+		{
+			name: "Monthly on last Friday",
+			rule: "FREQ=MONTHLY;BYDAY=-1FR;COUNT=3",
+			want: []time.Time{start, date(2019, 6, 28, 9), date(2019, 7, 26, 9)},
+		},
+		{
+			name: "Monthly on the last day",
+			rule: "FREQ=MONTHLY;BYMONTHDAY=-1;COUNT=3",
+			want: []time.Time{start, date(2019, 6, 30, 9), date(2019, 7, 31, 9)},
+		},
+		{
+			name: "Yearly",
+			rule: "FREQ=YEARLY;BYMONTH=2,6;COUNT=4",
+			want: []time.Time{start, date(2020, 2, 1, 9), date(2020, 6, 1, 9), date(2021, 2, 1, 9)},
+		},
+		{
+			name: "Daily in month",
+			rule: "FREQ=DAILY;BYMONTH=7;COUNT=3",
+			want: []time.Time{start, date(2019, 7, 1, 9), date(2019, 7, 2, 9)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := Parse(tt.rule)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := r.Between(start, start, date(2030, 1, 1, 0), 100)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Between() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRule_Next(t *testing.T) {
+	start := date(2019, 6, 1, 9)
+	r, err := Parse("FREQ=WEEKLY;COUNT=2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if next, ok := r.Next(start, start); !ok || !next.Equal(date(2019, 6, 8, 9)) {
+		t.Errorf("Next() = %v, %v", next, ok)
+	}
+	if next, ok := r.Next(start, date(2019, 6, 8, 9)); ok {
+		t.Errorf("Next() after the last occurrence = %v", next)
+	}
+
+	// rule matching no day ends
+	r, err = Parse("FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=30")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if next, ok := r.Next(start, start); ok {
+		t.Errorf("Next() = %v", next)
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		rule    string
+		want    string
+		wantErr bool
+	}{
+		{rule: "freq=weekly;byday=mo,-1fr;interval=2", wantErr: true},
+		{rule: "FREQ=MONTHLY;BYDAY=MO,-1FR;INTERVAL=2", want: "FREQ=MONTHLY;INTERVAL=2;BYDAY=MO,-1FR"},
+		{rule: "FREQ=YEARLY;UNTIL=20201231T000000Z;BYMONTH=1", want: "FREQ=YEARLY;UNTIL=20201231T000000Z;BYMONTH=1"},
+		{rule: "", wantErr: true},
+		{rule: "INTERVAL=2", wantErr: true},
+		{rule: "FREQ=HOURLY", wantErr: true},
+		{rule: "FREQ=DAILY;COUNT=0", wantErr: true},
+		{rule: "FREQ=DAILY;COUNT=2;UNTIL=20200101", wantErr: true},
+		{rule: "FREQ=DAILY;BYSETPOS=1", wantErr: true},
+		{rule: "FREQ=DAILY;FREQ=DAILY", wantErr: true},
+		{rule: "FREQ=MONTHLY;BYMONTHDAY=32", wantErr: true},
+		{rule: "FREQ=MONTHLY;BYDAY=6MO", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.rule, func(t *testing.T) {
+			r, err := Parse(tt.rule)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && r.String() != tt.want {
+				t.Errorf("String() = %v, want %v", r.String(), tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package scheduler
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes"
+	"go.uber.org/zap"
+
+	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
+	"go.smartmachine.io/go-grpc-api/pkg/events"
+	"go.smartmachine.io/go-grpc-api/pkg/logger"
+)
+
+// LogNotifier logs due reminders, title and description are not logged
+func LogNotifier() Notifier {
+	return NotifierFunc(func(ctx context.Context, td *v1.ToDo) error {
+		logger.FromContext(ctx).Info("reminder fired",
+			zap.Int64("id", td.Id), zap.String("reminder", ptypes.TimestampString(td.Reminder)))
+		return nil
+	})
+}
+
+// BusNotifier publishes due reminders as REMINDED events to watchers of tasks
+func BusNotifier(bus *events.Bus) Notifier {
+	return NotifierFunc(func(ctx context.Context, td *v1.ToDo) error {
+		bus.Publish(v1.EventType_REMINDED, td)
+		return nil
+	})
+}
@@ -0,0 +1,221 @@
+// Package scheduler fires reminders of todo tasks when they come due.
+//
+// Replicas of the server share the database and may all run scheduler.
+// Replica takes lease on due reminder before firing it and marks it as fired when
+// notifiers delivered it, so every reminder is marked as fired exactly once.
+// Reminder whose notifiers failed is fired again, by any replica, when its lease expires.
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+
+	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
+	"go.smartmachine.io/go-grpc-api/pkg/logger"
+)
+
+const (
+	// DefaultInterval is time between scans for due reminders
+	DefaultInterval = 10 * time.Second
+	// DefaultLease is time replica has to fire reminder before other replica may fire it
+	DefaultLease = time.Minute
+	// DefaultCatchUp is how old due reminders may be to be fired, e.g. after all replicas were down
+	DefaultCatchUp = time.Hour
+
+	// scanLimit is the largest number of reminders fired by one scan
+	scanLimit = 100
+)
+
+// Fire is reminder of task being fired or fired. Changed reminder of task is fired again.
+type Fire struct {
+	// ToDoID is ID of the task
+	ToDoID int64 `gorm:"primary_key;auto_increment:false"`
+	// Reminder is reminder of the task
+	Reminder time.Time `gorm:"primary_key"`
+	// Holder is replica holding lease on the reminder
+	Holder string
+	// LeaseExpiresAt is time the lease expires
+	LeaseExpiresAt time.Time
+	// FiredAt is time notifiers delivered the reminder, nil until then
+	FiredAt *time.Time
+}
+
+// TableName returns table of fired reminders
+func (Fire) TableName() string {
+	return "reminder_fires"
+}
+
+// Migrate creates schema of scheduler and index of tasks by reminder scans use
+func Migrate(db *gorm.DB) error {
+	if err := db.AutoMigrate(&Fire{}).Error; err != nil {
+		return err
+	}
+	return db.Model(&v1.ToDoORM{}).AddIndex("idx_to_dos_reminder", "reminder").Error
+}
+
+// Notifier delivers due reminder of task
+type Notifier interface {
+	Notify(ctx context.Context, td *v1.ToDo) error
+}
+
+// NotifierFunc is function implementing Notifier
+type NotifierFunc func(ctx context.Context, td *v1.ToDo) error
+
+// Notify calls f
+func (f NotifierFunc) Notify(ctx context.Context, td *v1.ToDo) error {
+	return f(ctx, td)
+}
+
+// Config is configuration of scheduler
+type Config struct {
+	// Interval is time between scans, DefaultInterval if 0
+	Interval time.Duration
+	// Lease is time replica has to fire reminder, DefaultLease if 0
+	Lease time.Duration
+	// CatchUp is how old due reminders may be to be fired, DefaultCatchUp if 0
+	CatchUp time.Duration
+	// Holder identifies replica holding leases, host name and process ID if empty
+	Holder string
+	// Notifiers deliver due reminders
+	Notifiers []Notifier
+}
+
+// Scheduler fires due reminders
+type Scheduler struct {
+	db  *gorm.DB
+	cfg Config
+	// now returns current time, tests replace it
+	now func() time.Time
+}
+
+// New creates scheduler of reminders of tasks in db, schema must be created by Migrate
+func New(db *gorm.DB, cfg Config) *Scheduler {
+	if cfg.Interval <= 0 {
+		cfg.Interval = DefaultInterval
+	}
+	if cfg.Lease <= 0 {
+		cfg.Lease = DefaultLease
+	}
+	if cfg.CatchUp <= 0 {
+		cfg.CatchUp = DefaultCatchUp
+	}
+	if len(cfg.Holder) == 0 {
+		host, _ := os.Hostname()
+		cfg.Holder = fmt.Sprintf("%s-%d", host, os.Getpid())
+	}
+	return &Scheduler{db: db, cfg: cfg, now: time.Now}
+}
+
+// Run scans for due reminders every interval until ctx is done
+func (s *Scheduler) Run(ctx context.Context) error {
+	log := logger.Log.Named("scheduler")
+	log.Info("starting reminder scheduler...", zap.Duration("interval", s.cfg.Interval), zap.String("holder", s.cfg.Holder))
+
+	t := time.NewTicker(s.cfg.Interval)
+	defer t.Stop()
+	for {
+		if _, err := s.Scan(logger.WithContext(ctx, log)); err != nil {
+			log.Warn("failed to scan for due reminders", zap.Error(err))
+		}
+		select {
+		case <-ctx.Done():
+			log.Info("reminder scheduler stopped")
+			return ctx.Err()
+		case <-t.C:
+		}
+	}
+}
+
+// Scan fires due reminders once and returns number of reminders fired
+func (s *Scheduler) Scan(ctx context.Context) (int, error) {
+	now := s.now().UTC()
+	horizon := now.Add(-s.cfg.CatchUp)
+
+	// fired reminders older than catch up window cannot come due again
+	if err := s.db.Where("reminder < ? AND fired_at IS NOT NULL", horizon).Delete(&Fire{}).Error; err != nil {
+		return 0, fmt.Errorf("failed to delete fired reminders: %v", err)
+	}
+
+	var due []*v1.ToDoORM
+	err := s.db.Table("to_dos").Select("to_dos.*").
+		Joins("LEFT JOIN reminder_fires f ON f.to_do_id = to_dos.id AND f.reminder = to_dos.reminder").
+		Where("to_dos.reminder <= ? AND to_dos.reminder > ? AND to_dos.done = ? AND to_dos.delete_time IS NULL", now, horizon, false).
+		Where("f.to_do_id IS NULL OR (f.fired_at IS NULL AND f.lease_expires_at < ?)", now).
+		Order("to_dos.reminder").Limit(scanLimit).Find(&due).Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to read due reminders: %v", err)
+	}
+
+	var fired int
+	for _, orm := range due {
+		ok, err := s.fire(ctx, orm, now)
+		if err != nil {
+			return fired, err
+		}
+		if ok {
+			fired++
+		}
+	}
+	return fired, nil
+}
+
+// fire claims reminder of task and delivers it, false is returned if other replica holds it
+// or notifiers failed
+func (s *Scheduler) fire(ctx context.Context, orm *v1.ToDoORM, now time.Time) (bool, error) {
+	key := Fire{ToDoID: orm.Id, Reminder: orm.Reminder.UTC()}
+	claimed, err := s.claim(key, now)
+	if err != nil || !claimed {
+		return false, err
+	}
+
+	td, err := orm.ToPB(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to convert task %d: %v", orm.Id, err)
+	}
+	var errs error
+	for _, n := range s.cfg.Notifiers {
+		errs = multierr.Append(errs, n.Notify(ctx, &td))
+	}
+	if errs != nil {
+		// lease expires and the reminder is fired again
+		logger.FromContext(ctx).Warn("failed to notify reminder", zap.Int64("id", td.Id), zap.Error(errs))
+		return false, nil
+	}
+
+	err = s.db.Model(&Fire{}).
+		Where("to_do_id = ? AND reminder = ? AND holder = ?", key.ToDoID, key.Reminder, s.cfg.Holder).
+		Update("fired_at", s.now().UTC()).Error
+	if err != nil {
+		return false, fmt.Errorf("failed to mark reminder of task %d as fired: %v", orm.Id, err)
+	}
+	return true, nil
+}
+
+// claim takes lease on reminder, false is returned if it was fired or other replica holds unexpired lease
+func (s *Scheduler) claim(key Fire, now time.Time) (bool, error) {
+	expires := now.Add(s.cfg.Lease)
+	res := s.db.Exec("INSERT INTO reminder_fires (to_do_id, reminder, holder, lease_expires_at) SELECT ?, ?, ?, ? "+
+		"WHERE NOT EXISTS (SELECT 1 FROM reminder_fires WHERE to_do_id = ? AND reminder = ?)",
+		key.ToDoID, key.Reminder, s.cfg.Holder, expires, key.ToDoID, key.Reminder)
+	if res.Error != nil {
+		return false, fmt.Errorf("failed to take lease on reminder of task %d: %v", key.ToDoID, res.Error)
+	}
+	if res.RowsAffected == 1 {
+		return true, nil
+	}
+
+	// reminder is claimed already, its lease is taken over if it expired before the reminder was fired
+	res = s.db.Model(&Fire{}).
+		Where("to_do_id = ? AND reminder = ? AND fired_at IS NULL AND lease_expires_at < ?", key.ToDoID, key.Reminder, now).
+		Updates(map[string]interface{}{"holder": s.cfg.Holder, "lease_expires_at": expires})
+	if res.Error != nil {
+		return false, fmt.Errorf("failed to take lease on reminder of task %d: %v", key.ToDoID, res.Error)
+	}
+	return res.RowsAffected == 1, nil
+}
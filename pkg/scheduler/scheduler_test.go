@@ -0,0 +1,135 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/jinzhu/gorm"
+	_ "github.com/jinzhu/gorm/dialects/sqlite"
+
+	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
+)
+
+// recorder is notifier counting delivered reminders by task ID
+type recorder struct {
+	mu    sync.Mutex
+	count map[int64]int
+	err   error
+}
+
+func (r *recorder) Notify(ctx context.Context, td *v1.ToDo) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err != nil {
+		return r.err
+	}
+	r.count[td.Id]++
+	return nil
+}
+
+func openDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	db.DB().SetMaxOpenConns(1)
+	if err := db.AutoMigrate(&v1.ToDoORM{}).Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := Migrate(db); err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+func createTask(t *testing.T, db *gorm.DB, reminder time.Time) int64 {
+	ts, _ := ptypes.TimestampProto(reminder)
+	td, err := v1.DefaultCreateToDo(context.Background(), &v1.ToDo{Title: "task", Reminder: ts}, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return td.Id
+}
+
+func TestScheduler_Scan(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2019, 6, 1, 10, 0, 0, 0, time.UTC)
+	db := openDB(t)
+	defer db.Close()
+
+	due := createTask(t, db, now.Add(-time.Minute))
+	createTask(t, db, now.Add(time.Minute))
+	createTask(t, db, now.Add(-2*DefaultCatchUp))
+
+	// replicas scan at the same time, every reminder is delivered once
+	rec := &recorder{count: map[int64]int{}}
+	var wg sync.WaitGroup
+	for _, holder := range []string{"a", "b", "c"} {
+		s := New(db, Config{Holder: holder, Notifiers: []Notifier{rec}})
+		s.now = func() time.Time { return now }
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := s.Scan(ctx); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+	if len(rec.count) != 1 || rec.count[due] != 1 {
+		t.Errorf("delivered reminders = %v, want task %d once", rec.count, due)
+	}
+
+	// changed reminder is fired again
+	s := New(db, Config{Holder: "a", Notifiers: []Notifier{rec}})
+	s.now = func() time.Time { return now }
+	if err := db.Model(&v1.ToDoORM{}).Where("id = ?", due).Update("reminder", now.Add(-time.Second)).Error; err != nil {
+		t.Fatal(err)
+	}
+	if n, err := s.Scan(ctx); err != nil || n != 1 || rec.count[due] != 2 {
+		t.Errorf("Scan() = %d, %v, delivered %v, want changed reminder fired", n, err, rec.count)
+	}
+}
+
+func TestScheduler_Scan_NotifierFailed(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2019, 6, 1, 10, 0, 0, 0, time.UTC)
+	db := openDB(t)
+	defer db.Close()
+	id := createTask(t, db, now.Add(-time.Minute))
+
+	rec := &recorder{count: map[int64]int{}, err: errors.New("unavailable")}
+	a := New(db, Config{Holder: "a", Notifiers: []Notifier{rec}})
+	a.now = func() time.Time { return now }
+	b := New(db, Config{Holder: "b", Notifiers: []Notifier{rec}})
+
+	tests := []struct {
+		name      string
+		scheduler *Scheduler
+		now       time.Time
+		err       error
+		wantFired int
+	}{
+		{name: "Notifier failed", scheduler: a, now: now, err: rec.err},
+		{name: "Lease held", scheduler: b, now: now.Add(DefaultLease / 2)},
+		{name: "Lease expired", scheduler: b, now: now.Add(DefaultLease + time.Second), wantFired: 1},
+		{name: "Fired", scheduler: a, now: now.Add(2 * DefaultLease)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec.err = tt.err
+			tt.scheduler.now = func() time.Time { return tt.now }
+			n, err := tt.scheduler.Scan(ctx)
+			if err != nil || n != tt.wantFired {
+				t.Errorf("Scan() = %d, %v, want %d", n, err, tt.wantFired)
+			}
+		})
+	}
+	if rec.count[id] != 1 {
+		t.Errorf("reminder delivered %d times, want once", rec.count[id])
+	}
+}
@@ -0,0 +1,37 @@
+// Package schema creates database schema of the ToDo service and all its components.
+//
+// Server and in-process test servers call Migrate, so they always run with the same schema.
+package schema
+
+import (
+	"fmt"
+
+	"github.com/jinzhu/gorm"
+
+	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
+	"go.smartmachine.io/go-grpc-api/pkg/audit"
+	"go.smartmachine.io/go-grpc-api/pkg/revision"
+	"go.smartmachine.io/go-grpc-api/pkg/scheduler"
+	"go.smartmachine.io/go-grpc-api/pkg/webhook"
+)
+
+// Migrate creates or updates tables and indexes of tasks, tags, projects, reminder scheduler,
+// webhooks, audit records and revisions
+func Migrate(db *gorm.DB) error {
+	if err := db.AutoMigrate(&v1.ToDoORM{}, &v1.TagORM{}, &v1.ProjectORM{}).Error; err != nil {
+		return err
+	}
+	if err := scheduler.Migrate(db); err != nil {
+		return fmt.Errorf("scheduler schema: %v", err)
+	}
+	if err := webhook.Migrate(db); err != nil {
+		return fmt.Errorf("webhook schema: %v", err)
+	}
+	if err := audit.Migrate(db); err != nil {
+		return fmt.Errorf("audit schema: %v", err)
+	}
+	if err := revision.Migrate(db); err != nil {
+		return fmt.Errorf("revision schema: %v", err)
+	}
+	return nil
+}
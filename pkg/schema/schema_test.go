@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jinzhu/gorm"
+	// register SQLite driver
+	_ "github.com/jinzhu/gorm/dialects/sqlite"
+
+	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
+	"go.smartmachine.io/go-grpc-api/pkg/audit"
+	"go.smartmachine.io/go-grpc-api/pkg/revision"
+	"go.smartmachine.io/go-grpc-api/pkg/scheduler"
+	"go.smartmachine.io/go-grpc-api/pkg/webhook"
+)
+
+func TestMigrate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "schema")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	db, err := gorm.Open("sqlite3", filepath.Join(dir, "todo.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	// migration of existing schema changes nothing
+	for i := 0; i < 2; i++ {
+		if err := Migrate(db); err != nil {
+			t.Fatalf("Migrate() error = %v", err)
+		}
+	}
+
+	for _, table := range []interface{}{
+		&v1.ToDoORM{}, &v1.TagORM{}, &v1.ProjectORM{}, &scheduler.Fire{},
+		&webhook.Endpoint{}, &webhook.DeadLetter{}, &audit.Event{}, &revision.Revision{},
+	} {
+		if !db.HasTable(table) {
+			t.Errorf("table of %T not created", table)
+		}
+	}
+	if !db.Dialect().HasIndex(db.NewScope(&v1.ToDoORM{}).TableName(), "idx_to_dos_reminder") {
+		t.Error("index idx_to_dos_reminder not created")
+	}
+}
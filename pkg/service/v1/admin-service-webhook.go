@@ -0,0 +1,148 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"strings"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/ptypes"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
+	"go.smartmachine.io/go-grpc-api/pkg/logger"
+	"go.smartmachine.io/go-grpc-api/pkg/webhook"
+)
+
+// checkWebhooks checks API version and that webhooks are enabled
+func (s *adminServiceServer) checkWebhooks(api string) error {
+	// check if the API version requested by client is supported by server
+	if err := s.checkAPI(api); err != nil {
+		return err
+	}
+	if s.hooks == nil {
+		return status.Error(codes.Unimplemented, "webhooks are disabled")
+	}
+	return nil
+}
+
+// webhookToPB converts registered endpoint to webhook, secret is not set
+func webhookToPB(e *webhook.Endpoint) *v1.Webhook {
+	w := &v1.Webhook{Id: e.ID, Url: e.URL}
+	if len(e.Types) > 0 {
+		for _, name := range strings.Split(e.Types, ",") {
+			w.Types = append(w.Types, v1.EventType(v1.EventType_value[name]))
+		}
+	}
+	w.CreateTime, _ = ptypes.TimestampProto(e.CreatedAt)
+	return w
+}
+
+// Register webhook receiving changes of todo tasks and fired reminders
+func (s *adminServiceServer) CreateWebhook(ctx context.Context, req *v1.CreateWebhookRequest) (*v1.CreateWebhookResponse, error) {
+	if err := s.checkWebhooks(req.Api); err != nil {
+		return nil, err
+	}
+	if req.Webhook == nil {
+		return nil, status.Error(codes.InvalidArgument, "webhook field is required")
+	}
+	for _, t := range req.Webhook.Types {
+		if t == v1.EventType_EVENT_TYPE_UNSPECIFIED {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid event type: %v", t)
+		}
+	}
+
+	e, err := s.hooks.Register(req.Webhook.Url, req.Webhook.Types, req.Webhook.Secret)
+	if err == webhook.ErrInvalidURL {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	logger.FromContext(ctx).Info("webhook registered", zap.Int64("webhook", e.ID), zap.String("url", e.URL))
+
+	w := webhookToPB(e)
+	w.Secret = e.Secret
+	return &v1.CreateWebhookResponse{
+		Api:     apiVersion,
+		Webhook: w,
+	}, nil
+}
+
+// Read all webhooks
+func (s *adminServiceServer) ListWebhooks(ctx context.Context, req *v1.ListWebhooksRequest) (*v1.ListWebhooksResponse, error) {
+	if err := s.checkWebhooks(req.Api); err != nil {
+		return nil, err
+	}
+
+	list, err := s.hooks.Endpoints()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	webhooks := []*v1.Webhook{}
+	for _, e := range list {
+		webhooks = append(webhooks, webhookToPB(e))
+	}
+
+	return &v1.ListWebhooksResponse{
+		Api:      apiVersion,
+		Webhooks: webhooks,
+	}, nil
+}
+
+// Delete webhook
+func (s *adminServiceServer) DeleteWebhook(ctx context.Context, req *v1.DeleteWebhookRequest) (*v1.DeleteWebhookResponse, error) {
+	if err := s.checkWebhooks(req.Api); err != nil {
+		return nil, err
+	}
+
+	n, err := s.hooks.Delete(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if n > 0 {
+		logger.FromContext(ctx).Info("webhook deleted", zap.Int64("webhook", req.Id))
+	}
+
+	return &v1.DeleteWebhookResponse{
+		Api:     apiVersion,
+		Deleted: n,
+	}, nil
+}
+
+// Read events which could not be delivered to webhooks
+func (s *adminServiceServer) ListDeadLetters(ctx context.Context, req *v1.ListDeadLettersRequest) (*v1.ListDeadLettersResponse, error) {
+	if err := s.checkWebhooks(req.Api); err != nil {
+		return nil, err
+	}
+
+	list, err := s.hooks.DeadLetters(req.WebhookId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	u := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	letters := []*v1.DeadLetter{}
+	for _, dl := range list {
+		event := new(v1.Event)
+		if err := u.Unmarshal(bytes.NewReader([]byte(dl.Payload)), event); err != nil {
+			return nil, status.Errorf(codes.Internal, "unable to read dead letter %d: %v", dl.ID, err)
+		}
+		created, _ := ptypes.TimestampProto(dl.CreatedAt)
+		letters = append(letters, &v1.DeadLetter{
+			Id:         dl.ID,
+			WebhookId:  dl.EndpointID,
+			Url:        dl.URL,
+			Event:      event,
+			Attempts:   int32(dl.Attempts),
+			LastError:  dl.LastError,
+			CreateTime: created,
+		})
+	}
+
+	return &v1.ListDeadLettersResponse{
+		Api:         apiVersion,
+		DeadLetters: letters,
+	}, nil
+}
@@ -0,0 +1,93 @@
+package v1_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
+	"go.smartmachine.io/go-grpc-api/pkg/testing/todotest"
+	"go.smartmachine.io/go-grpc-api/pkg/testing/webhooktest"
+)
+
+func TestWebhooks(t *testing.T) {
+	srv := todotest.Start(t, todotest.Config{AdminToken: "admin"})
+	defer srv.Close()
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "bearer admin")
+
+	r := webhooktest.NewReceiver("secret")
+	defer r.Close()
+	failing := webhooktest.NewReceiver("")
+	defer failing.Close()
+	failing.FailNext(-1)
+
+	created, err := srv.Admin.CreateWebhook(ctx, &v1.CreateWebhookRequest{Webhook: &v1.Webhook{
+		Url: r.URL, Secret: "secret", Types: []v1.EventType{v1.EventType_CREATED},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dead, err := srv.Admin.CreateWebhook(ctx, &v1.CreateWebhookRequest{Webhook: &v1.Webhook{Url: failing.URL}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dead.Webhook.Secret) == 0 {
+		t.Error("secret was not generated")
+	}
+
+	list, err := srv.Admin.ListWebhooks(ctx, &v1.ListWebhooksRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Webhooks) != 2 || list.Webhooks[0].Url != r.URL || len(list.Webhooks[0].Secret) > 0 {
+		t.Errorf("ListWebhooks() = %v", list.Webhooks)
+	}
+
+	ids := srv.Seed(t, &v1.ToDo{Title: "hooked", Reminder: ptypes.TimestampNow()})
+	if _, err := srv.Client.Delete(ctx, &v1.DeleteRequest{Api: todotest.APIVersion, Id: ids[0]}); err != nil {
+		t.Fatal(err)
+	}
+
+	// only created event is delivered to receiver accepting CREATED
+	deliveries := r.Wait(t, 1)
+	if d := deliveries[0]; !d.Verified || d.Event.Type != v1.EventType_CREATED || d.Event.ToDo.Title != "hooked" {
+		t.Errorf("delivery = %+v", d)
+	}
+
+	// both events become dead letters of failing receiver
+	var letters []*v1.DeadLetter
+	for deadline := time.Now().Add(webhooktest.WaitTimeout); len(letters) < 2 && time.Now().Before(deadline); {
+		time.Sleep(10 * time.Millisecond)
+		res, err := srv.Admin.ListDeadLetters(ctx, &v1.ListDeadLettersRequest{WebhookId: dead.Webhook.Id})
+		if err != nil {
+			t.Fatal(err)
+		}
+		letters = res.DeadLetters
+	}
+	if len(letters) != 2 {
+		t.Fatalf("dead letters = %v, want 2", letters)
+	}
+	for _, dl := range letters {
+		if dl.Attempts != todotest.WebhookMaxAttempts || dl.Url != failing.URL || dl.Event.ToDo.GetId() != ids[0] {
+			t.Errorf("dead letter = %v", dl)
+		}
+	}
+	if len(r.Deliveries()) != 1 {
+		t.Errorf("receiver got %d events, want 1", len(r.Deliveries()))
+	}
+
+	res, err := srv.Admin.DeleteWebhook(ctx, &v1.DeleteWebhookRequest{Id: created.Webhook.Id})
+	if err != nil || res.Deleted != 1 {
+		t.Errorf("DeleteWebhook() = %v, %v", res, err)
+	}
+
+	_, err = srv.Admin.CreateWebhook(ctx, &v1.CreateWebhookRequest{Webhook: &v1.Webhook{Url: "ftp://example.com"}})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("CreateWebhook() with invalid URL error = %v, want InvalidArgument", err)
+	}
+}
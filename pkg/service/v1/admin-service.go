@@ -0,0 +1,88 @@
+package v1
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
+	"go.smartmachine.io/go-grpc-api/pkg/logger"
+	"go.smartmachine.io/go-grpc-api/pkg/webhook"
+)
+
+// adminServiceServer is implementation of v1.AdminServiceServer proto interface
+type adminServiceServer struct {
+	hooks *webhook.Dispatcher
+}
+
+// NewAdminServiceServer creates Admin service, webhooks are managed by hooks.
+// Webhook calls are unimplemented if hooks is nil.
+func NewAdminServiceServer(hooks *webhook.Dispatcher) v1.AdminServiceServer {
+	return &adminServiceServer{hooks: hooks}
+}
+
+// checkAPI checks if the API version requested by client is supported by server
+func (s *adminServiceServer) checkAPI(api string) error {
+	// API version is "" means use current version of the service
+	if len(api) > 0 {
+		if apiVersion != api {
+			return status.Errorf(codes.Unimplemented,
+				"unsupported API version: service implements API version '%s', but asked for '%s'", apiVersion, api)
+		}
+	}
+	return nil
+}
+
+// logLevels returns global log level and all overrides
+func logLevels() (string, []*v1.LogLevel) {
+	overrides := logger.Overrides()
+	list := []*v1.LogLevel{}
+	for _, name := range logger.OverrideNames() {
+		list = append(list, &v1.LogLevel{
+			Name:  name,
+			Level: overrides[name].String(),
+		})
+	}
+	return logger.Level().String(), list
+}
+
+// Read global log level and overrides
+func (s *adminServiceServer) GetLogLevels(ctx context.Context, req *v1.GetLogLevelsRequest) (*v1.GetLogLevelsResponse, error) {
+	// check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+
+	level, overrides := logLevels()
+
+	return &v1.GetLogLevelsResponse{
+		Api:       apiVersion,
+		Level:     level,
+		Overrides: overrides,
+	}, nil
+}
+
+// Change global log level or override of a named logger
+func (s *adminServiceServer) SetLogLevel(ctx context.Context, req *v1.SetLogLevelRequest) (*v1.SetLogLevelResponse, error) {
+	// check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+
+	if req.LogLevel == nil {
+		return nil, status.Error(codes.InvalidArgument, "logLevel field is required")
+	}
+
+	if err := logger.SetLevel(req.LogLevel.Name, req.LogLevel.Level); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	level, overrides := logLevels()
+
+	return &v1.SetLogLevelResponse{
+		Api:       apiVersion,
+		Level:     level,
+		Overrides: overrides,
+	}, nil
+}
@@ -0,0 +1,124 @@
+package v1
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
+)
+
+func Test_adminServiceServer_SetLogLevel(t *testing.T) {
+	ctx := context.Background()
+	s := NewAdminServiceServer(nil)
+
+	type args struct {
+		ctx context.Context
+		req *v1.SetLogLevelRequest
+	}
+	tests := []struct {
+		name    string
+		s       v1.AdminServiceServer
+		args    args
+		want    *v1.SetLogLevelResponse
+		wantErr bool
+	}{
+		{
+			name: "Global level",
+			s:    s,
+			args: args{
+				ctx: ctx,
+				req: &v1.SetLogLevelRequest{
+					Api:      "v1",
+					LogLevel: &v1.LogLevel{Level: "warn"},
+				},
+			},
+			want: &v1.SetLogLevelResponse{
+				Api:       "v1",
+				Level:     "warn",
+				Overrides: []*v1.LogLevel{},
+			},
+		},
+		{
+			name: "Override",
+			s:    s,
+			args: args{
+				ctx: ctx,
+				req: &v1.SetLogLevelRequest{
+					Api:      "v1",
+					LogLevel: &v1.LogLevel{Name: "/v1.ToDoService/Create", Level: "debug"},
+				},
+			},
+			want: &v1.SetLogLevelResponse{
+				Api:   "v1",
+				Level: "warn",
+				Overrides: []*v1.LogLevel{
+					{Name: "/v1.ToDoService/Create", Level: "debug"},
+				},
+			},
+		},
+		{
+			name: "Remove override",
+			s:    s,
+			args: args{
+				ctx: ctx,
+				req: &v1.SetLogLevelRequest{
+					Api:      "v1",
+					LogLevel: &v1.LogLevel{Name: "/v1.ToDoService/Create"},
+				},
+			},
+			want: &v1.SetLogLevelResponse{
+				Api:       "v1",
+				Level:     "warn",
+				Overrides: []*v1.LogLevel{},
+			},
+		},
+		{
+			name: "Invalid level",
+			s:    s,
+			args: args{
+				ctx: ctx,
+				req: &v1.SetLogLevelRequest{
+					Api:      "v1",
+					LogLevel: &v1.LogLevel{Level: "verbose"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Missing log level",
+			s:    s,
+			args: args{
+				ctx: ctx,
+				req: &v1.SetLogLevelRequest{
+					Api: "v1",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Unsupported API",
+			s:    s,
+			args: args{
+				ctx: ctx,
+				req: &v1.SetLogLevelRequest{
+					Api:      "v1000",
+					LogLevel: &v1.LogLevel{Level: "info"},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.s.SetLogLevel(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("adminServiceServer.SetLogLevel() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("adminServiceServer.SetLogLevel() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package v1
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
+	"go.smartmachine.io/go-grpc-api/pkg/audit"
+	"go.smartmachine.io/go-grpc-api/pkg/revision"
+)
+
+// maxAuditEvents is the largest number of audit records listed by one request
+const maxAuditEvents = 1000
+
+// recordChange writes audit record and new revision of changed task in transaction tx,
+// before is nil for created task and after is nil for deleted one
+func recordChange(ctx context.Context, tx *gorm.DB, before, after *v1.ToDo) error {
+	if err := audit.Record(ctx, tx, before, after); err != nil {
+		return status.Errorf(codes.Internal, "unable to write audit record: %v", err)
+	}
+	if after != nil {
+		if _, err := revision.Record(tx, after); err != nil {
+			return status.Errorf(codes.Internal, "unable to write revision: %v", err)
+		}
+	}
+	return nil
+}
+
+// snapshot converts task read from database for audit record or revision
+func snapshot(ctx context.Context, orm *v1.ToDoORM) (*v1.ToDo, error) {
+	td, err := orm.ToPB(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to convert to orm representation: %v", err)
+	}
+	return &td, nil
+}
+
+// List audit records of mutations of todo tasks
+func (s *toDoServiceServer) ListAuditEvents(ctx context.Context, req *v1.ListAuditEventsRequest) (*v1.ListAuditEventsResponse, error) {
+	// check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+
+	f := audit.Filter{ToDoID: req.ToDoId, Actor: req.Actor, UnverifiedActor: req.UnverifiedActor}
+	var err error
+	if req.Start != nil {
+		if f.Start, err = ptypes.Timestamp(req.Start); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "start field has invalid format-> %v", err)
+		}
+	}
+	if req.End != nil {
+		if f.End, err = ptypes.Timestamp(req.End); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "end field has invalid format-> %v", err)
+		}
+	}
+	if !f.Start.IsZero() && !f.End.IsZero() && !f.End.After(f.Start) {
+		return nil, status.Errorf(codes.InvalidArgument, "end %v is not after start %v",
+			f.End.Format(time.RFC3339), f.Start.Format(time.RFC3339))
+	}
+
+	// one more record is read to tell if the list is truncated
+	events, err := audit.List(s.db, f, maxAuditEvents+1)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to read records, internal error: %v", err)
+	}
+	res := &v1.ListAuditEventsResponse{Api: apiVersion}
+	if len(events) > maxAuditEvents {
+		events, res.Truncated = events[:maxAuditEvents], true
+	}
+	for _, e := range events {
+		pb, err := e.ToPB()
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "unable to convert audit record: %v", err)
+		}
+		res.Events = append(res.Events, pb)
+	}
+	return res, nil
+}
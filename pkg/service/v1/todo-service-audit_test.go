@@ -0,0 +1,159 @@
+package v1_test
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
+	"go.smartmachine.io/go-grpc-api/pkg/requestid"
+	"go.smartmachine.io/go-grpc-api/pkg/testing/todotest"
+)
+
+func TestListAuditEvents(t *testing.T) {
+	srv := todotest.Start(t, todotest.Config{})
+	defer srv.Close()
+
+	// alice creates, updates, deletes and restores task, bob creates another one
+	alice := metadata.AppendToOutgoingContext(context.Background(), requestid.CallerMetadataKey, "alice")
+	bob := metadata.AppendToOutgoingContext(context.Background(), requestid.CallerMetadataKey, "bob")
+	td := remindAt(time.Now())
+	td.Title = "audited"
+	created, err := srv.Client.Create(alice, &v1.CreateRequest{ToDo: td})
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := created.Id
+	td.Id, td.Title = id, "changed"
+	if _, err := srv.Client.Update(alice, &v1.UpdateRequest{ToDo: td}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := srv.Client.Delete(alice, &v1.DeleteRequest{Id: id}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := srv.Client.Undelete(alice, &v1.UndeleteRequest{Id: id}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := srv.Client.Create(bob, &v1.CreateRequest{ToDo: remindAt(time.Now())}); err != nil {
+		t.Fatal(err)
+	}
+	future, _ := ptypes.TimestampProto(time.Now().Add(time.Hour))
+
+	tests := []struct {
+		name     string
+		req      *v1.ListAuditEventsRequest
+		want     []string
+		wantCode codes.Code
+	}{
+		{
+			name: "Task",
+			req:  &v1.ListAuditEventsRequest{ToDoId: id},
+			want: []string{"Undelete", "Delete", "Update", "Create"},
+		},
+		{
+			name: "Unverified actor",
+			req:  &v1.ListAuditEventsRequest{UnverifiedActor: "bob"},
+			want: []string{"Create"},
+		},
+		{
+			// identity declared by caller is not trusted as actor
+			name: "Actor",
+			req:  &v1.ListAuditEventsRequest{Actor: "bob"},
+		},
+		{
+			name: "Time range",
+			req:  &v1.ListAuditEventsRequest{Start: future},
+		},
+		{
+			name:     "Empty time range",
+			req:      &v1.ListAuditEventsRequest{Start: future, End: future},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		for _, transport := range []string{"gRPC", "REST"} {
+			t.Run(tt.name+"/"+transport, func(t *testing.T) {
+				client := srv.Client
+				if transport == "REST" {
+					client = srv.REST
+				}
+				res, err := client.ListAuditEvents(context.Background(), tt.req)
+				if status.Code(err) != tt.wantCode {
+					t.Fatalf("ListAuditEvents() error = %v, want code %v", err, tt.wantCode)
+				}
+				if err != nil {
+					return
+				}
+				var got []string
+				for _, e := range res.Events {
+					got = append(got, e.Rpc)
+					if len(e.RequestId) == 0 || e.Time == nil {
+						t.Errorf("event %v misses request ID or time", e)
+					}
+				}
+				if !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("ListAuditEvents() = %v, want %v", got, tt.want)
+				}
+			})
+		}
+	}
+
+	// snapshots show the change
+	res, err := srv.Client.ListAuditEvents(context.Background(), &v1.ListAuditEventsRequest{ToDoId: id, UnverifiedActor: "alice"})
+	if err != nil || len(res.Events) != 4 {
+		t.Fatalf("ListAuditEvents() = %v, %v", res, err)
+	}
+	for _, e := range res.Events {
+		if e.Actor != "" || e.UnverifiedActor != "alice" {
+			t.Errorf("event actor = %q, unverified actor = %q, want unverified alice", e.Actor, e.UnverifiedActor)
+		}
+	}
+	update, del := res.Events[2], res.Events[1]
+	if update.Before.GetTitle() != "audited" || update.After.GetTitle() != "changed" {
+		t.Errorf("Update snapshots = %v, %v", update.Before, update.After)
+	}
+	if del.Before.GetTitle() != "changed" || del.After != nil {
+		t.Errorf("Delete snapshots = %v, %v", del.Before, del.After)
+	}
+	if create := res.Events[3]; create.Before != nil || create.After.GetId() != id {
+		t.Errorf("Create snapshots = %v, %v", create.Before, create.After)
+	}
+}
+
+func TestListAuditEvents_GatewayRequestID(t *testing.T) {
+	srv := todotest.Start(t, todotest.Config{})
+	defer srv.Close()
+
+	// request ID returned by HTTP/REST gateway is the one gRPC server recorded
+	body := `{"toDo":{"title":"over REST","reminder":"2019-06-01T10:00:00Z"}}`
+	req, err := http.NewRequest(http.MethodPost, srv.URL+"/v1/todo", strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("X-Caller-Id", "alice")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("HTTP status = %d", resp.StatusCode)
+	}
+
+	res, err := srv.Client.ListAuditEvents(context.Background(), &v1.ListAuditEventsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := resp.Header.Get("X-Request-Id")
+	if len(res.Events) != 1 || len(id) == 0 || res.Events[0].RequestId != id || res.Events[0].UnverifiedActor != "alice" {
+		t.Errorf("ListAuditEvents() = %v, want event of request %q by alice", res.Events, id)
+	}
+}
@@ -0,0 +1,352 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/jinzhu/gorm"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
+	"go.smartmachine.io/go-grpc-api/pkg/logger"
+)
+
+const (
+	// maxBatchSize is the largest number of items in one batch, bigger imports are split by client
+	maxBatchSize = 1000
+
+	// batchSavepoint is name of savepoint every item of PER_ITEM batch is applied in
+	batchSavepoint = "batch_item"
+)
+
+// checkBatch checks API version and size of batch
+func (s *toDoServiceServer) checkBatch(api string, size int) error {
+	// check if the API version requested by client is supported by server
+	if err := s.checkAPI(api); err != nil {
+		return err
+	}
+	if size > maxBatchSize {
+		return status.Errorf(codes.InvalidArgument, "batch of %d items exceeds limit of %d items", size, maxBatchSize)
+	}
+	return nil
+}
+
+// inTransaction runs fn in database transaction, transaction is rolled back if fn fails
+func (s *toDoServiceServer) inTransaction(fn func(tx *gorm.DB) error) error {
+	tx := s.db.Begin()
+	if tx.Error != nil {
+		return status.Errorf(codes.Internal, "unable to begin transaction: %v", tx.Error)
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := tx.Commit().Error; err != nil {
+		return status.Errorf(codes.Internal, "unable to commit transaction: %v", err)
+	}
+	return nil
+}
+
+// applyItem applies item of PER_ITEM batch in savepoint, so failed item is rolled back alone
+func applyItem(tx *gorm.DB, fn func() error) error {
+	if err := tx.Exec("SAVEPOINT " + batchSavepoint).Error; err != nil {
+		return status.Errorf(codes.Internal, "unable to create savepoint: %v", err)
+	}
+	if err := fn(); err != nil {
+		tx.Exec("ROLLBACK TO SAVEPOINT " + batchSavepoint)
+		tx.Exec("RELEASE SAVEPOINT " + batchSavepoint)
+		return err
+	}
+	if err := tx.Exec("RELEASE SAVEPOINT " + batchSavepoint).Error; err != nil {
+		return status.Errorf(codes.Internal, "unable to release savepoint: %v", err)
+	}
+	return nil
+}
+
+// itemError returns error failing ALL_OR_NOTHING batch because of item i of field
+func itemError(field string, i int, err error) error {
+	st := status.Convert(err)
+	return status.Errorf(st.Code(), "%s[%d]: %s", field, i, st.Message())
+}
+
+// batch applies items one by one in one transaction. apply returns ID of applied item.
+// ALL_OR_NOTHING batch fails on the first item which cannot be applied,
+// PER_ITEM batch records status of every item and applies the rest.
+func (s *toDoServiceServer) batch(mode v1.BatchMode, field string, size int,
+	apply func(tx *gorm.DB, i int) (int64, error)) ([]*v1.BatchResult, error) {
+	results := make([]*v1.BatchResult, size)
+	err := s.inTransaction(func(tx *gorm.DB) error {
+		for i := 0; i < size; i++ {
+			var id int64
+			var err error
+			if mode == v1.BatchMode_PER_ITEM {
+				err = applyItem(tx, func() (err error) {
+					id, err = apply(tx, i)
+					return err
+				})
+			} else {
+				id, err = apply(tx, i)
+			}
+			if err != nil && mode != v1.BatchMode_PER_ITEM {
+				return itemError(field, i, err)
+			}
+			results[i] = &v1.BatchResult{Id: id, Status: status.Convert(err).Proto()}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// applied counts applied items of batch
+func applied(results []*v1.BatchResult) int64 {
+	var n int64
+	for _, r := range results {
+		if r.Status.GetCode() == int32(codes.OK) {
+			n++
+		}
+	}
+	return n
+}
+
+// existing returns tasks which exist in database by ID
+func existing(ctx context.Context, tx *gorm.DB, ids []int64) (map[int64]*v1.ToDo, error) {
+	var orms []*v1.ToDoORM
+	// IDs are looked up in chunks to stay within limit of SQL variables
+	for len(ids) > 0 {
+		n := len(ids)
+		if n > importChunkSize {
+			n = importChunkSize
+		}
+		var found []*v1.ToDoORM
+		if err := withoutPreload(tx).Where("id in (?)", ids[:n]).Find(&found).Error; err != nil {
+			return nil, status.Errorf(codes.Internal, "unable to read records, internal error: %v", err)
+		}
+		orms = append(orms, found...)
+		ids = ids[n:]
+	}
+	if err := loadTags(tx, orms); err != nil {
+		return nil, err
+	}
+	exist := make(map[int64]*v1.ToDo, len(orms))
+	for _, orm := range orms {
+		td, err := snapshot(ctx, orm)
+		if err != nil {
+			return nil, err
+		}
+		exist[td.Id] = td
+	}
+	return exist, nil
+}
+
+// createItem validates and inserts task of batch or import
+func createItem(ctx context.Context, tx *gorm.DB, td *v1.ToDo) (*v1.ToDo, error) {
+	if td == nil {
+		return nil, status.Error(codes.InvalidArgument, "task is missing")
+	}
+	if _, err := ptypes.Timestamp(td.Reminder); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "reminder field has invalid format-> "+err.Error())
+	}
+	if err := checkRecurrence(td); err != nil {
+		return nil, err
+	}
+	if err := checkTags(td); err != nil {
+		return nil, err
+	}
+	if err := checkToDoProject(tx, td); err != nil {
+		return nil, err
+	}
+	created, err := v1.DefaultCreateToDo(ctx, td, tx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to insert, internal error: %v", err)
+	}
+	if len(td.Tags) > 0 {
+		if err := saveTags(tx, created.Id, td.Tags); err != nil {
+			return nil, err
+		}
+		created.Tags = td.Tags
+	}
+	if err := recordChange(ctx, tx, nil, created); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// BatchCreate creates todo tasks in one transaction
+func (s *toDoServiceServer) BatchCreate(ctx context.Context, req *v1.BatchCreateRequest) (*v1.BatchCreateResponse, error) {
+	if err := s.checkBatch(req.Api, len(req.ToDos)); err != nil {
+		return nil, err
+	}
+
+	created := make([]*v1.ToDo, len(req.ToDos))
+	results, err := s.batch(req.Mode, "toDos", len(req.ToDos), func(tx *gorm.DB, i int) (int64, error) {
+		td, err := createItem(ctx, tx, req.ToDos[i])
+		if err != nil {
+			return 0, err
+		}
+		created[i] = td
+		return td.Id, nil
+	})
+	if err != nil {
+		logger.FromContext(ctx).Warn("failed to create todo tasks", zap.Error(err))
+		return nil, err
+	}
+
+	for i, r := range results {
+		if r.Status.GetCode() == int32(codes.OK) {
+			s.publish(v1.EventType_CREATED, created[i])
+		}
+	}
+	n := applied(results)
+	logger.FromContext(ctx).Info("todo tasks created", zap.Int64("created", n), zap.Int("batch", len(results)))
+
+	return &v1.BatchCreateResponse{
+		Api:     apiVersion,
+		Created: n,
+		Results: results,
+	}, nil
+}
+
+// BatchUpdate updates existing todo tasks in one transaction
+func (s *toDoServiceServer) BatchUpdate(ctx context.Context, req *v1.BatchUpdateRequest) (*v1.BatchUpdateResponse, error) {
+	if err := s.checkBatch(req.Api, len(req.ToDos)); err != nil {
+		return nil, err
+	}
+
+	results, err := s.batch(req.Mode, "toDos", len(req.ToDos), func(tx *gorm.DB, i int) (int64, error) {
+		td := req.ToDos[i]
+		if td == nil {
+			return 0, status.Error(codes.InvalidArgument, "task is missing")
+		}
+		if td.Id <= 0 {
+			return td.Id, status.Errorf(codes.InvalidArgument, "invalid task id: %d", td.Id)
+		}
+		if err := checkRecurrence(td); err != nil {
+			return td.Id, err
+		}
+		if err := checkTags(td); err != nil {
+			return td.Id, err
+		}
+		exist, err := existing(ctx, tx, []int64{td.Id})
+		if err != nil {
+			return td.Id, err
+		}
+		before := exist[td.Id]
+		if before == nil {
+			return td.Id, status.Errorf(codes.NotFound, "record not found: %d", td.Id)
+		}
+		if err := checkToDoProject(tx, td); err != nil {
+			return td.Id, err
+		}
+		orm, err := td.ToORM(ctx)
+		if err != nil {
+			return td.Id, status.Error(codes.Internal, "unable to convert to orm representation: "+err.Error())
+		}
+		orm.DeletedAt = nil
+		if err := tx.Save(&orm).Error; err != nil {
+			return td.Id, status.Errorf(codes.Internal, "error updating record: %v", err)
+		}
+		if err := saveTags(tx, td.Id, td.Tags); err != nil {
+			return td.Id, err
+		}
+		after, err := snapshot(ctx, &orm)
+		if err != nil {
+			return td.Id, err
+		}
+		after.Tags = td.Tags
+		return td.Id, recordChange(ctx, tx, before, after)
+	})
+	if err != nil {
+		logger.FromContext(ctx).Warn("failed to update todo tasks", zap.Error(err))
+		return nil, err
+	}
+
+	for i, r := range results {
+		if r.Status.GetCode() == int32(codes.OK) {
+			s.publish(v1.EventType_UPDATED, req.ToDos[i])
+		}
+	}
+	n := applied(results)
+	logger.FromContext(ctx).Info("todo tasks updated", zap.Int64("updated", n), zap.Int("batch", len(results)))
+
+	return &v1.BatchUpdateResponse{
+		Api:     apiVersion,
+		Updated: n,
+		Results: results,
+	}, nil
+}
+
+// BatchDelete deletes existing todo tasks in one transaction
+func (s *toDoServiceServer) BatchDelete(ctx context.Context, req *v1.BatchDeleteRequest) (*v1.BatchDeleteResponse, error) {
+	if err := s.checkBatch(req.Api, len(req.Ids)); err != nil {
+		return nil, err
+	}
+
+	results := make([]*v1.BatchResult, len(req.Ids))
+	err := s.inTransaction(func(tx *gorm.DB) error {
+		exist, err := existing(ctx, tx, req.Ids)
+		if err != nil {
+			return err
+		}
+
+		// tasks are checked first and all existing ones are deleted together
+		var toDos []*v1.ToDo
+		for i, id := range req.Ids {
+			switch {
+			case id <= 0:
+				err = status.Errorf(codes.InvalidArgument, "invalid task id: %d", id)
+			case exist[id] == nil:
+				err = status.Errorf(codes.NotFound, "record not found: %d", id)
+			default:
+				err = nil
+				toDos = append(toDos, exist[id])
+				// task listed again is already deleted, so it is not found
+				exist[id] = nil
+			}
+			if err != nil && req.Mode != v1.BatchMode_PER_ITEM {
+				return itemError("ids", i, err)
+			}
+			results[i] = &v1.BatchResult{Id: id, Status: status.Convert(err).Proto()}
+		}
+
+		// tasks are deleted in chunks to stay within limit of SQL variables
+		for rest := toDos; len(rest) > 0; {
+			n := len(rest)
+			if n > importChunkSize {
+				n = importChunkSize
+			}
+			if err := v1.DefaultDeleteToDoSet(ctx, rest[:n], tx); err != nil {
+				return status.Errorf(codes.Internal, "unable to delete, internal error: %v", err)
+			}
+			rest = rest[n:]
+		}
+		for _, td := range toDos {
+			if err := recordChange(ctx, tx, td, nil); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		logger.FromContext(ctx).Warn("failed to delete todo tasks", zap.Error(err))
+		return nil, err
+	}
+
+	for _, r := range results {
+		if r.Status.GetCode() == int32(codes.OK) {
+			s.publish(v1.EventType_DELETED, &v1.ToDo{Id: r.Id})
+		}
+	}
+	n := applied(results)
+	logger.FromContext(ctx).Info("todo tasks deleted", zap.Int64("deleted", n), zap.Int("batch", len(results)))
+
+	return &v1.BatchDeleteResponse{
+		Api:     apiVersion,
+		Deleted: n,
+		Results: results,
+	}, nil
+}
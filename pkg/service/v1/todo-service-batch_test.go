@@ -0,0 +1,196 @@
+package v1_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
+	"go.smartmachine.io/go-grpc-api/pkg/testing/todotest"
+)
+
+func TestBatch(t *testing.T) {
+	reminder, _ := ptypes.TimestampProto(time.Date(2019, 6, 1, 10, 0, 0, 0, time.UTC))
+	valid := &v1.ToDo{Title: "valid", Reminder: reminder}
+	invalid := &v1.ToDo{Title: "invalid"}
+
+	tests := []struct {
+		name string
+		call func(ctx context.Context, c v1.ToDoServiceClient) ([]*v1.BatchResult, error)
+		// wantCode is expected status code of the call
+		wantCode codes.Code
+		// wantResults are expected status codes of items
+		wantResults []codes.Code
+		// wantTasks is number of tasks after the call, 2 tasks are seeded
+		wantTasks int
+		// wantEvents is number of changes published by the call
+		wantEvents int64
+	}{
+		{
+			name: "Create",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) ([]*v1.BatchResult, error) {
+				res, err := c.BatchCreate(ctx, &v1.BatchCreateRequest{ToDos: []*v1.ToDo{valid, valid}})
+				return res.GetResults(), err
+			},
+			wantResults: []codes.Code{codes.OK, codes.OK},
+			wantTasks:   4, wantEvents: 2,
+		},
+		{
+			name: "Create rolls back",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) ([]*v1.BatchResult, error) {
+				res, err := c.BatchCreate(ctx, &v1.BatchCreateRequest{ToDos: []*v1.ToDo{valid, invalid, valid}})
+				return res.GetResults(), err
+			},
+			wantCode:  codes.InvalidArgument,
+			wantTasks: 2,
+		},
+		{
+			name: "Create per item",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) ([]*v1.BatchResult, error) {
+				res, err := c.BatchCreate(ctx, &v1.BatchCreateRequest{Mode: v1.BatchMode_PER_ITEM,
+					ToDos: []*v1.ToDo{valid, invalid, {}, valid}})
+				return res.GetResults(), err
+			},
+			wantResults: []codes.Code{codes.OK, codes.InvalidArgument, codes.InvalidArgument, codes.OK},
+			wantTasks:   4, wantEvents: 2,
+		},
+		{
+			name: "Create too many",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) ([]*v1.BatchResult, error) {
+				toDos := make([]*v1.ToDo, 1001)
+				for i := range toDos {
+					toDos[i] = valid
+				}
+				res, err := c.BatchCreate(ctx, &v1.BatchCreateRequest{ToDos: toDos})
+				return res.GetResults(), err
+			},
+			wantCode:  codes.InvalidArgument,
+			wantTasks: 2,
+		},
+		{
+			name: "Update rolls back",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) ([]*v1.BatchResult, error) {
+				res, err := c.BatchUpdate(ctx, &v1.BatchUpdateRequest{ToDos: []*v1.ToDo{
+					{Id: 1, Title: "updated", Reminder: reminder}, {Id: 100, Title: "updated", Reminder: reminder}}})
+				return res.GetResults(), err
+			},
+			wantCode:  codes.NotFound,
+			wantTasks: 2,
+		},
+		{
+			name: "Update per item",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) ([]*v1.BatchResult, error) {
+				res, err := c.BatchUpdate(ctx, &v1.BatchUpdateRequest{Mode: v1.BatchMode_PER_ITEM, ToDos: []*v1.ToDo{
+					{Id: 1, Title: "updated", Reminder: reminder}, {Id: 100, Title: "updated", Reminder: reminder},
+					{Title: "no id", Reminder: reminder}}})
+				return res.GetResults(), err
+			},
+			wantResults: []codes.Code{codes.OK, codes.NotFound, codes.InvalidArgument},
+			wantTasks:   2, wantEvents: 1,
+		},
+		{
+			name: "Delete",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) ([]*v1.BatchResult, error) {
+				res, err := c.BatchDelete(ctx, &v1.BatchDeleteRequest{Ids: []int64{1, 2}})
+				return res.GetResults(), err
+			},
+			wantResults: []codes.Code{codes.OK, codes.OK},
+			wantTasks:   0, wantEvents: 2,
+		},
+		{
+			name: "Delete rolls back",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) ([]*v1.BatchResult, error) {
+				res, err := c.BatchDelete(ctx, &v1.BatchDeleteRequest{Ids: []int64{1, 1}})
+				return res.GetResults(), err
+			},
+			wantCode:  codes.NotFound,
+			wantTasks: 2,
+		},
+		{
+			name: "Delete per item",
+			call: func(ctx context.Context, c v1.ToDoServiceClient) ([]*v1.BatchResult, error) {
+				res, err := c.BatchDelete(ctx, &v1.BatchDeleteRequest{Mode: v1.BatchMode_PER_ITEM, Ids: []int64{2, 100, 0}})
+				return res.GetResults(), err
+			},
+			wantResults: []codes.Code{codes.OK, codes.NotFound, codes.InvalidArgument},
+			wantTasks:   1, wantEvents: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			srv := todotest.Start(t, todotest.Config{})
+			defer srv.Close()
+			srv.Seed(t, valid, valid)
+			seq := srv.Bus.Sequence()
+
+			results, err := tt.call(ctx, srv.Client)
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("call error = %v, want code %v", err, tt.wantCode)
+			}
+			if len(results) != len(tt.wantResults) {
+				t.Fatalf("results = %v, want %v", results, tt.wantResults)
+			}
+			for i, r := range results {
+				if codes.Code(r.Status.GetCode()) != tt.wantResults[i] {
+					t.Errorf("results[%d] = %v, want %v", i, r.Status, tt.wantResults[i])
+				}
+			}
+
+			all, err := srv.Client.ReadAll(ctx, &v1.ReadAllRequest{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(all.ToDos) != tt.wantTasks {
+				t.Errorf("tasks after call = %d, want %d", len(all.ToDos), tt.wantTasks)
+			}
+			if got := srv.Bus.Sequence() - seq; got != tt.wantEvents {
+				t.Errorf("published %d changes, want %d", got, tt.wantEvents)
+			}
+		})
+	}
+}
+
+func TestBatch_MaxSize(t *testing.T) {
+	ctx := context.Background()
+	srv := todotest.Start(t, todotest.Config{})
+	defer srv.Close()
+
+	// batch of the largest allowed size binds more IDs than SQLite allows variables in one statement
+	const size = 1000
+	reminder, _ := ptypes.TimestampProto(time.Date(2019, 6, 1, 10, 0, 0, 0, time.UTC))
+	toDos := make([]*v1.ToDo, size)
+	for i := range toDos {
+		toDos[i] = &v1.ToDo{Title: "task", Reminder: reminder, Tags: []string{"home"}}
+	}
+	created, err := srv.Client.BatchCreate(ctx, &v1.BatchCreateRequest{ToDos: toDos})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.Created != size {
+		t.Fatalf("created %d tasks, want %d", created.Created, size)
+	}
+
+	ids := make([]int64, size)
+	for i, r := range created.Results {
+		ids[i] = r.Id
+	}
+	deleted, err := srv.Client.BatchDelete(ctx, &v1.BatchDeleteRequest{Ids: ids})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deleted.Deleted != size {
+		t.Errorf("deleted %d tasks, want %d", deleted.Deleted, size)
+	}
+	trash, err := srv.Client.ListDeleted(ctx, &v1.ListDeletedRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(trash.ToDos) != size || len(trash.ToDos[0].Tags) != 1 {
+		t.Errorf("trash has %d tasks, want %d with tags", len(trash.ToDos), size)
+	}
+}
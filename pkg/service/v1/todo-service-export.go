@@ -0,0 +1,369 @@
+package v1
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/ptypes"
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
+	"go.smartmachine.io/go-grpc-api/pkg/logger"
+)
+
+const (
+	// exportPageSize is number of tasks read from database at once
+	exportPageSize = 500
+
+	// exportChunkSize is size in bytes file is sent in chunks of
+	exportChunkSize = 32 << 10
+
+	// contentTypeKey is header metadata key with content type of exported file,
+	// HTTP/REST gateway returns it as Content-Type header
+	contentTypeKey = "x-content-type"
+	// contentDispositionKey is header metadata key with file name of exported file
+	contentDispositionKey = "content-disposition"
+)
+
+// encoder writes tasks in export file format
+type encoder interface {
+	// encode writes task
+	encode(td *v1.ToDo) error
+	// close writes end of file
+	close() error
+}
+
+// exportFormat is file format of export
+type exportFormat struct {
+	contentType string
+	extension   string
+	newEncoder  func(w io.Writer) encoder
+}
+
+// exportFormats are supported export file formats by name
+var exportFormats = map[string]exportFormat{
+	"csv":   {contentType: "text/csv; charset=utf-8", extension: "csv", newEncoder: newCSVEncoder},
+	"jsonl": {contentType: "application/x-ndjson", extension: "jsonl", newEncoder: newJSONLEncoder},
+	"ics":   {contentType: "text/calendar; charset=utf-8", extension: "ics", newEncoder: newICSEncoder},
+}
+
+// reminderTime returns reminder of task, zero time if it is not set
+func reminderTime(td *v1.ToDo) time.Time {
+	if td.Reminder == nil {
+		return time.Time{}
+	}
+	t, err := ptypes.Timestamp(td.Reminder)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
+
+// csvHeader is header row of CSV export
+var csvHeader = []string{"id", "title", "description", "reminder", "externalId"}
+
+// csvEncoder writes tasks as CSV with header row
+type csvEncoder struct {
+	w      *csv.Writer
+	header bool
+}
+
+func newCSVEncoder(w io.Writer) encoder {
+	return &csvEncoder{w: csv.NewWriter(w)}
+}
+
+// row writes record, header row is written first
+func (e *csvEncoder) row(record []string) error {
+	if !e.header {
+		e.header = true
+		if err := e.w.Write(csvHeader); err != nil {
+			return err
+		}
+	}
+	if record != nil {
+		if err := e.w.Write(record); err != nil {
+			return err
+		}
+	}
+	e.w.Flush()
+	return e.w.Error()
+}
+
+func (e *csvEncoder) encode(td *v1.ToDo) error {
+	var reminder string
+	if t := reminderTime(td); !t.IsZero() {
+		reminder = t.Format(time.RFC3339)
+	}
+	return e.row([]string{strconv.FormatInt(td.Id, 10), td.Title, td.Description, reminder, td.ExternalId})
+}
+
+func (e *csvEncoder) close() error {
+	// empty export has header row only
+	return e.row(nil)
+}
+
+// jsonlEncoder writes tasks as JSON Lines, one JSON object per line
+type jsonlEncoder struct {
+	w io.Writer
+	m jsonpb.Marshaler
+}
+
+func newJSONLEncoder(w io.Writer) encoder {
+	return &jsonlEncoder{w: w, m: jsonpb.Marshaler{OrigName: true}}
+}
+
+func (e *jsonlEncoder) encode(td *v1.ToDo) error {
+	if err := e.m.Marshal(e.w, td); err != nil {
+		return err
+	}
+	_, err := io.WriteString(e.w, "\n")
+	return err
+}
+
+func (e *jsonlEncoder) close() error {
+	return nil
+}
+
+// icsEncoder writes tasks as iCalendar (RFC 5545) VTODO components,
+// reminder is start of the task with display alarm
+type icsEncoder struct {
+	w       io.Writer
+	started bool
+	stamp   string
+}
+
+func newICSEncoder(w io.Writer) encoder {
+	return &icsEncoder{w: w, stamp: time.Now().UTC().Format(icsTimeFormat)}
+}
+
+// icsTimeFormat is iCalendar UTC date-time format
+const icsTimeFormat = "20060102T150405Z"
+
+// icsLineLimit is the longest content line in octets, longer lines are folded
+const icsLineLimit = 75
+
+// icsEscaper escapes iCalendar TEXT values
+var icsEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+
+// line writes content line folded at 75 octets without splitting UTF-8 characters
+func (e *icsEncoder) line(name, value string) error {
+	s := name + ":" + value
+	var b strings.Builder
+	for len(s) > icsLineLimit {
+		n := icsLineLimit
+		if b.Len() > 0 {
+			// continuation lines start with space
+			n--
+		}
+		for n > 0 && s[n]&0xC0 == 0x80 {
+			n--
+		}
+		b.WriteString(s[:n])
+		b.WriteString("\r\n ")
+		s = s[n:]
+	}
+	b.WriteString(s)
+	b.WriteString("\r\n")
+	_, err := io.WriteString(e.w, b.String())
+	return err
+}
+
+// lines writes content lines given as name, value pairs
+func (e *icsEncoder) lines(pairs ...string) error {
+	for i := 0; i < len(pairs); i += 2 {
+		if err := e.line(pairs[i], pairs[i+1]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *icsEncoder) start() error {
+	if e.started {
+		return nil
+	}
+	e.started = true
+	return e.lines("BEGIN", "VCALENDAR", "VERSION", "2.0", "PRODID", "-//go-grpc-api//ToDo service//EN")
+}
+
+func (e *icsEncoder) encode(td *v1.ToDo) error {
+	if err := e.start(); err != nil {
+		return err
+	}
+	// imported tasks keep UID of their file
+	uid := td.ExternalId
+	if len(uid) == 0 {
+		uid = fmt.Sprintf("todo-%d@go-grpc-api", td.Id)
+	}
+	if err := e.lines(
+		"BEGIN", "VTODO",
+		"UID", uid,
+		"DTSTAMP", e.stamp,
+		"SUMMARY", icsEscaper.Replace(td.Title),
+	); err != nil {
+		return err
+	}
+	if len(td.Description) > 0 {
+		if err := e.line("DESCRIPTION", icsEscaper.Replace(td.Description)); err != nil {
+			return err
+		}
+	}
+	if td.Done {
+		if err := e.line("STATUS", "COMPLETED"); err != nil {
+			return err
+		}
+	}
+	if t := reminderTime(td); !t.IsZero() {
+		if err := e.line("DTSTART", t.UTC().Format(icsTimeFormat)); err != nil {
+			return err
+		}
+		if len(td.Recurrence) > 0 {
+			if err := e.line("RRULE", strings.TrimPrefix(td.Recurrence, "RRULE:")); err != nil {
+				return err
+			}
+		}
+		if err := e.lines(
+			"BEGIN", "VALARM",
+			"ACTION", "DISPLAY",
+			"DESCRIPTION", icsEscaper.Replace(td.Title),
+			"TRIGGER;RELATED=START", "PT0S",
+			"END", "VALARM",
+		); err != nil {
+			return err
+		}
+	}
+	return e.line("END", "VTODO")
+}
+
+func (e *icsEncoder) close() error {
+	if err := e.start(); err != nil {
+		return err
+	}
+	return e.line("END", "VCALENDAR")
+}
+
+// chunkWriter sends written bytes as HttpBody messages of at least exportChunkSize bytes
+type chunkWriter struct {
+	stream      v1.ToDoService_ExportServer
+	contentType string
+	buf         bytes.Buffer
+	size        int
+}
+
+func (w *chunkWriter) Write(b []byte) (int, error) {
+	n, _ := w.buf.Write(b)
+	if w.buf.Len() >= exportChunkSize {
+		return n, w.flush()
+	}
+	return n, nil
+}
+
+// flush sends buffered bytes
+func (w *chunkWriter) flush() error {
+	if w.buf.Len() == 0 {
+		return nil
+	}
+	w.size += w.buf.Len()
+	// data is copied, buffer is reused for the next chunk
+	data := append([]byte(nil), w.buf.Bytes()...)
+	w.buf.Reset()
+	return w.stream.Send(&httpbody.HttpBody{ContentType: w.contentType, Data: data})
+}
+
+// Export todo tasks as file
+func (s *toDoServiceServer) Export(req *v1.ExportRequest, stream v1.ToDoService_ExportServer) error {
+	// check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return err
+	}
+	name := strings.ToLower(req.Format)
+	if len(name) == 0 {
+		name = "csv"
+	}
+	format, ok := exportFormats[name]
+	if !ok {
+		return status.Errorf(codes.InvalidArgument, "unsupported export format '%s', expected csv, jsonl or ics", req.Format)
+	}
+
+	q := s.db.Model(&v1.ToDoORM{})
+	// selected IDs are filtered page by page in ascending order to stay within limit of SQL variables
+	ids := append([]int64(nil), req.Ids...)
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	if req.ReminderAfter != nil {
+		t, err := ptypes.Timestamp(req.ReminderAfter)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "reminderAfter field has invalid format-> %v", err)
+		}
+		q = q.Where("reminder >= ?", t)
+	}
+	if req.ReminderBefore != nil {
+		t, err := ptypes.Timestamp(req.ReminderBefore)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "reminderBefore field has invalid format-> %v", err)
+		}
+		q = q.Where("reminder < ?", t)
+	}
+
+	ctx := stream.Context()
+	if err := stream.SendHeader(metadata.Pairs(
+		contentTypeKey, format.contentType,
+		contentDispositionKey, fmt.Sprintf(`attachment; filename="todo.%s"`, format.extension),
+	)); err != nil {
+		return err
+	}
+
+	w := &chunkWriter{stream: stream, contentType: format.contentType}
+	enc := format.newEncoder(w)
+	var count int
+	// tasks are read in pages, so slow client does not hold database connection
+	for last := int64(0); ; {
+		pq := q.Where("id > ?", last)
+		if len(req.Ids) > 0 {
+			n := len(ids)
+			if n > exportPageSize {
+				n = exportPageSize
+			}
+			pq = pq.Where("id in (?)", ids[:n])
+			ids = ids[n:]
+		}
+		var page []*v1.ToDoORM
+		if err := pq.Order("id").Limit(exportPageSize).Find(&page).Error; err != nil {
+			return status.Errorf(codes.Internal, "unable to read records, internal error: %v", err)
+		}
+		for _, orm := range page {
+			td, err := orm.ToPB(ctx)
+			if err != nil {
+				return status.Error(codes.Internal, "unable to convert to orm representation: "+err.Error())
+			}
+			if err := enc.encode(&td); err != nil {
+				return err
+			}
+			last = orm.Id
+			count++
+		}
+		if (len(req.Ids) > 0 && len(ids) == 0) || (len(req.Ids) == 0 && len(page) < exportPageSize) {
+			break
+		}
+	}
+	if err := enc.close(); err != nil {
+		return err
+	}
+	if err := w.flush(); err != nil {
+		return err
+	}
+
+	logger.FromContext(ctx).Info("todo tasks exported",
+		zap.String("format", name), zap.Int("count", count), zap.Int("size", w.size))
+	return nil
+}
@@ -0,0 +1,156 @@
+package v1_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
+	"go.smartmachine.io/go-grpc-api/pkg/testing/todotest"
+)
+
+func TestExport(t *testing.T) {
+	june, _ := ptypes.TimestampProto(time.Date(2019, 6, 1, 10, 0, 0, 0, time.UTC))
+	july, _ := ptypes.TimestampProto(time.Date(2019, 7, 1, 10, 0, 0, 0, time.UTC))
+	toDos := []*v1.ToDo{
+		{Title: "title 1", Description: "with, comma", Reminder: june},
+		{Title: "title 2", Description: "line\nbreak", Reminder: july},
+	}
+	// more IDs than SQLite allows variables in one statement, in descending order
+	many := make([]int64, 2000)
+	for i := range many {
+		many[i] = int64(len(many) - i)
+	}
+
+	tests := []struct {
+		name            string
+		req             *v1.ExportRequest
+		wantCode        codes.Code
+		wantContentType string
+		wantLines       []string
+	}{
+		{
+			name:            "CSV",
+			req:             &v1.ExportRequest{Format: "csv"},
+			wantContentType: "text/csv; charset=utf-8",
+			wantLines: []string{
+				"id,title,description,reminder,externalId",
+				`1,title 1,"with, comma",2019-06-01T10:00:00Z,`,
+				`2,title 2,"line`,
+			},
+		},
+		{
+			name:            "JSON Lines filtered by ids",
+			req:             &v1.ExportRequest{Format: "jsonl", Ids: []int64{2}},
+			wantContentType: "application/x-ndjson",
+			wantLines: []string{
+				`{"id":"2","title":"title 2","description":"line\nbreak","reminder":"2019-07-01T10:00:00Z"}`,
+			},
+		},
+		{
+			name:            "JSON Lines filtered by many ids",
+			req:             &v1.ExportRequest{Format: "jsonl", Ids: many},
+			wantContentType: "application/x-ndjson",
+			wantLines: []string{
+				`{"id":"1","title":"title 1","description":"with, comma","reminder":"2019-06-01T10:00:00Z"}`,
+				`{"id":"2","title":"title 2","description":"line\nbreak","reminder":"2019-07-01T10:00:00Z"}`,
+			},
+		},
+		{
+			name:            "iCalendar filtered by reminder",
+			req:             &v1.ExportRequest{Format: "ics", ReminderBefore: july},
+			wantContentType: "text/calendar; charset=utf-8",
+			wantLines: []string{
+				"BEGIN:VCALENDAR",
+				"BEGIN:VTODO",
+				"UID:todo-1@go-grpc-api",
+				`DESCRIPTION:with\, comma`,
+				"DTSTART:20190601T100000Z",
+				"BEGIN:VALARM",
+				"TRIGGER;RELATED=START:PT0S",
+				"END:VCALENDAR",
+			},
+		},
+		{
+			name:            "Empty",
+			req:             &v1.ExportRequest{Ids: []int64{100}},
+			wantContentType: "text/csv; charset=utf-8",
+			wantLines:       []string{"id,title,description,reminder,externalId"},
+		},
+		{
+			name:     "Unsupported format",
+			req:      &v1.ExportRequest{Format: "xml"},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "Unsupported API",
+			req:      &v1.ExportRequest{Api: "v2"},
+			wantCode: codes.Unimplemented,
+		},
+	}
+	for _, tt := range tests {
+		for _, transport := range []string{"gRPC", "REST"} {
+			t.Run(tt.name+"/"+transport, func(t *testing.T) {
+				ctx := context.Background()
+				srv := todotest.Start(t, todotest.Config{})
+				defer srv.Close()
+				client := srv.Client
+				if transport == "REST" {
+					client = srv.REST
+				}
+				for _, td := range toDos {
+					if _, err := srv.Client.Create(ctx, &v1.CreateRequest{Api: "v1", ToDo: td}); err != nil {
+						t.Fatal(err)
+					}
+				}
+
+				stream, err := client.Export(ctx, tt.req)
+				var file bytes.Buffer
+				var contentType string
+				for err == nil {
+					var chunk *httpbody.HttpBody
+					if chunk, err = stream.Recv(); err == nil {
+						file.Write(chunk.Data)
+						contentType = chunk.ContentType
+					}
+				}
+				if err == io.EOF {
+					err = nil
+				}
+				if status.Code(err) != tt.wantCode {
+					t.Fatalf("Export() error = %v, want code %v", err, tt.wantCode)
+				}
+				if err != nil {
+					return
+				}
+
+				if contentType != tt.wantContentType {
+					t.Errorf("content type = %s, want %s", contentType, tt.wantContentType)
+				}
+				got := strings.Split(strings.Replace(file.String(), "\r\n", "\n", -1), "\n")
+				for _, want := range tt.wantLines {
+					if !contains(got, want) {
+						t.Errorf("line %q is missing in\n%s", want, file.String())
+					}
+				}
+			})
+		}
+	}
+}
+
+func contains(lines []string, line string) bool {
+	for _, l := range lines {
+		if l == line {
+			return true
+		}
+	}
+	return false
+}
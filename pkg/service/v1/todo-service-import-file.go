@@ -0,0 +1,420 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"io"
+	"mime"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/jinzhu/gorm"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
+	"go.smartmachine.io/go-grpc-api/pkg/logger"
+)
+
+// errDryRun rolls back transaction of dry run import
+var errDryRun = errors.New("dry run")
+
+// importFileFormats are formats of imported files by media type
+var importFileFormats = map[string]string{
+	"text/csv":      "csv",
+	"text/calendar": "ics",
+}
+
+// fileRow is task read from imported file
+type fileRow struct {
+	toDo *v1.ToDo
+	// err is reason the task cannot be imported
+	err error
+}
+
+// importFileFormat returns format of imported file, it is taken from content type of file if not requested
+func importFileFormat(req *v1.ImportFileRequest) (string, error) {
+	format := strings.ToLower(req.Format)
+	if len(format) == 0 {
+		mt, _, _ := mime.ParseMediaType(req.File.GetContentType())
+		format = importFileFormats[mt]
+		if len(format) == 0 {
+			return "", status.Errorf(codes.InvalidArgument,
+				"format of file with content type '%s' is unknown, expected csv or ics format", req.File.GetContentType())
+		}
+	}
+	if format != "csv" && format != "ics" {
+		return "", status.Errorf(codes.InvalidArgument, "unsupported import format '%s', expected csv or ics", req.Format)
+	}
+	return format, nil
+}
+
+// parseReminder parses reminder of CSV file as RFC 3339 date and time or date only
+func parseReminder(s string) (time.Time, error) {
+	if len(s) == 0 {
+		return time.Time{}, status.Error(codes.InvalidArgument, "reminder is missing")
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		if d, derr := time.Parse("2006-01-02", s); derr == nil {
+			return d, nil
+		}
+		return time.Time{}, status.Error(codes.InvalidArgument, "reminder field has invalid format-> "+err.Error())
+	}
+	return t, nil
+}
+
+// setReminder sets reminder of task read from file, err is returned if the row is invalid
+func setReminder(td *v1.ToDo, t time.Time, err error) error {
+	if err != nil {
+		return err
+	}
+	if td.Reminder, err = ptypes.TimestampProto(t); err != nil {
+		return status.Error(codes.InvalidArgument, "reminder field has invalid format-> "+err.Error())
+	}
+	return nil
+}
+
+// parseCSV reads tasks from CSV file with header row
+func parseCSV(data []byte, columns *v1.CSVColumns) ([]fileRow, error) {
+	r := csv.NewReader(bytes.NewReader(data))
+	// short rows leave missing fields empty
+	r.FieldsPerRecord = -1
+
+	header, err := r.Read()
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid CSV file: %v", err)
+	}
+	index := make(map[string]int, len(header))
+	for i, name := range header {
+		if i == 0 {
+			// UTF-8 byte order mark written by spreadsheets
+			name = strings.TrimPrefix(name, "\ufeff")
+		}
+		name = strings.ToLower(strings.TrimSpace(name))
+		if _, ok := index[name]; !ok {
+			index[name] = i
+		}
+	}
+	// column returns index of field column, -1 if default column is missing
+	column := func(field, name string) (int, error) {
+		if len(name) == 0 {
+			if i, ok := index[strings.ToLower(field)]; ok {
+				return i, nil
+			}
+			return -1, nil
+		}
+		i, ok := index[strings.ToLower(strings.TrimSpace(name))]
+		if !ok {
+			return 0, status.Errorf(codes.InvalidArgument, "column '%s' of %s field is missing in CSV header", name, field)
+		}
+		return i, nil
+	}
+	var cols [4]int
+	for i, c := range []struct{ field, name string }{
+		{"title", columns.GetTitle()},
+		{"description", columns.GetDescription()},
+		{"reminder", columns.GetReminder()},
+		{"externalId", columns.GetExternalId()},
+	} {
+		if cols[i], err = column(c.field, c.name); err != nil {
+			return nil, err
+		}
+	}
+
+	var rows []fileRow
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid CSV file: %v", err)
+		}
+		value := func(i int) string {
+			if i < 0 || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+		td := &v1.ToDo{Title: value(cols[0]), Description: value(cols[1]), ExternalId: value(cols[3])}
+		t, err := parseReminder(value(cols[2]))
+		rows = append(rows, fileRow{toDo: td, err: setReminder(td, t, err)})
+	}
+}
+
+// icsUnescaper unescapes iCalendar TEXT values
+var icsUnescaper = strings.NewReplacer(`\\`, `\`, `\;`, ";", `\,`, ",", `\n`, "\n", `\N`, "\n")
+
+// icsProperty is content line of iCalendar file
+type icsProperty struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+// parseICSLine parses content line "NAME;PARAM=value:value", colons in quoted parameter values are skipped
+func parseICSLine(line string) (icsProperty, bool) {
+	quoted := false
+	for i, c := range line {
+		switch {
+		case c == '"':
+			quoted = !quoted
+		case c == ':' && !quoted:
+			parts := strings.Split(line[:i], ";")
+			p := icsProperty{name: strings.ToUpper(parts[0]), params: map[string]string{}, value: line[i+1:]}
+			for _, param := range parts[1:] {
+				if kv := strings.SplitN(param, "=", 2); len(kv) == 2 {
+					p.params[strings.ToUpper(kv[0])] = strings.Trim(kv[1], `"`)
+				}
+			}
+			return p, true
+		}
+	}
+	return icsProperty{}, false
+}
+
+// icsTime parses DATE or DATE-TIME value, local time is in TZID time zone or UTC
+func icsTime(p icsProperty) (time.Time, error) {
+	loc := time.UTC
+	if tzid := p.params["TZID"]; len(tzid) > 0 {
+		var err error
+		if loc, err = time.LoadLocation(tzid); err != nil {
+			return time.Time{}, status.Errorf(codes.InvalidArgument, "unknown time zone '%s' of %s", tzid, p.name)
+		}
+	}
+	layout := "20060102T150405"
+	switch {
+	case p.params["VALUE"] == "DATE" || len(p.value) == len("20060102"):
+		layout = "20060102"
+	case strings.HasSuffix(p.value, "Z"):
+		layout = icsTimeFormat
+	}
+	t, err := time.ParseInLocation(layout, p.value, loc)
+	if err != nil {
+		return time.Time{}, status.Errorf(codes.InvalidArgument, "%s has invalid format-> %v", p.name, err)
+	}
+	return t, nil
+}
+
+// icsRow converts VTODO or VEVENT component to task, DUE of VTODO is used if DTSTART is not set
+func icsRow(props map[string]icsProperty) fileRow {
+	td := &v1.ToDo{
+		Title:       icsUnescaper.Replace(props["SUMMARY"].value),
+		Description: icsUnescaper.Replace(props["DESCRIPTION"].value),
+		ExternalId:  props["UID"].value,
+		Recurrence:  props["RRULE"].value,
+		Done:        strings.EqualFold(props["STATUS"].value, "COMPLETED"),
+	}
+	start, ok := props["DTSTART"]
+	if !ok {
+		start, ok = props["DUE"]
+	}
+	if !ok {
+		return fileRow{toDo: td, err: status.Error(codes.InvalidArgument, "reminder is missing, DTSTART is not set")}
+	}
+	t, err := icsTime(start)
+	return fileRow{toDo: td, err: setReminder(td, t, err)}
+}
+
+// parseICS reads VTODO and VEVENT components of iCalendar file as tasks,
+// properties of nested components like VALARM are ignored
+func parseICS(data []byte) ([]fileRow, error) {
+	// unfold content lines, continuation lines start with space or tab
+	var lines []string
+	for _, line := range strings.Split(strings.Replace(string(data), "\r\n", "\n", -1), "\n") {
+		if len(lines) > 0 && len(line) > 0 && (line[0] == ' ' || line[0] == '\t') {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		if len(strings.TrimSpace(line)) > 0 {
+			lines = append(lines, line)
+		}
+	}
+	if len(lines) == 0 {
+		return nil, nil
+	}
+
+	var rows []fileRow
+	var stack []string
+	var props map[string]icsProperty
+	for n, line := range lines {
+		p, ok := parseICSLine(line)
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid iCalendar file: line %d has no value", n+1)
+		}
+		switch p.name {
+		case "BEGIN":
+			name := strings.ToUpper(p.value)
+			if len(stack) == 0 && name != "VCALENDAR" {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid iCalendar file: %s component is outside of VCALENDAR", name)
+			}
+			stack = append(stack, name)
+			if len(stack) == 2 && (name == "VTODO" || name == "VEVENT") {
+				props = map[string]icsProperty{}
+			}
+		case "END":
+			name := strings.ToUpper(p.value)
+			if len(stack) == 0 || stack[len(stack)-1] != name {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid iCalendar file: unexpected END:%s at line %d", name, n+1)
+			}
+			if len(stack) == 2 && props != nil {
+				rows = append(rows, icsRow(props))
+				props = nil
+			}
+			stack = stack[:len(stack)-1]
+		default:
+			if len(stack) == 2 && props != nil {
+				if _, ok := props[p.name]; !ok {
+					props[p.name] = p
+				}
+			}
+		}
+	}
+	if len(stack) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid iCalendar file: %s component is not ended", stack[len(stack)-1])
+	}
+	return rows, nil
+}
+
+// knownExternalIDs returns IDs of existing tasks by external IDs of imported tasks
+func knownExternalIDs(tx *gorm.DB, rows []fileRow) (map[string]int64, error) {
+	var ids []string
+	for _, r := range rows {
+		if len(r.toDo.ExternalId) > 0 {
+			ids = append(ids, r.toDo.ExternalId)
+		}
+	}
+	known := make(map[string]int64)
+	// external IDs are looked up in chunks to stay within limit of SQL variables
+	for len(ids) > 0 {
+		n := len(ids)
+		if n > importChunkSize {
+			n = importChunkSize
+		}
+		var found []*v1.ToDoORM
+		if err := tx.Select("id, external_id").Where("external_id in (?)", ids[:n]).Order("id").Find(&found).Error; err != nil {
+			return nil, status.Errorf(codes.Internal, "unable to read records, internal error: %v", err)
+		}
+		for _, orm := range found {
+			if _, ok := known[orm.ExternalId]; !ok {
+				known[orm.ExternalId] = orm.Id
+			}
+		}
+		ids = ids[n:]
+	}
+	return known, nil
+}
+
+// importRows creates tasks of file, tasks with known external ID are skipped
+func (s *toDoServiceServer) importRows(ctx context.Context, tx *gorm.DB, rows []fileRow,
+	res *v1.ImportFileResponse) ([]*v1.ToDo, error) {
+	known, err := knownExternalIDs(tx, rows)
+	if err != nil {
+		return nil, err
+	}
+	var created []*v1.ToDo
+	for i, row := range rows {
+		ext := row.toDo.ExternalId
+		r := &v1.ImportFileResult{Row: int64(i), ExternalId: ext}
+		res.Results = append(res.Results, r)
+
+		if id, ok := known[ext]; ok && len(ext) > 0 {
+			r.Action = v1.ImportAction_SKIP
+			r.Id = id
+			res.Skipped++
+			continue
+		}
+		err := row.err
+		if err == nil {
+			err = applyItem(tx, func() error {
+				td, err := createItem(ctx, tx, row.toDo)
+				if err != nil {
+					return err
+				}
+				created = append(created, td)
+				r.Id = td.Id
+				return nil
+			})
+		}
+		if err != nil {
+			r.Action = v1.ImportAction_REJECT
+			r.Status = status.Convert(err).Proto()
+			res.Rejected++
+			continue
+		}
+		r.Action = v1.ImportAction_CREATE
+		res.Created++
+		if len(ext) > 0 {
+			known[ext] = r.Id
+		}
+	}
+	return created, nil
+}
+
+// ImportFile imports todo tasks from CSV or iCalendar file in one transaction.
+// Dry run imports the file and rolls the transaction back.
+func (s *toDoServiceServer) ImportFile(ctx context.Context, req *v1.ImportFileRequest) (*v1.ImportFileResponse, error) {
+	// check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+	format, err := importFileFormat(req)
+	if err != nil {
+		return nil, err
+	}
+	var rows []fileRow
+	if format == "csv" {
+		rows, err = parseCSV(req.File.GetData(), req.Columns)
+	} else {
+		rows, err = parseICS(req.File.GetData())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	res := &v1.ImportFileResponse{Api: apiVersion, DryRun: req.DryRun}
+	var created []*v1.ToDo
+	err = s.inTransaction(func(tx *gorm.DB) error {
+		var err error
+		if created, err = s.importRows(ctx, tx, rows, res); err != nil {
+			return err
+		}
+		if req.DryRun {
+			return errDryRun
+		}
+		return nil
+	})
+	if err != nil && err != errDryRun {
+		logger.FromContext(ctx).Warn("failed to import todo file", zap.Error(err))
+		return nil, err
+	}
+
+	if req.DryRun {
+		// tasks were rolled back, so their IDs are not valid
+		ids := make(map[int64]bool, len(created))
+		for _, td := range created {
+			ids[td.Id] = true
+		}
+		for _, r := range res.Results {
+			if ids[r.Id] {
+				r.Id = 0
+			}
+		}
+	} else {
+		for _, td := range created {
+			s.publish(v1.EventType_CREATED, td)
+		}
+	}
+	logger.FromContext(ctx).Info("todo file imported", zap.String("format", format), zap.Bool("dryRun", req.DryRun),
+		zap.Int64("created", res.Created), zap.Int64("skipped", res.Skipped), zap.Int64("rejected", res.Rejected))
+
+	return res, nil
+}
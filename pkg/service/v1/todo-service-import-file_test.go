@@ -0,0 +1,220 @@
+package v1_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
+	"go.smartmachine.io/go-grpc-api/pkg/testing/todotest"
+)
+
+const importCSV = "\ufeffName,Notes,Due,Key\r\n" +
+	"existing,,2019-06-01T10:00:00Z,ext-1\r\n" +
+	"new,\"with, comma\",2019-06-01,ext-2\r\n" +
+	"duplicate,,2019-06-01T10:00:00Z,ext-2\r\n" +
+	"no key,,2019-06-01T10:00:00+02:00,\r\n" +
+	"invalid,,tomorrow,ext-3\r\n"
+
+const importICS = "BEGIN:VCALENDAR\r\n" +
+	"VERSION:2.0\r\n" +
+	"BEGIN:VTODO\r\n" +
+	"UID:ext-1\r\n" +
+	"SUMMARY:existing\r\n" +
+	"DTSTART:20190601T100000Z\r\n" +
+	"END:VTODO\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:ext-2\r\n" +
+	"SUMMARY:event\\, folded\r\n" +
+	" line\r\n" +
+	"DTSTART;TZID=Europe/Berlin:20190601T120000\r\n" +
+	"BEGIN:VALARM\r\n" +
+	"DESCRIPTION:alarm\r\n" +
+	"END:VALARM\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VTODO\r\n" +
+	"UID:ext-3\r\n" +
+	"SUMMARY:due\r\n" +
+	"DUE;VALUE=DATE:20190601\r\n" +
+	"END:VTODO\r\n" +
+	"BEGIN:VTODO\r\n" +
+	"UID:ext-4\r\n" +
+	"SUMMARY:no start\r\n" +
+	"END:VTODO\r\n" +
+	"END:VCALENDAR\r\n"
+
+func TestImportFile(t *testing.T) {
+	reminder, _ := ptypes.TimestampProto(time.Date(2019, 6, 1, 10, 0, 0, 0, time.UTC))
+	columns := &v1.CSVColumns{Title: "name", Description: "Notes", Reminder: "Due", ExternalId: "Key"}
+	csvFile := &httpbody.HttpBody{ContentType: "text/csv", Data: []byte(importCSV)}
+	icsFile := &httpbody.HttpBody{ContentType: "text/calendar; charset=utf-8", Data: []byte(importICS)}
+
+	tests := []struct {
+		name        string
+		req         *v1.ImportFileRequest
+		wantCode    codes.Code
+		wantActions []v1.ImportAction
+		wantTitles  []string
+	}{
+		{
+			name: "CSV",
+			req:  &v1.ImportFileRequest{File: csvFile, Columns: columns},
+			wantActions: []v1.ImportAction{
+				v1.ImportAction_SKIP, v1.ImportAction_CREATE, v1.ImportAction_SKIP, v1.ImportAction_CREATE, v1.ImportAction_REJECT,
+			},
+			wantTitles: []string{"new", "no key"},
+		},
+		{
+			name: "iCalendar",
+			req:  &v1.ImportFileRequest{File: icsFile},
+			wantActions: []v1.ImportAction{
+				v1.ImportAction_SKIP, v1.ImportAction_CREATE, v1.ImportAction_CREATE, v1.ImportAction_REJECT,
+			},
+			wantTitles: []string{"event, foldedline", "due"},
+		},
+		{
+			name: "Dry run",
+			req:  &v1.ImportFileRequest{File: csvFile, Columns: columns, DryRun: true},
+			wantActions: []v1.ImportAction{
+				v1.ImportAction_SKIP, v1.ImportAction_CREATE, v1.ImportAction_SKIP, v1.ImportAction_CREATE, v1.ImportAction_REJECT,
+			},
+		},
+		{
+			name:     "Missing column",
+			req:      &v1.ImportFileRequest{File: csvFile, Columns: &v1.CSVColumns{Reminder: "When"}},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "Invalid iCalendar file",
+			req:      &v1.ImportFileRequest{Format: "ics", File: &httpbody.HttpBody{Data: []byte("BEGIN:VTODO\r\n")}},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "Unknown format",
+			req:      &v1.ImportFileRequest{File: &httpbody.HttpBody{ContentType: "application/pdf"}},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "Unsupported API",
+			req:      &v1.ImportFileRequest{Api: "v2", File: csvFile},
+			wantCode: codes.Unimplemented,
+		},
+	}
+	for _, tt := range tests {
+		for _, transport := range []string{"gRPC", "REST"} {
+			t.Run(tt.name+"/"+transport, func(t *testing.T) {
+				ctx := context.Background()
+				srv := todotest.Start(t, todotest.Config{})
+				defer srv.Close()
+				client := srv.Client
+				if transport == "REST" {
+					client = srv.REST
+				}
+				srv.Seed(t, &v1.ToDo{Title: "existing", Reminder: reminder, ExternalId: "ext-1"})
+
+				res, err := client.ImportFile(ctx, tt.req)
+				if status.Code(err) != tt.wantCode {
+					t.Fatalf("ImportFile() error = %v, want code %v", err, tt.wantCode)
+				}
+				if err != nil {
+					return
+				}
+
+				var actions []v1.ImportAction
+				count := map[v1.ImportAction]int64{}
+				for i, r := range res.Results {
+					actions = append(actions, r.Action)
+					count[r.Action]++
+					if r.Row != int64(i) {
+						t.Errorf("results[%d].row = %d", i, r.Row)
+					}
+					if r.Action == v1.ImportAction_CREATE && (r.Id != 0) == tt.req.DryRun {
+						t.Errorf("results[%d].id = %d in dry run %v", i, r.Id, tt.req.DryRun)
+					}
+					if r.Action == v1.ImportAction_REJECT && codes.Code(r.Status.GetCode()) != codes.InvalidArgument {
+						t.Errorf("results[%d].status = %v, want InvalidArgument", i, r.Status)
+					}
+				}
+				if !reflect.DeepEqual(actions, tt.wantActions) {
+					t.Errorf("actions = %v, want %v", actions, tt.wantActions)
+				}
+				if res.Created != count[v1.ImportAction_CREATE] || res.Skipped != count[v1.ImportAction_SKIP] ||
+					res.Rejected != count[v1.ImportAction_REJECT] || res.DryRun != tt.req.DryRun {
+					t.Errorf("response = %v", res)
+				}
+
+				all, err := srv.Client.ReadAll(ctx, &v1.ReadAllRequest{})
+				if err != nil {
+					t.Fatal(err)
+				}
+				var titles []string
+				for _, td := range all.ToDos[1:] {
+					titles = append(titles, td.Title)
+				}
+				if !reflect.DeepEqual(titles, tt.wantTitles) {
+					t.Errorf("imported tasks = %q, want %q", titles, tt.wantTitles)
+				}
+			})
+		}
+	}
+}
+
+func TestImportFile_Upload(t *testing.T) {
+	srv := todotest.Start(t, todotest.Config{})
+	defer srv.Close()
+
+	// the file is uploaded to route of streaming Import
+	body := "title,reminder,externalId\nuploaded,2019-06-01T10:00:00Z,ext-1\nuploaded,2019-06-01T10:00:00Z,ext-1\n"
+	resp, err := http.Post(srv.URL+"/v1/todo:import", "text/csv; charset=utf-8", strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	var res struct {
+		Created string `json:"created"`
+		Skipped string `json:"skipped"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusOK || res.Created != "1" || res.Skipped != "1" {
+		t.Errorf("status %d, response %+v, want 1 created and 1 skipped", resp.StatusCode, res)
+	}
+}
+
+func TestImportFile_UploadTooLarge(t *testing.T) {
+	srv := todotest.Start(t, todotest.Config{MaxRequestSize: 64})
+	defer srv.Close()
+
+	body := "title,reminder\n" + strings.Repeat("uploaded,2019-06-01T10:00:00Z\n", 10)
+	resp, err := http.Post(srv.URL+"/v1/todo:import", "text/csv", strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	var res struct {
+		Code    codes.Code `json:"code"`
+		Message string     `json:"message"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusBadRequest || res.Code != codes.InvalidArgument ||
+		!strings.Contains(res.Message, "larger than 64 bytes") {
+		t.Errorf("status %d, response %+v, want InvalidArgument", resp.StatusCode, res)
+	}
+	if all, err := srv.Client.ReadAll(context.Background(), &v1.ReadAllRequest{}); err != nil || len(all.ToDos) > 0 {
+		t.Errorf("ReadAll() = %v, %v, want no tasks", all, err)
+	}
+}
@@ -0,0 +1,105 @@
+package v1
+
+import (
+	"context"
+	"io"
+
+	"github.com/jinzhu/gorm"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+
+	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
+	"go.smartmachine.io/go-grpc-api/pkg/logger"
+)
+
+const (
+	// importChunkSize is number of imported tasks inserted in one transaction
+	importChunkSize = 500
+
+	// maxImportErrors is the largest number of errors listed in import summary
+	maxImportErrors = 1000
+)
+
+// importer inserts imported tasks in chunks and collects summary
+type importer struct {
+	ctx context.Context
+	s   *toDoServiceServer
+	// chunk are tasks waiting for insert, first is at row
+	chunk []*v1.ToDo
+	row   int64
+	res   *v1.ImportResponse
+}
+
+// add queues task for insert and inserts queued tasks if chunk is full
+func (im *importer) add(td *v1.ToDo) error {
+	im.chunk = append(im.chunk, td)
+	if len(im.chunk) < importChunkSize {
+		return nil
+	}
+	return im.flush()
+}
+
+// flush inserts queued tasks in one transaction, tasks which cannot be inserted are skipped
+func (im *importer) flush() error {
+	if len(im.chunk) == 0 {
+		return nil
+	}
+	created := make([]*v1.ToDo, len(im.chunk))
+	results, err := im.s.batch(v1.BatchMode_PER_ITEM, "toDos", len(im.chunk), func(tx *gorm.DB, i int) (int64, error) {
+		td, err := createItem(im.ctx, tx, im.chunk[i])
+		if err != nil {
+			return 0, err
+		}
+		created[i] = td
+		return td.Id, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for i, r := range results {
+		if r.Status.GetCode() == int32(codes.OK) {
+			im.res.Inserted++
+			im.s.publish(v1.EventType_CREATED, created[i])
+			continue
+		}
+		im.res.Failed++
+		if len(im.res.Errors) < maxImportErrors {
+			im.res.Errors = append(im.res.Errors, &v1.ImportError{Row: im.row + int64(i), Status: r.Status})
+		}
+	}
+	im.row += int64(len(im.chunk))
+	im.chunk = im.chunk[:0]
+	return nil
+}
+
+// Import stream of todo tasks
+func (s *toDoServiceServer) Import(stream v1.ToDoService_ImportServer) error {
+	ctx := stream.Context()
+	im := &importer{ctx: ctx, s: s, res: &v1.ImportResponse{Api: apiVersion}}
+
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			logger.FromContext(ctx).Warn("import stream failed", zap.Int64("inserted", im.res.Inserted), zap.Error(err))
+			return err
+		}
+		// check if the API version requested by client is supported by server
+		if err := s.checkAPI(req.Api); err != nil {
+			return err
+		}
+		if err := im.add(req.ToDo); err != nil {
+			return err
+		}
+	}
+	if err := im.flush(); err != nil {
+		return err
+	}
+
+	logger.FromContext(ctx).Info("todo tasks imported",
+		zap.Int64("inserted", im.res.Inserted), zap.Int64("failed", im.res.Failed))
+	return stream.SendAndClose(im.res)
+}
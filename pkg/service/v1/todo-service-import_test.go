@@ -0,0 +1,97 @@
+package v1_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
+	"go.smartmachine.io/go-grpc-api/pkg/testing/todotest"
+)
+
+func TestImport(t *testing.T) {
+	reminder, _ := ptypes.TimestampProto(time.Date(2019, 6, 1, 10, 0, 0, 0, time.UTC))
+
+	// more than two chunks, every 100th task has no reminder
+	var rows []*v1.ImportRequest
+	var wantErrRows []int64
+	for i := 0; i < 1201; i++ {
+		td := &v1.ToDo{Title: "imported", Reminder: reminder}
+		if i%100 == 0 {
+			td.Reminder = nil
+			wantErrRows = append(wantErrRows, int64(i))
+		}
+		rows = append(rows, &v1.ImportRequest{Api: "v1", ToDo: td})
+	}
+
+	tests := []struct {
+		name         string
+		rows         []*v1.ImportRequest
+		wantCode     codes.Code
+		wantInserted int64
+		wantErrRows  []int64
+	}{
+		{name: "Import", rows: rows, wantInserted: 1188, wantErrRows: wantErrRows},
+		{name: "Empty", rows: nil},
+		{name: "Unsupported API", rows: []*v1.ImportRequest{{Api: "v2", ToDo: &v1.ToDo{Reminder: reminder}}},
+			wantCode: codes.Unimplemented},
+	}
+	for _, tt := range tests {
+		for _, transport := range []string{"gRPC", "REST"} {
+			t.Run(tt.name+"/"+transport, func(t *testing.T) {
+				ctx := context.Background()
+				srv := todotest.Start(t, todotest.Config{})
+				defer srv.Close()
+				client := srv.Client
+				if transport == "REST" {
+					client = srv.REST
+				}
+
+				stream, err := client.Import(ctx)
+				if err != nil {
+					t.Fatal(err)
+				}
+				for _, r := range tt.rows {
+					if err := stream.Send(r); err != nil {
+						// the call is over, its status is returned by CloseAndRecv
+						break
+					}
+				}
+				res, err := stream.CloseAndRecv()
+				if status.Code(err) != tt.wantCode {
+					t.Fatalf("CloseAndRecv() error = %v, want code %v", err, tt.wantCode)
+				}
+				if err != nil {
+					return
+				}
+
+				if res.Inserted != tt.wantInserted || res.Failed != int64(len(tt.wantErrRows)) {
+					t.Errorf("inserted %d, failed %d, want %d, %d", res.Inserted, res.Failed, tt.wantInserted, len(tt.wantErrRows))
+				}
+				if len(res.Errors) != len(tt.wantErrRows) {
+					t.Fatalf("errors = %v, want rows %v", res.Errors, tt.wantErrRows)
+				}
+				for i, e := range res.Errors {
+					if e.Row != tt.wantErrRows[i] || codes.Code(e.Status.GetCode()) != codes.InvalidArgument {
+						t.Errorf("errors[%d] = %v, want InvalidArgument at row %d", i, e, tt.wantErrRows[i])
+					}
+				}
+
+				all, err := srv.Client.ReadAll(ctx, &v1.ReadAllRequest{})
+				if err != nil {
+					t.Fatal(err)
+				}
+				if int64(len(all.ToDos)) != tt.wantInserted {
+					t.Errorf("tasks after import = %d, want %d", len(all.ToDos), tt.wantInserted)
+				}
+				if srv.Bus.Sequence() != tt.wantInserted {
+					t.Errorf("published %d changes, want %d", srv.Bus.Sequence(), tt.wantInserted)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,269 @@
+package v1
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/jinzhu/gorm"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
+	"go.smartmachine.io/go-grpc-api/pkg/logger"
+)
+
+// maxProjectNameLength is the longest project name in characters
+const maxProjectNameLength = 100
+
+// checkProject trims name of project and checks it
+func checkProject(p *v1.Project) error {
+	if p == nil {
+		return status.Error(codes.InvalidArgument, "project is missing")
+	}
+	p.Name = strings.TrimSpace(p.Name)
+	switch {
+	case len(p.Name) == 0:
+		return status.Error(codes.InvalidArgument, "project name is empty")
+	case utf8.RuneCountInString(p.Name) > maxProjectNameLength:
+		return status.Errorf(codes.InvalidArgument, "project name is longer than %d characters", maxProjectNameLength)
+	}
+	return nil
+}
+
+// uniqueName checks no other project has name of project p
+func uniqueName(tx *gorm.DB, p *v1.Project) error {
+	var count int
+	if err := tx.Model(&v1.ProjectORM{}).Where("name = ? AND id <> ?", p.Name, p.Id).Count(&count).Error; err != nil {
+		return status.Errorf(codes.Internal, "error reading project: %v", err)
+	}
+	if count > 0 {
+		return status.Errorf(codes.AlreadyExists, "project '%s' already exists", p.Name)
+	}
+	return nil
+}
+
+// projectExists tells if project id exists, tasks of project 0 belong to no project
+func projectExists(db *gorm.DB, id int64) (bool, error) {
+	if id == 0 {
+		return true, nil
+	}
+	var count int
+	if err := db.Model(&v1.ProjectORM{}).Where("id = ?", id).Count(&count).Error; err != nil {
+		return false, status.Errorf(codes.Internal, "error reading project: %v", err)
+	}
+	return count > 0, nil
+}
+
+// checkToDoProject checks project of task exists
+func checkToDoProject(tx *gorm.DB, td *v1.ToDo) error {
+	ok, err := projectExists(tx, td.ProjectId)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return status.Errorf(codes.InvalidArgument, "project %d does not exist", td.ProjectId)
+	}
+	return nil
+}
+
+// Create new project
+func (s *toDoServiceServer) CreateProject(ctx context.Context, req *v1.CreateProjectRequest) (*v1.CreateProjectResponse, error) {
+	// check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+	if err := checkProject(req.Project); err != nil {
+		return nil, err
+	}
+
+	p := *req.Project
+	p.Id = 0
+	err := s.inTransaction(func(tx *gorm.DB) error {
+		if err := uniqueName(tx, &p); err != nil {
+			return err
+		}
+		orm, err := p.ToORM(ctx)
+		if err != nil {
+			return status.Errorf(codes.Internal, "unable to convert to orm representation: %v", err)
+		}
+		if err := tx.Create(&orm).Error; err != nil {
+			return status.Errorf(codes.Internal, "unable to insert project, internal error: %v", err)
+		}
+		p.Id = orm.Id
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logger.FromContext(ctx).Info("project created", zap.Int64("id", p.Id))
+
+	return &v1.CreateProjectResponse{
+		Api:     apiVersion,
+		Project: &p,
+	}, nil
+}
+
+// Read project
+func (s *toDoServiceServer) ReadProject(ctx context.Context, req *v1.ReadProjectRequest) (*v1.ReadProjectResponse, error) {
+	// check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+
+	var orm v1.ProjectORM
+	if err := s.db.First(&orm, req.Id).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return nil, status.Errorf(codes.NotFound, "project not found: %d", req.Id)
+		}
+		return nil, status.Errorf(codes.Internal, "error reading project: %v", err)
+	}
+	p, err := orm.ToPB(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to convert to orm representation: %v", err)
+	}
+
+	return &v1.ReadProjectResponse{
+		Api:     apiVersion,
+		Project: &p,
+	}, nil
+}
+
+// Update project
+func (s *toDoServiceServer) UpdateProject(ctx context.Context, req *v1.UpdateProjectRequest) (*v1.UpdateProjectResponse, error) {
+	// check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+	if err := checkProject(req.Project); err != nil {
+		return nil, err
+	}
+
+	p := *req.Project
+	err := s.inTransaction(func(tx *gorm.DB) error {
+		ok, err := projectExists(tx, p.Id)
+		if err != nil {
+			return err
+		}
+		if !ok || p.Id == 0 {
+			return status.Errorf(codes.NotFound, "project not found: %d", p.Id)
+		}
+		if err := uniqueName(tx, &p); err != nil {
+			return err
+		}
+		orm, err := p.ToORM(ctx)
+		if err != nil {
+			return status.Errorf(codes.Internal, "unable to convert to orm representation: %v", err)
+		}
+		if err := tx.Save(&orm).Error; err != nil {
+			return status.Errorf(codes.Internal, "error updating project: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logger.FromContext(ctx).Info("project updated", zap.Int64("id", p.Id))
+
+	return &v1.UpdateProjectResponse{
+		Api:     apiVersion,
+		Project: &p,
+	}, nil
+}
+
+// Delete project, its tasks restrict the delete or are moved to trash by mode
+func (s *toDoServiceServer) DeleteProject(ctx context.Context, req *v1.DeleteProjectRequest) (*v1.DeleteProjectResponse, error) {
+	// check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+
+	var deleted int64
+	var trashed []*v1.ToDo
+	err := s.inTransaction(func(tx *gorm.DB) error {
+		ok, err := projectExists(tx, req.Id)
+		if err != nil {
+			return err
+		}
+		if !ok || req.Id == 0 {
+			// deleting project which does not exist is not an error
+			return nil
+		}
+		var orms []*v1.ToDoORM
+		if err := tx.Where("project_id = ?", req.Id).Find(&orms).Error; err != nil {
+			return status.Errorf(codes.Internal, "unable to read records, internal error: %v", err)
+		}
+		if len(orms) > 0 && req.Mode != v1.ProjectDeleteMode_CASCADE {
+			return status.Errorf(codes.FailedPrecondition, "project %d has %d tasks", req.Id, len(orms))
+		}
+		for _, orm := range orms {
+			before, err := snapshot(ctx, orm)
+			if err != nil {
+				return err
+			}
+			trashed = append(trashed, before)
+		}
+		if len(orms) > 0 {
+			if err := tx.Where("project_id = ?", req.Id).Delete(&v1.ToDoORM{}).Error; err != nil {
+				return status.Errorf(codes.Internal, "unable to delete, internal error: %v", err)
+			}
+			for _, before := range trashed {
+				if err := recordChange(ctx, tx, before, nil); err != nil {
+					return err
+				}
+			}
+		}
+
+		db := tx.Delete(&v1.ProjectORM{Id: req.Id})
+		if db.Error != nil {
+			return status.Errorf(codes.Internal, "unable to delete project, internal error: %v", db.Error)
+		}
+		deleted = db.RowsAffected
+		return nil
+	})
+	if err != nil {
+		logger.FromContext(ctx).Warn("failed to delete project", zap.Int64("id", req.Id), zap.Error(err))
+		return nil, err
+	}
+
+	logger.FromContext(ctx).Info("project deleted", zap.Int64("id", req.Id), zap.Int64("deleted", deleted),
+		zap.Int("trashed", len(trashed)))
+	for _, td := range trashed {
+		s.publish(v1.EventType_DELETED, &v1.ToDo{Id: td.Id})
+	}
+
+	return &v1.DeleteProjectResponse{
+		Api:     apiVersion,
+		Deleted: deleted,
+		Trashed: int64(len(trashed)),
+	}, nil
+}
+
+// List all projects
+func (s *toDoServiceServer) ListProjects(ctx context.Context, req *v1.ListProjectsRequest) (*v1.ListProjectsResponse, error) {
+	// check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+
+	var orms []*v1.ProjectORM
+	if err := s.db.Order("name").Find(&orms).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to read projects, internal error: %v", err)
+	}
+	list := []*v1.Project{}
+	for _, orm := range orms {
+		p, err := orm.ToPB(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "unable to convert to orm representation: %v", err)
+		}
+		list = append(list, &p)
+	}
+
+	return &v1.ListProjectsResponse{
+		Api:      apiVersion,
+		Projects: list,
+	}, nil
+}
@@ -0,0 +1,151 @@
+package v1_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
+	"go.smartmachine.io/go-grpc-api/pkg/testing/todotest"
+)
+
+func TestProjects(t *testing.T) {
+	ctx := context.Background()
+
+	for _, transport := range []string{"gRPC", "REST"} {
+		t.Run(transport, func(t *testing.T) {
+			srv := todotest.Start(t, todotest.Config{})
+			defer srv.Close()
+			client := srv.Client
+			if transport == "REST" {
+				client = srv.REST
+			}
+
+			project := func(name string) int64 {
+				res, err := client.CreateProject(ctx, &v1.CreateProjectRequest{Project: &v1.Project{Name: name}})
+				if err != nil {
+					t.Fatalf("CreateProject() error = %v", err)
+				}
+				return res.Project.Id
+			}
+			create := func(title string, projectID int64) int64 {
+				td := remindAt(time.Now())
+				td.Title, td.ProjectId = title, projectID
+				res, err := client.Create(ctx, &v1.CreateRequest{ToDo: td})
+				if err != nil {
+					t.Fatalf("Create() error = %v", err)
+				}
+				return res.Id
+			}
+			titles := func(projectID int64) []string {
+				res, err := client.ReadAll(ctx, &v1.ReadAllRequest{ProjectId: projectID})
+				if err != nil {
+					t.Fatalf("ReadAll(%d) error = %v", projectID, err)
+				}
+				var got []string
+				for _, td := range res.ToDos {
+					got = append(got, td.Title)
+				}
+				return got
+			}
+
+			home, work := project(" home "), project("work")
+			create("paint", home)
+			create("clean", home)
+			report := create("report", work)
+			create("inbox", 0)
+
+			if got, want := titles(home), []string{"paint", "clean"}; !reflect.DeepEqual(got, want) {
+				t.Errorf("ReadAll() of home = %v, want %v", got, want)
+			}
+			if got := titles(0); len(got) != 4 {
+				t.Errorf("ReadAll() = %v, want 4 tasks", got)
+			}
+
+			res, err := client.UpdateProject(ctx, &v1.UpdateProjectRequest{
+				Project: &v1.Project{Id: work, Name: "office", Description: "daily work"},
+			})
+			if err != nil || res.Project.Name != "office" {
+				t.Fatalf("UpdateProject() = %v, %v", res, err)
+			}
+			if read, err := client.ReadProject(ctx, &v1.ReadProjectRequest{Id: work}); err != nil ||
+				read.Project.Description != "daily work" {
+				t.Errorf("ReadProject() = %v, %v", read, err)
+			}
+			list, err := client.ListProjects(ctx, &v1.ListProjectsRequest{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			var names []string
+			for _, p := range list.Projects {
+				names = append(names, p.Name)
+			}
+			if want := []string{"home", "office"}; !reflect.DeepEqual(names, want) {
+				t.Errorf("ListProjects() = %v, want %v", names, want)
+			}
+
+			for _, tt := range []struct {
+				name string
+				err  error
+				want codes.Code
+			}{
+				{"CreateProject() with empty name", func() error {
+					_, err := client.CreateProject(ctx, &v1.CreateProjectRequest{Project: &v1.Project{Name: " "}})
+					return err
+				}(), codes.InvalidArgument},
+				{"CreateProject() with existing name", func() error {
+					_, err := client.CreateProject(ctx, &v1.CreateProjectRequest{Project: &v1.Project{Name: "home"}})
+					return err
+				}(), codes.AlreadyExists},
+				{"UpdateProject() of unknown project", func() error {
+					_, err := client.UpdateProject(ctx, &v1.UpdateProjectRequest{Project: &v1.Project{Id: 1000, Name: "x"}})
+					return err
+				}(), codes.NotFound},
+				{"ReadAll() of unknown project", func() error {
+					_, err := client.ReadAll(ctx, &v1.ReadAllRequest{ProjectId: 1000})
+					return err
+				}(), codes.NotFound},
+				{"Create() in unknown project", func() error {
+					td := remindAt(time.Now())
+					td.Title, td.ProjectId = "lost", 1000
+					_, err := client.Create(ctx, &v1.CreateRequest{ToDo: td})
+					return err
+				}(), codes.InvalidArgument},
+				{"DeleteProject() of project with tasks", func() error {
+					_, err := client.DeleteProject(ctx, &v1.DeleteProjectRequest{Id: home})
+					return err
+				}(), codes.FailedPrecondition},
+			} {
+				if status.Code(tt.err) != tt.want {
+					t.Errorf("%s error = %v, want %v", tt.name, tt.err, tt.want)
+				}
+			}
+
+			// tasks in trash do not restrict the delete, restored task belongs to no project
+			if _, err := client.Delete(ctx, &v1.DeleteRequest{Id: report}); err != nil {
+				t.Fatal(err)
+			}
+			if res, err := client.DeleteProject(ctx, &v1.DeleteProjectRequest{Id: work}); err != nil || res.Deleted != 1 || res.Trashed != 0 {
+				t.Fatalf("DeleteProject() = %v, %v", res, err)
+			}
+			if res, err := client.Undelete(ctx, &v1.UndeleteRequest{Id: report}); err != nil || res.ToDo.ProjectId != 0 {
+				t.Errorf("Undelete() of task of deleted project = %v, %v", res, err)
+			}
+
+			res2, err := client.DeleteProject(ctx, &v1.DeleteProjectRequest{Id: home, Mode: v1.ProjectDeleteMode_CASCADE})
+			if err != nil || res2.Deleted != 1 || res2.Trashed != 2 {
+				t.Fatalf("DeleteProject() with CASCADE = %v, %v", res2, err)
+			}
+			if got, want := titles(0), []string{"report", "inbox"}; !reflect.DeepEqual(got, want) {
+				t.Errorf("ReadAll() after DeleteProject() = %v, want %v", got, want)
+			}
+			if res, err := client.DeleteProject(ctx, &v1.DeleteProjectRequest{Id: home}); err != nil || res.Deleted != 0 {
+				t.Errorf("DeleteProject() of deleted project = %v, %v", res, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,217 @@
+package v1
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/jinzhu/gorm"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
+	"go.smartmachine.io/go-grpc-api/pkg/logger"
+	"go.smartmachine.io/go-grpc-api/pkg/rrule"
+)
+
+const (
+	// maxOccurrences is the largest number of occurrences listed by ListOccurrences
+	maxOccurrences = 1000
+)
+
+// checkRecurrence checks recurrence rule of task, recurring task must have reminder it starts at
+func checkRecurrence(td *v1.ToDo) error {
+	if len(td.Recurrence) == 0 {
+		return nil
+	}
+	if _, err := rrule.Parse(td.Recurrence); err != nil {
+		return status.Error(codes.InvalidArgument, "recurrence field has invalid format-> "+err.Error())
+	}
+	if td.Reminder == nil {
+		return status.Error(codes.InvalidArgument, "recurring task requires reminder")
+	}
+	return nil
+}
+
+// nextOccurrence returns task for the next occurrence of recurring task, nil if the task does not recur
+// or its recurrence ended. Remaining COUNT of the rule is carried over to the next task.
+func nextOccurrence(td *v1.ToDo) (*v1.ToDo, error) {
+	if len(td.Recurrence) == 0 {
+		return nil, nil
+	}
+	r, err := rrule.Parse(td.Recurrence)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "task %d has invalid recurrence: %v", td.Id, err)
+	}
+	start, err := ptypes.Timestamp(td.Reminder)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "recurring task %d has invalid reminder: %v", td.Id, err)
+	}
+	at, ok := r.Next(start, start)
+	if !ok {
+		return nil, nil
+	}
+
+	recurrence := td.Recurrence
+	if r.Count > 0 {
+		r.Count--
+		recurrence = r.String()
+	}
+	reminder, err := ptypes.TimestampProto(at)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to convert next occurrence: %v", err)
+	}
+	return &v1.ToDo{Title: td.Title, Description: td.Description, Reminder: reminder, Recurrence: recurrence,
+		Tags: td.Tags, ProjectId: td.ProjectId}, nil
+}
+
+// Complete todo task, recurring task is continued by task for its next occurrence
+func (s *toDoServiceServer) Complete(ctx context.Context, req *v1.CompleteRequest) (*v1.CompleteResponse, error) {
+	// check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+
+	var done, next *v1.ToDo
+	err := s.inTransaction(func(tx *gorm.DB) error {
+		var orm v1.ToDoORM
+		if err := tx.First(&orm, req.Id).Error; err != nil {
+			if gorm.IsRecordNotFoundError(err) {
+				return status.Errorf(codes.NotFound, "record not found: %d", req.Id)
+			}
+			return status.Errorf(codes.Internal, "error reading record: %v", err)
+		}
+		// concurrent completions of the task create one next task
+		res := tx.Model(&v1.ToDoORM{}).Where("id = ? AND done = ?", req.Id, false).Update("done", true)
+		if res.Error != nil {
+			return status.Errorf(codes.Internal, "error updating record: %v", res.Error)
+		}
+		if res.RowsAffected == 0 {
+			return status.Errorf(codes.FailedPrecondition, "task %d is done already", req.Id)
+		}
+
+		before, err := snapshot(ctx, &orm)
+		if err != nil {
+			return err
+		}
+		orm.Done = true
+		if done, err = snapshot(ctx, &orm); err != nil {
+			return err
+		}
+		if err := recordChange(ctx, tx, before, done); err != nil {
+			return err
+		}
+
+		if next, err = nextOccurrence(done); err != nil || next == nil {
+			return err
+		}
+		tags := next.Tags
+		if next, err = v1.DefaultCreateToDo(ctx, next, tx); err != nil {
+			return status.Errorf(codes.Internal, "unable to insert, internal error: %v", err)
+		}
+		if len(tags) > 0 {
+			if err := saveTags(tx, next.Id, tags); err != nil {
+				return err
+			}
+			next.Tags = tags
+		}
+		return recordChange(ctx, tx, nil, next)
+	})
+	if err != nil {
+		logger.FromContext(ctx).Warn("failed to complete todo task", zap.Int64("id", req.Id), zap.Error(err))
+		return nil, err
+	}
+
+	s.publish(v1.EventType_UPDATED, done)
+	log := logger.FromContext(ctx).With(zap.Int64("id", req.Id))
+	if next != nil {
+		s.publish(v1.EventType_CREATED, next)
+		log = log.With(zap.Int64("next", next.Id))
+	}
+	log.Info("todo task completed")
+
+	return &v1.CompleteResponse{
+		Api:  apiVersion,
+		ToDo: done,
+		Next: next,
+	}, nil
+}
+
+// occurrences returns occurrences of task in window from start, inclusive, to end, exclusive
+func occurrences(orm *v1.ToDoORM, start, end time.Time, limit int) ([]time.Time, error) {
+	if orm.Reminder.IsZero() {
+		return nil, nil
+	}
+	if len(orm.Recurrence) == 0 {
+		if orm.Reminder.Before(start) || !orm.Reminder.Before(end) {
+			return nil, nil
+		}
+		return []time.Time{orm.Reminder.UTC()}, nil
+	}
+	r, err := rrule.Parse(orm.Recurrence)
+	if err != nil {
+		return nil, err
+	}
+	return r.Between(orm.Reminder, start, end, limit), nil
+}
+
+// List upcoming occurrences of todo tasks in time window
+func (s *toDoServiceServer) ListOccurrences(ctx context.Context, req *v1.ListOccurrencesRequest) (*v1.ListOccurrencesResponse, error) {
+	// check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+
+	start := time.Now().UTC()
+	if req.Start != nil {
+		var err error
+		if start, err = ptypes.Timestamp(req.Start); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "start field has invalid format-> "+err.Error())
+		}
+	}
+	if req.End == nil {
+		return nil, status.Error(codes.InvalidArgument, "end field is required")
+	}
+	end, err := ptypes.Timestamp(req.End)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "end field has invalid format-> "+err.Error())
+	}
+	if !end.After(start) {
+		return nil, status.Errorf(codes.InvalidArgument, "end %v is not after start %v", end, start)
+	}
+
+	// series start at reminder, so later reminders have no occurrence in window
+	q := withoutPreload(s.db).Where("done = ? AND reminder < ?", false, end)
+	if len(req.Ids) > 0 {
+		q = q.Where("id in (?)", req.Ids)
+	}
+	var orms []*v1.ToDoORM
+	if err := q.Order("id").Find(&orms).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to read records, internal error: %v", err)
+	}
+
+	list := []*v1.Occurrence{}
+	for _, orm := range orms {
+		times, err := occurrences(orm, start, end, maxOccurrences+1)
+		if err != nil {
+			logger.FromContext(ctx).Warn("task has invalid recurrence", zap.Int64("id", orm.Id), zap.Error(err))
+			continue
+		}
+		for _, t := range times {
+			ts, _ := ptypes.TimestampProto(t)
+			list = append(list, &v1.Occurrence{Id: orm.Id, Title: orm.Title, Time: ts})
+		}
+	}
+	sort.SliceStable(list, func(i, j int) bool {
+		a, b := list[i].Time, list[j].Time
+		return a.Seconds < b.Seconds || (a.Seconds == b.Seconds && a.Nanos < b.Nanos)
+	})
+
+	res := &v1.ListOccurrencesResponse{Api: apiVersion, Occurrences: list}
+	if len(list) > maxOccurrences {
+		res.Occurrences, res.Truncated = list[:maxOccurrences], true
+	}
+	return res, nil
+}
@@ -0,0 +1,156 @@
+package v1_test
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
+	"go.smartmachine.io/go-grpc-api/pkg/testing/todotest"
+)
+
+// remindAt returns task with reminder at t
+func remindAt(t time.Time) *v1.ToDo {
+	ts, _ := ptypes.TimestampProto(t)
+	return &v1.ToDo{Reminder: ts}
+}
+
+func TestComplete(t *testing.T) {
+	ctx := context.Background()
+	start := time.Date(2019, 6, 3, 9, 0, 0, 0, time.UTC)
+
+	for _, transport := range []string{"gRPC", "REST"} {
+		t.Run(transport, func(t *testing.T) {
+			srv := todotest.Start(t, todotest.Config{})
+			defer srv.Close()
+			client := srv.Client
+			if transport == "REST" {
+				client = srv.REST
+			}
+
+			weekly := remindAt(start)
+			weekly.Title, weekly.Recurrence = "weekly", "FREQ=WEEKLY;BYDAY=MO,TH;COUNT=3"
+			once := remindAt(start)
+			once.Title = "once"
+			ids := srv.Seed(t, weekly, once)
+
+			// every completion creates the next occurrence until COUNT is used up
+			id := ids[0]
+			for _, want := range []struct {
+				reminder   time.Time
+				recurrence string
+			}{
+				{start.AddDate(0, 0, 3), "FREQ=WEEKLY;COUNT=2;BYDAY=MO,TH"},
+				{start.AddDate(0, 0, 7), "FREQ=WEEKLY;COUNT=1;BYDAY=MO,TH"},
+			} {
+				res, err := client.Complete(ctx, &v1.CompleteRequest{Id: id})
+				if err != nil {
+					t.Fatalf("Complete(%d) error = %v", id, err)
+				}
+				reminder, _ := ptypes.Timestamp(res.Next.GetReminder())
+				if !res.ToDo.Done || res.Next.GetTitle() != "weekly" || !reminder.Equal(want.reminder) ||
+					res.Next.GetRecurrence() != want.recurrence || res.Next.GetDone() {
+					t.Fatalf("Complete(%d) = %v", id, res)
+				}
+				id = res.Next.Id
+			}
+			if res, err := client.Complete(ctx, &v1.CompleteRequest{Id: id}); err != nil || res.Next != nil {
+				t.Errorf("Complete() of the last occurrence = %v, %v", res, err)
+			}
+
+			if res, err := client.Complete(ctx, &v1.CompleteRequest{Id: ids[1]}); err != nil || res.Next != nil {
+				t.Errorf("Complete() of task which does not recur = %v, %v", res, err)
+			}
+			if _, err := client.Complete(ctx, &v1.CompleteRequest{Id: ids[1]}); status.Code(err) != codes.FailedPrecondition {
+				t.Errorf("Complete() of done task error = %v, want FailedPrecondition", err)
+			}
+			if _, err := client.Complete(ctx, &v1.CompleteRequest{Id: 1000}); status.Code(err) != codes.NotFound {
+				t.Errorf("Complete() of unknown task error = %v, want NotFound", err)
+			}
+
+			invalid := remindAt(start)
+			invalid.Recurrence = "FREQ=HOURLY"
+			if _, err := client.Create(ctx, &v1.CreateRequest{ToDo: invalid}); status.Code(err) != codes.InvalidArgument {
+				t.Errorf("Create() with invalid recurrence error = %v, want InvalidArgument", err)
+			}
+		})
+	}
+}
+
+func TestListOccurrences(t *testing.T) {
+	ctx := context.Background()
+	start := time.Date(2019, 6, 3, 9, 0, 0, 0, time.UTC)
+	at := func(days int) *v1.ToDo { return remindAt(start.AddDate(0, 0, days)) }
+
+	daily, once, later, done := at(0), at(1), at(10), at(0)
+	daily.Title, daily.Recurrence = "daily", "FREQ=DAILY;INTERVAL=2"
+	once.Title = "once"
+	later.Title, later.Recurrence = "later", "FREQ=DAILY"
+	done.Title = "done"
+
+	tests := []struct {
+		name     string
+		req      *v1.ListOccurrencesRequest
+		want     []string
+		wantCode codes.Code
+	}{
+		{
+			name: "Window",
+			req:  &v1.ListOccurrencesRequest{Start: at(1).Reminder, End: at(5).Reminder},
+			want: []string{"once 1", "daily 2", "daily 4"},
+		},
+		{
+			name: "IDs",
+			req:  &v1.ListOccurrencesRequest{Start: at(0).Reminder, End: at(5).Reminder, Ids: []int64{1}},
+			want: []string{"daily 0", "daily 2", "daily 4"},
+		},
+		{
+			name:     "Missing end",
+			req:      &v1.ListOccurrencesRequest{Start: at(1).Reminder},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "Empty window",
+			req:      &v1.ListOccurrencesRequest{Start: at(1).Reminder, End: at(1).Reminder},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		for _, transport := range []string{"gRPC", "REST"} {
+			t.Run(tt.name+"/"+transport, func(t *testing.T) {
+				srv := todotest.Start(t, todotest.Config{})
+				defer srv.Close()
+				client := srv.Client
+				if transport == "REST" {
+					client = srv.REST
+				}
+				ids := srv.Seed(t, daily, once, later, done)
+				if _, err := srv.Client.Complete(ctx, &v1.CompleteRequest{Id: ids[3]}); err != nil {
+					t.Fatal(err)
+				}
+
+				res, err := client.ListOccurrences(ctx, tt.req)
+				if status.Code(err) != tt.wantCode {
+					t.Fatalf("ListOccurrences() error = %v, want code %v", err, tt.wantCode)
+				}
+				if err != nil {
+					return
+				}
+				var got []string
+				for _, o := range res.Occurrences {
+					tm, _ := ptypes.Timestamp(o.Time)
+					got = append(got, o.Title+" "+strconv.Itoa(int(tm.Sub(start).Hours()/24)))
+				}
+				if !reflect.DeepEqual(got, tt.want) || res.Truncated {
+					t.Errorf("ListOccurrences() = %v, truncated %v, want %v", got, res.Truncated, tt.want)
+				}
+			})
+		}
+	}
+}
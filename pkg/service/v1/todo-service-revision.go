@@ -0,0 +1,155 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/jinzhu/gorm"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
+	"go.smartmachine.io/go-grpc-api/pkg/logger"
+	"go.smartmachine.io/go-grpc-api/pkg/revision"
+)
+
+// maxRevisions is the largest number of revisions listed by one request
+const maxRevisions = 1000
+
+// getRevision reads revision of task
+func getRevision(db *gorm.DB, id, number int64) (*revision.Revision, error) {
+	r, err := revision.Get(db, id, number)
+	switch err {
+	case nil:
+		return r, nil
+	case revision.ErrNotFound:
+		return nil, status.Errorf(codes.NotFound, "revision %d of task %d not found", number, id)
+	default:
+		return nil, status.Errorf(codes.Internal, "unable to read revision, internal error: %v", err)
+	}
+}
+
+// List revisions of todo task
+func (s *toDoServiceServer) ListRevisions(ctx context.Context, req *v1.ListRevisionsRequest) (*v1.ListRevisionsResponse, error) {
+	// check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+
+	// one more revision is read to tell if the list is truncated
+	revisions, err := revision.List(s.db, req.Id, maxRevisions+1)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to read revisions, internal error: %v", err)
+	}
+	if len(revisions) == 0 {
+		return nil, status.Errorf(codes.NotFound, "revisions of task %d not found", req.Id)
+	}
+	res := &v1.ListRevisionsResponse{Api: apiVersion}
+	if len(revisions) > maxRevisions {
+		revisions, res.Truncated = revisions[:maxRevisions], true
+	}
+	for _, r := range revisions {
+		pb, err := r.ToPB()
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "unable to convert revision: %v", err)
+		}
+		res.Revisions = append(res.Revisions, pb)
+	}
+	return res, nil
+}
+
+// Read revision of todo task
+func (s *toDoServiceServer) GetRevision(ctx context.Context, req *v1.GetRevisionRequest) (*v1.GetRevisionResponse, error) {
+	// check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+
+	r, err := getRevision(s.db, req.Id, req.Revision)
+	if err != nil {
+		return nil, err
+	}
+	pb, err := r.ToPB()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to convert revision: %v", err)
+	}
+	return &v1.GetRevisionResponse{
+		Api:      apiVersion,
+		Revision: pb,
+	}, nil
+}
+
+// Revert todo task to its revision, the revert is recorded as new revision
+func (s *toDoServiceServer) RestoreRevision(ctx context.Context, req *v1.RestoreRevisionRequest) (*v1.RestoreRevisionResponse, error) {
+	// check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+
+	var td *v1.ToDo
+	var number int64
+	err := s.inTransaction(func(tx *gorm.DB) error {
+		var orm v1.ToDoORM
+		if err := tx.First(&orm, req.Id).Error; err != nil {
+			if gorm.IsRecordNotFoundError(err) {
+				return status.Errorf(codes.NotFound, "record not found: %d", req.Id)
+			}
+			return status.Errorf(codes.Internal, "error reading record: %v", err)
+		}
+		before, err := snapshot(ctx, &orm)
+		if err != nil {
+			return err
+		}
+		r, err := getRevision(tx, req.Id, req.Revision)
+		if err != nil {
+			return err
+		}
+		if td, err = r.ToDo(); err != nil {
+			return status.Errorf(codes.Internal, "unable to convert revision: %v", err)
+		}
+		td.Id, td.DeletedAt = req.Id, nil
+		ok, err := projectExists(tx, td.ProjectId)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			// revision of task of deleted project is restored without project
+			td.ProjectId = 0
+		}
+
+		restored, err := td.ToORM(ctx)
+		if err != nil {
+			return status.Errorf(codes.Internal, "unable to convert to orm representation: %v", err)
+		}
+		if err := tx.Save(&restored).Error; err != nil {
+			return status.Errorf(codes.Internal, "error updating record: %v", err)
+		}
+		if err := saveTags(tx, req.Id, td.Tags); err != nil {
+			return err
+		}
+		if err := recordChange(ctx, tx, before, td); err != nil {
+			return err
+		}
+		latest, err := revision.List(tx, req.Id, 1)
+		if err != nil || len(latest) == 0 {
+			return status.Errorf(codes.Internal, "unable to read revision, internal error: %v", err)
+		}
+		number = latest[0].Number
+		return nil
+	})
+	if err != nil {
+		logger.FromContext(ctx).Warn("failed to restore revision of todo task", zap.Int64("id", req.Id),
+			zap.Int64("revision", req.Revision), zap.Error(err))
+		return nil, err
+	}
+
+	logger.FromContext(ctx).Info("todo task reverted", zap.Int64("id", req.Id),
+		zap.Int64("revision", req.Revision), zap.Int64("newRevision", number))
+	s.publish(v1.EventType_UPDATED, td)
+
+	return &v1.RestoreRevisionResponse{
+		Api:      apiVersion,
+		ToDo:     td,
+		Revision: number,
+	}, nil
+}
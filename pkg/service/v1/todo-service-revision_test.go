@@ -0,0 +1,108 @@
+package v1_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
+	"go.smartmachine.io/go-grpc-api/pkg/testing/todotest"
+)
+
+func TestRevisions(t *testing.T) {
+	ctx := context.Background()
+
+	for _, transport := range []string{"gRPC", "REST"} {
+		t.Run(transport, func(t *testing.T) {
+			srv := todotest.Start(t, todotest.Config{})
+			defer srv.Close()
+			client := srv.Client
+			if transport == "REST" {
+				client = srv.REST
+			}
+
+			td := remindAt(time.Now())
+			td.Title = "first"
+			created, err := client.Create(ctx, &v1.CreateRequest{ToDo: td})
+			if err != nil {
+				t.Fatal(err)
+			}
+			id := created.Id
+			td.Id = id
+			for _, title := range []string{"second", "third"} {
+				td.Title = title
+				if _, err := client.Update(ctx, &v1.UpdateRequest{ToDo: td}); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			titles := func() []string {
+				res, err := client.ListRevisions(ctx, &v1.ListRevisionsRequest{Id: id})
+				if err != nil {
+					t.Fatalf("ListRevisions() error = %v", err)
+				}
+				var got []string
+				for i, r := range res.Revisions {
+					if r.ToDoId != id || r.Revision != int64(len(res.Revisions)-i) || r.CreateTime == nil {
+						t.Errorf("ListRevisions() revision %d = %v", i, r)
+					}
+					got = append(got, r.ToDo.GetTitle())
+				}
+				return got
+			}
+			if got, want := titles(), []string{"third", "second", "first"}; !reflect.DeepEqual(got, want) {
+				t.Errorf("ListRevisions() = %v, want %v", got, want)
+			}
+			if res, err := client.GetRevision(ctx, &v1.GetRevisionRequest{Id: id, Revision: 2}); err != nil ||
+				res.Revision.ToDo.GetTitle() != "second" {
+				t.Errorf("GetRevision() = %v, %v", res, err)
+			}
+
+			// restore is recorded as new revision
+			res, err := client.RestoreRevision(ctx, &v1.RestoreRevisionRequest{Id: id, Revision: 1})
+			if err != nil || res.Revision != 4 || res.ToDo.GetTitle() != "first" || res.ToDo.Id != id {
+				t.Fatalf("RestoreRevision() = %v, %v", res, err)
+			}
+			if read, err := client.Read(ctx, &v1.ReadRequest{Id: id}); err != nil || read.ToDo.Title != "first" {
+				t.Errorf("Read() after RestoreRevision() = %v, %v", read, err)
+			}
+			if got, want := titles(), []string{"first", "third", "second", "first"}; !reflect.DeepEqual(got, want) {
+				t.Errorf("ListRevisions() after RestoreRevision() = %v, want %v", got, want)
+			}
+
+			for _, tt := range []struct {
+				name string
+				err  error
+			}{
+				{"GetRevision() of unknown revision", func() error {
+					_, err := client.GetRevision(ctx, &v1.GetRevisionRequest{Id: id, Revision: 10})
+					return err
+				}()},
+				{"ListRevisions() of unknown task", func() error {
+					_, err := client.ListRevisions(ctx, &v1.ListRevisionsRequest{Id: 1000})
+					return err
+				}()},
+				{"RestoreRevision() of unknown revision", func() error {
+					_, err := client.RestoreRevision(ctx, &v1.RestoreRevisionRequest{Id: id, Revision: 10})
+					return err
+				}()},
+			} {
+				if status.Code(tt.err) != codes.NotFound {
+					t.Errorf("%s error = %v, want NotFound", tt.name, tt.err)
+				}
+			}
+
+			// task in trash cannot be reverted
+			if _, err := client.Delete(ctx, &v1.DeleteRequest{Id: id}); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := client.RestoreRevision(ctx, &v1.RestoreRevisionRequest{Id: id, Revision: 2}); status.Code(err) != codes.NotFound {
+				t.Errorf("RestoreRevision() of trashed task error = %v, want NotFound", err)
+			}
+		})
+	}
+}
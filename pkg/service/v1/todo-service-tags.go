@@ -0,0 +1,149 @@
+package v1
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/jinzhu/gorm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
+)
+
+const (
+	// maxTags is the largest number of tags of one task
+	maxTags = 32
+	// maxTagLength is the longest tag name in characters
+	maxTagLength = 64
+)
+
+// checkTags trims, deduplicates and sorts names of tags of task and checks them
+func checkTags(td *v1.ToDo) error {
+	set := map[string]bool{}
+	for _, name := range td.Tags {
+		name = strings.TrimSpace(name)
+		switch {
+		case len(name) == 0:
+			return status.Error(codes.InvalidArgument, "tag name is empty")
+		case utf8.RuneCountInString(name) > maxTagLength:
+			return status.Errorf(codes.InvalidArgument, "tag name '%s' is longer than %d characters", name, maxTagLength)
+		case strings.ContainsAny(name, ",\r\n"):
+			return status.Errorf(codes.InvalidArgument, "tag name '%s' contains comma or line break", name)
+		}
+		set[name] = true
+	}
+	if len(set) > maxTags {
+		return status.Errorf(codes.InvalidArgument, "task has %d tags, limit is %d", len(set), maxTags)
+	}
+	td.Tags = td.Tags[:0]
+	for name := range set {
+		td.Tags = append(td.Tags, name)
+	}
+	sort.Strings(td.Tags)
+	return nil
+}
+
+// saveTags labels task id by tags checked by checkTags, tags which do not exist are created
+func saveTags(tx *gorm.DB, id int64, names []string) error {
+	tags := make([]*v1.TagORM, 0, len(names))
+	for _, name := range names {
+		tag := &v1.TagORM{}
+		if err := tx.Where(v1.TagORM{Name: name}).FirstOrCreate(tag).Error; err != nil {
+			return status.Errorf(codes.Internal, "unable to save tag '%s', internal error: %v", name, err)
+		}
+		tags = append(tags, tag)
+	}
+	if err := tx.Model(&v1.ToDoORM{Id: id}).Association("Tags").Replace(tags).Error; err != nil {
+		return status.Errorf(codes.Internal, "unable to save tags, internal error: %v", err)
+	}
+	return nil
+}
+
+// withoutPreload returns db which does not preload tags, loadTags loads them for many tasks
+func withoutPreload(db *gorm.DB) *gorm.DB {
+	return db.Set("gorm:auto_preload", false)
+}
+
+// loadTags loads tags of tasks, unlike preloading it stays within limit of SQL variables for any number of tasks
+func loadTags(db *gorm.DB, orms []*v1.ToDoORM) error {
+	byID := make(map[int64]*v1.ToDoORM, len(orms))
+	ids := make([]int64, 0, len(orms))
+	for _, orm := range orms {
+		byID[orm.Id] = orm
+		ids = append(ids, orm.Id)
+	}
+	for len(ids) > 0 {
+		n := len(ids)
+		if n > importChunkSize {
+			n = importChunkSize
+		}
+		var labels []struct {
+			ToDoID int64
+			Name   string
+		}
+		err := db.New().Table("to_do_tags").
+			Select("to_do_tags.to_do_id, tags.name").
+			Joins("JOIN tags ON tags.id = to_do_tags.tag_id").
+			Where("to_do_tags.to_do_id IN (?)", ids[:n]).
+			Scan(&labels).Error
+		if err != nil {
+			return status.Errorf(codes.Internal, "unable to read tags, internal error: %v", err)
+		}
+		for _, l := range labels {
+			orm := byID[l.ToDoID]
+			orm.Tags = append(orm.Tags, &v1.TagORM{Name: l.Name})
+		}
+		ids = ids[n:]
+	}
+	return nil
+}
+
+// withTags selects tasks labeled by all of the tags
+func withTags(db *gorm.DB, names []string) *gorm.DB {
+	set := map[string]bool{}
+	for _, name := range names {
+		set[strings.TrimSpace(name)] = true
+	}
+	unique := make([]string, 0, len(set))
+	for name := range set {
+		unique = append(unique, name)
+	}
+	labeled := db.New().Table("to_do_tags").
+		Select("to_do_tags.to_do_id").
+		Joins("JOIN tags ON tags.id = to_do_tags.tag_id").
+		Where("tags.name IN (?)", unique).
+		Group("to_do_tags.to_do_id").
+		Having("count(*) = ?", len(unique))
+	return db.Where("id IN (?)", labeled.QueryExpr())
+}
+
+// List tags with number of tasks they label
+func (s *toDoServiceServer) ListTags(ctx context.Context, req *v1.ListTagsRequest) (*v1.ListTagsResponse, error) {
+	// check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+
+	var tags []*v1.Tag
+	err := s.db.New().Table("tags").
+		Select("tags.id, tags.name, count(to_dos.id) as count").
+		Joins("LEFT JOIN to_do_tags ON to_do_tags.tag_id = tags.id").
+		Joins("LEFT JOIN to_dos ON to_dos.id = to_do_tags.to_do_id AND to_dos.delete_time IS NULL").
+		Group("tags.id, tags.name").
+		Order("tags.name").
+		Scan(&tags).Error
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to read tags, internal error: %v", err)
+	}
+	if tags == nil {
+		tags = []*v1.Tag{}
+	}
+
+	return &v1.ListTagsResponse{
+		Api:  apiVersion,
+		Tags: tags,
+	}, nil
+}
@@ -0,0 +1,117 @@
+package v1_test
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
+	"go.smartmachine.io/go-grpc-api/pkg/testing/todotest"
+)
+
+func TestTags(t *testing.T) {
+	ctx := context.Background()
+
+	for _, transport := range []string{"gRPC", "REST"} {
+		t.Run(transport, func(t *testing.T) {
+			srv := todotest.Start(t, todotest.Config{})
+			defer srv.Close()
+			client := srv.Client
+			if transport == "REST" {
+				client = srv.REST
+			}
+
+			create := func(title string, tags ...string) int64 {
+				td := remindAt(time.Now())
+				td.Title, td.Tags = title, tags
+				res, err := client.Create(ctx, &v1.CreateRequest{ToDo: td})
+				if err != nil {
+					t.Fatalf("Create() error = %v", err)
+				}
+				return res.Id
+			}
+			home := create("home", " home ", "urgent", "home")
+			create("work", "work", "urgent")
+			trashed := create("trashed", "work")
+			create("untagged")
+			if _, err := client.Delete(ctx, &v1.DeleteRequest{Id: trashed}); err != nil {
+				t.Fatal(err)
+			}
+
+			read, err := client.Read(ctx, &v1.ReadRequest{Id: home})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if want := []string{"home", "urgent"}; !reflect.DeepEqual(read.ToDo.Tags, want) {
+				t.Errorf("Read() tags = %v, want %v", read.ToDo.Tags, want)
+			}
+
+			for _, tt := range []struct {
+				tags []string
+				want []string
+			}{
+				{tags: []string{"urgent"}, want: []string{"home", "work"}},
+				{tags: []string{"urgent", "work"}, want: []string{"work"}},
+				{tags: []string{"unknown"}},
+			} {
+				res, err := client.ReadAll(ctx, &v1.ReadAllRequest{Tags: tt.tags})
+				if err != nil {
+					t.Fatalf("ReadAll(%v) error = %v", tt.tags, err)
+				}
+				var got []string
+				for _, td := range res.ToDos {
+					got = append(got, td.Title)
+				}
+				if !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("ReadAll(%v) = %v, want %v", tt.tags, got, tt.want)
+				}
+			}
+
+			// update replaces tags
+			read.ToDo.Tags = []string{"home", "later"}
+			if _, err := client.Update(ctx, &v1.UpdateRequest{ToDo: read.ToDo}); err != nil {
+				t.Fatal(err)
+			}
+
+			tags, err := client.ListTags(ctx, &v1.ListTagsRequest{})
+			if err != nil {
+				t.Fatalf("ListTags() error = %v", err)
+			}
+			counts := map[string]int64{}
+			for _, tag := range tags.Tags {
+				counts[tag.Name] = tag.Count
+			}
+			if want := map[string]int64{"home": 1, "later": 1, "urgent": 1, "work": 1}; !reflect.DeepEqual(counts, want) {
+				t.Errorf("ListTags() = %v, want %v", counts, want)
+			}
+
+			var tooMany []string
+			for i := 0; i <= 32; i++ {
+				tooMany = append(tooMany, strconv.Itoa(i))
+			}
+			for _, tags := range [][]string{
+				{""},
+				{"a,b"},
+				{strings.Repeat("x", 65)},
+				tooMany,
+			} {
+				td := remindAt(time.Now())
+				td.Title, td.Tags = "invalid", tags
+				if _, err := client.Create(ctx, &v1.CreateRequest{ToDo: td}); status.Code(err) != codes.InvalidArgument {
+					t.Errorf("Create() with tags %q error = %v, want InvalidArgument", tags, err)
+				}
+			}
+			td := read.ToDo
+			td.Tags = []string{"work\nhome"}
+			if _, err := client.Update(ctx, &v1.UpdateRequest{ToDo: td}); status.Code(err) != codes.InvalidArgument {
+				t.Errorf("Update() with invalid tag error = %v, want InvalidArgument", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/jinzhu/gorm"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
+	"go.smartmachine.io/go-grpc-api/pkg/logger"
+)
+
+// Restore todo task from trash
+func (s *toDoServiceServer) Undelete(ctx context.Context, req *v1.UndeleteRequest) (*v1.UndeleteResponse, error) {
+	// check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+
+	var td *v1.ToDo
+	err := s.inTransaction(func(tx *gorm.DB) error {
+		var orm v1.ToDoORM
+		if err := tx.Unscoped().Where("delete_time IS NOT NULL").First(&orm, req.Id).Error; err != nil {
+			if gorm.IsRecordNotFoundError(err) {
+				return status.Errorf(codes.NotFound, "record not found in trash: %d", req.Id)
+			}
+			return status.Errorf(codes.Internal, "error reading record: %v", err)
+		}
+		before, err := snapshot(ctx, &orm)
+		if err != nil {
+			return err
+		}
+		ok, err := projectExists(tx, orm.ProjectId)
+		if err != nil {
+			return err
+		}
+		restore := map[string]interface{}{"delete_time": nil}
+		if !ok {
+			// task of deleted project is restored without project
+			restore["project_id"], orm.ProjectId = 0, 0
+		}
+		res := tx.Unscoped().Model(&v1.ToDoORM{}).Where("id = ?", req.Id).Updates(restore)
+		if res.Error != nil {
+			logger.FromContext(ctx).Error("failed to restore todo task", zap.Int64("id", req.Id), zap.Error(res.Error))
+			return status.Errorf(codes.Internal, "unable to restore, internal error: %v", res.Error)
+		}
+		orm.DeletedAt = nil
+		if td, err = snapshot(ctx, &orm); err != nil {
+			return err
+		}
+		return recordChange(ctx, tx, before, td)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logger.FromContext(ctx).Info("todo task restored", zap.Int64("id", req.Id))
+	s.publish(v1.EventType_UNDELETED, td)
+
+	return &v1.UndeleteResponse{
+		Api:  apiVersion,
+		ToDo: td,
+	}, nil
+}
+
+// List todo tasks in trash
+func (s *toDoServiceServer) ListDeleted(ctx context.Context, req *v1.ListDeletedRequest) (*v1.ListDeletedResponse, error) {
+	// check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+
+	var orms []*v1.ToDoORM
+	err := withoutPreload(s.db).Unscoped().Where("delete_time IS NOT NULL").Order("delete_time desc, id").Find(&orms).Error
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to read records, internal error: %v", err)
+	}
+	if err := loadTags(s.db, orms); err != nil {
+		return nil, err
+	}
+
+	list := []*v1.ToDo{}
+	for _, orm := range orms {
+		td, err := orm.ToPB(ctx)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "unable to convert to orm representation: "+err.Error())
+		}
+		list = append(list, &td)
+	}
+
+	return &v1.ListDeletedResponse{
+		Api:   apiVersion,
+		ToDos: list,
+	}, nil
+}
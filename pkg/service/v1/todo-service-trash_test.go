@@ -0,0 +1,77 @@
+package v1_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
+	"go.smartmachine.io/go-grpc-api/pkg/testing/todotest"
+)
+
+func TestTrash(t *testing.T) {
+	ctx := context.Background()
+
+	for _, transport := range []string{"gRPC", "REST"} {
+		t.Run(transport, func(t *testing.T) {
+			srv := todotest.Start(t, todotest.Config{})
+			defer srv.Close()
+			client := srv.Client
+			if transport == "REST" {
+				client = srv.REST
+			}
+
+			kept, trashed := remindAt(time.Now()), remindAt(time.Now())
+			kept.Title, trashed.Title = "kept", "trashed"
+			ids := srv.Seed(t, kept, trashed)
+			if _, err := client.Delete(ctx, &v1.DeleteRequest{Id: ids[1]}); err != nil {
+				t.Fatal(err)
+			}
+
+			// trashed task is hidden unless showDeleted is set
+			if _, err := client.Read(ctx, &v1.ReadRequest{Id: ids[1]}); status.Code(err) != codes.NotFound {
+				t.Errorf("Read() of trashed task error = %v, want NotFound", err)
+			}
+			if res, err := client.Read(ctx, &v1.ReadRequest{Id: ids[1], ShowDeleted: true}); err != nil || res.ToDo.DeletedAt == nil {
+				t.Errorf("Read() with showDeleted = %v, %v", res, err)
+			}
+			if res, err := client.ReadAll(ctx, &v1.ReadAllRequest{}); err != nil || len(res.ToDos) != 1 {
+				t.Errorf("ReadAll() = %v, %v, want 1 task", res, err)
+			}
+			if res, err := client.ReadAll(ctx, &v1.ReadAllRequest{ShowDeleted: true}); err != nil || len(res.ToDos) != 2 {
+				t.Errorf("ReadAll() with showDeleted = %v, %v, want 2 tasks", res, err)
+			}
+			if res, err := client.ListDeleted(ctx, &v1.ListDeletedRequest{}); err != nil ||
+				len(res.ToDos) != 1 || res.ToDos[0].Id != ids[1] {
+				t.Errorf("ListDeleted() = %v, %v", res, err)
+			}
+
+			// trashed task cannot be updated or deleted again
+			trashed.Id = ids[1]
+			if _, err := client.Update(ctx, &v1.UpdateRequest{ToDo: trashed}); status.Code(err) != codes.NotFound {
+				t.Errorf("Update() of trashed task error = %v, want NotFound", err)
+			}
+			if res, err := client.Delete(ctx, &v1.DeleteRequest{Id: ids[1]}); err != nil || res.Deleted != 0 {
+				t.Errorf("Delete() of trashed task = %v, %v, want 0 deleted", res, err)
+			}
+
+			// undelete restores task
+			if _, err := client.Undelete(ctx, &v1.UndeleteRequest{Id: ids[0]}); status.Code(err) != codes.NotFound {
+				t.Errorf("Undelete() of task not in trash error = %v, want NotFound", err)
+			}
+			res, err := client.Undelete(ctx, &v1.UndeleteRequest{Id: ids[1]})
+			if err != nil || res.ToDo.Title != "trashed" || res.ToDo.DeletedAt != nil {
+				t.Fatalf("Undelete() = %v, %v", res, err)
+			}
+			if _, err := client.Read(ctx, &v1.ReadRequest{Id: ids[1]}); err != nil {
+				t.Errorf("Read() of restored task error = %v", err)
+			}
+			if res, err := client.ListDeleted(ctx, &v1.ListDeletedRequest{}); err != nil || len(res.ToDos) != 0 {
+				t.Errorf("ListDeleted() after Undelete() = %v, %v", res, err)
+			}
+		})
+	}
+}
@@ -2,12 +2,19 @@ package v1
 
 import (
 	"context"
+	"strconv"
+
+	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/jinzhu/gorm"
+	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
 	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
+	"go.smartmachine.io/go-grpc-api/pkg/events"
+	"go.smartmachine.io/go-grpc-api/pkg/logger"
 )
 
 const (
@@ -17,12 +24,22 @@ const (
 
 // toDoServiceServer is implementation of v1.ToDoServiceServer proto interface
 type toDoServiceServer struct {
-	db *gorm.DB
+	db  *gorm.DB
+	bus *events.Bus
 }
 
-// NewToDoServiceServer creates ToDo service
-func NewToDoServiceServer(db *gorm.DB) v1.ToDoServiceServer {
-	return &toDoServiceServer{db: db}
+// NewToDoServiceServer creates ToDo service publishing changes to bus,
+// nil bus disables Watch
+func NewToDoServiceServer(db *gorm.DB, bus *events.Bus) v1.ToDoServiceServer {
+	// tags are read with tasks
+	return &toDoServiceServer{db: db.Set("gorm:auto_preload", true), bus: bus}
+}
+
+// publish sends change of todo task to watchers
+func (s *toDoServiceServer) publish(typ v1.EventType, td *v1.ToDo) {
+	if s.bus != nil {
+		s.bus.Publish(typ, td)
+	}
 }
 
 // checkAPI checks if the API version requested by client is supported by server
@@ -48,17 +65,43 @@ func (s *toDoServiceServer) Create(ctx context.Context, req *v1.CreateRequest) (
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, "reminder field has invalid format-> "+err.Error())
 	}
+	if err := checkRecurrence(req.ToDo); err != nil {
+		return nil, err
+	}
+	if err := checkTags(req.ToDo); err != nil {
+		return nil, err
+	}
 
 	orm, err := req.ToDo.ToORM(ctx)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "unable to convert to orm representation: " + err.Error())
 	}
 
-	err = s.db.Create(&orm).Error
+	td := *req.ToDo
+	err = s.inTransaction(func(tx *gorm.DB) error {
+		if err := checkToDoProject(tx, &td); err != nil {
+			return err
+		}
+		if err := tx.Create(&orm).Error; err != nil {
+			logger.FromContext(ctx).Error("failed to insert todo task", zap.Error(err))
+			return status.Errorf(codes.Internal, "unable to insert, internal error: %v", err)
+		}
+		td.Id = orm.Id
+		if len(td.Tags) > 0 {
+			if err := saveTags(tx, td.Id, td.Tags); err != nil {
+				return err
+			}
+		}
+		return recordChange(ctx, tx, nil, &td)
+	})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "unable to insert, internal error: %v", err)
+		return nil, err
 	}
 
+	logger.FromContext(ctx).Info("todo task created", zap.Int64("id", orm.Id))
+
+	s.publish(v1.EventType_CREATED, &td)
+
 	return &v1.CreateResponse{
 		Api: apiVersion,
 		Id:  orm.Id,
@@ -72,8 +115,12 @@ func (s *toDoServiceServer) Read(ctx context.Context, req *v1.ReadRequest) (*v1.
 		return nil, err
 	}
 
+	db := s.db
+	if req.ShowDeleted {
+		db = db.Unscoped()
+	}
 	var orm v1.ToDoORM
-	err := s.db.First(&orm, req.Id).Error
+	err := db.First(&orm, req.Id).Error
 	if err != nil {
 		if gorm.IsRecordNotFoundError(err) {
 			return nil, status.Errorf(codes.NotFound, "record not found: %v", err)
@@ -99,45 +146,107 @@ func (s *toDoServiceServer) Update(ctx context.Context, req *v1.UpdateRequest) (
 	if err := s.checkAPI(req.Api); err != nil {
 		return nil, err
 	}
+	if err := checkRecurrence(req.ToDo); err != nil {
+		return nil, err
+	}
+	if err := checkTags(req.ToDo); err != nil {
+		return nil, err
+	}
 
 	orm, err := req.ToDo.ToORM(ctx)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "unable to convert to orm representation: " + err.Error())
 	}
+	// tasks are moved to trash by Delete only
+	orm.DeletedAt = nil
 
-	err = s.db.Save(orm).Error
-	if err != nil {
-		if gorm.IsRecordNotFoundError(err) {
-			return nil, status.Errorf(codes.NotFound, "record not found: %v", err)
+	td := *req.ToDo
+	err = s.inTransaction(func(tx *gorm.DB) error {
+		var old v1.ToDoORM
+		var before *v1.ToDo
+		err := tx.Unscoped().First(&old, orm.Id).Error
+		switch {
+		case err == nil && old.DeletedAt != nil:
+			return status.Errorf(codes.NotFound, "record not found: %d is in trash", orm.Id)
+		case err == nil:
+			if before, err = snapshot(ctx, &old); err != nil {
+				return err
+			}
+		case gorm.IsRecordNotFoundError(err):
+			// task which does not exist is created
+		default:
+			return status.Errorf(codes.Internal, "error reading record: %v", err)
 		}
-		return nil, status.Errorf(codes.Internal, "error updating record: %v", err)
+		if err := checkToDoProject(tx, &td); err != nil {
+			return err
+		}
+		if err := tx.Save(orm).Error; err != nil {
+			logger.FromContext(ctx).Error("failed to update todo task", zap.Int64("id", orm.Id), zap.Error(err))
+			return status.Errorf(codes.Internal, "error updating record: %v", err)
+		}
+		td.Id = orm.Id
+		td.DeletedAt = nil
+		if err := saveTags(tx, td.Id, td.Tags); err != nil {
+			return err
+		}
+		return recordChange(ctx, tx, before, &td)
+	})
+	if err != nil {
+		return nil, err
 	}
 
+	logger.FromContext(ctx).Info("todo task updated", zap.Int64("id", orm.Id))
+
+	s.publish(v1.EventType_UPDATED, &td)
+
 	return &v1.UpdateResponse{
 		Api:     apiVersion,
 		Updated: 1,
 	}, nil
 }
 
-// Delete todo task
+// Delete todo task, it is moved to trash
 func (s *toDoServiceServer) Delete(ctx context.Context, req *v1.DeleteRequest) (*v1.DeleteResponse, error) {
 	// check if the API version requested by client is supported by server
 	if err := s.checkAPI(req.Api); err != nil {
 		return nil, err
 	}
 
-	db := s.db.Delete(&v1.ToDoORM{Id: req.Id})
-	err := db.Error
-	if err != nil{
-		if gorm.IsRecordNotFoundError(err){
-			return nil, status.Errorf(codes.NotFound, "unable to delete, record not found: %v", err)
+	var deleted int64
+	err := s.inTransaction(func(tx *gorm.DB) error {
+		var old v1.ToDoORM
+		if err := tx.First(&old, req.Id).Error; err != nil {
+			if gorm.IsRecordNotFoundError(err) {
+				// deleting task which does not exist is not an error
+				return nil
+			}
+			return status.Errorf(codes.Internal, "error reading record: %v", err)
+		}
+		before, err := snapshot(ctx, &old)
+		if err != nil {
+			return err
+		}
+		db := tx.Delete(&v1.ToDoORM{Id: req.Id})
+		if db.Error != nil {
+			logger.FromContext(ctx).Error("failed to delete todo task", zap.Int64("id", req.Id), zap.Error(db.Error))
+			return status.Errorf(codes.Internal, "unable to delete, internal error: %v", db.Error)
 		}
-		return nil, status.Errorf(codes.Internal, "unable to delete, internal error: %v", err)
+		deleted = db.RowsAffected
+		return recordChange(ctx, tx, before, nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logger.FromContext(ctx).Info("todo task deleted", zap.Int64("id", req.Id), zap.Int64("deleted", deleted))
+
+	if deleted > 0 {
+		s.publish(v1.EventType_DELETED, &v1.ToDo{Id: req.Id})
 	}
 
 	return &v1.DeleteResponse{
 		Api:     apiVersion,
-		Deleted: db.RowsAffected,
+		Deleted: deleted,
 	}, nil
 }
 
@@ -148,16 +257,36 @@ func (s *toDoServiceServer) ReadAll(ctx context.Context, req *v1.ReadAllRequest)
 		return nil, err
 	}
 
+	db := withoutPreload(s.db)
+	if req.ShowDeleted {
+		db = db.Unscoped()
+	}
+	if len(req.Tags) > 0 {
+		db = withTags(db, req.Tags)
+	}
+	if req.ProjectId != 0 {
+		ok, err := projectExists(s.db, req.ProjectId)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, status.Errorf(codes.NotFound, "project not found: %d", req.ProjectId)
+		}
+		db = db.Where("project_id = ?", req.ProjectId)
+	}
 	var users []*v1.ToDoORM
 	var count int
 
-	err := s.db.Find(&users).Count(&count).Error
+	err := db.Find(&users).Count(&count).Error
 	if gorm.IsRecordNotFoundError(err) {
 		return nil, status.Errorf(codes.NotFound, "unable to read records, not found: %v", err)
 
 	} else if err != nil {
 		return nil, status.Errorf(codes.Internal, "unable to read records, internal error: %v", err)
 	}
+	if err := loadTags(s.db, users); err != nil {
+		return nil, err
+	}
 
 	list := []*v1.ToDo{}
 	for _, todo := range users {
@@ -174,4 +303,83 @@ func (s *toDoServiceServer) ReadAll(ctx context.Context, req *v1.ReadAllRequest)
 		Api:   apiVersion,
 		ToDos: list,
 	}, nil
-}
\ No newline at end of file
+}
+
+const (
+	// lastEventIDKey is metadata key of the Last-Event-ID header sent by reconnecting server-sent events client
+	lastEventIDKey = "last-event-id"
+	// watchSequenceKey is header metadata key with sequence number of the last change before watching started
+	watchSequenceKey = "watch-sequence"
+)
+
+// Watch changes of todo tasks
+func (s *toDoServiceServer) Watch(req *v1.WatchRequest, stream v1.ToDoService_WatchServer) error {
+	// check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return err
+	}
+	if s.bus == nil {
+		return status.Error(codes.Unimplemented, "watching changes is not enabled")
+	}
+	ctx := stream.Context()
+
+	after := req.AfterSequence
+	if after == 0 {
+		if md, ok := metadata.FromIncomingContext(ctx); ok && len(md.Get(lastEventIDKey)) > 0 {
+			id, err := strconv.ParseInt(md.Get(lastEventIDKey)[0], 10, 64)
+			if err != nil {
+				return status.Errorf(codes.InvalidArgument, "invalid Last-Event-ID '%s': %v", md.Get(lastEventIDKey)[0], err)
+			}
+			after = id
+		}
+	}
+	if after < 0 {
+		return status.Errorf(codes.InvalidArgument, "invalid afterSequence: %d", after)
+	}
+
+	types := map[v1.EventType]bool{}
+	for _, t := range req.Types {
+		types[t] = true
+	}
+	ids := map[int64]bool{}
+	for _, id := range req.Ids {
+		ids[id] = true
+	}
+	sub, err := s.bus.Subscribe(after, func(e *v1.Event) bool {
+		return (len(types) == 0 || types[e.Type]) && (len(ids) == 0 || ids[e.ToDo.GetId()])
+	})
+	switch err {
+	case nil:
+	case events.ErrHistoryExpired:
+		return status.Errorf(codes.OutOfRange, "unable to resume after sequence %d: %v", after, err)
+	default:
+		return status.Errorf(codes.Unavailable, "unable to watch: %v", err)
+	}
+	defer sub.Close()
+
+	// send headers right away, so HTTP/REST clients see the stream is open before the first change
+	if err := stream.SendHeader(metadata.Pairs(watchSequenceKey, strconv.FormatInt(sub.Sequence, 10))); err != nil {
+		return err
+	}
+	logger.FromContext(ctx).Info("watching todo tasks", zap.Int64("afterSequence", after))
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case e, ok := <-sub.C:
+			if !ok {
+				switch sub.Err() {
+				case events.ErrSlowSubscriber:
+					return status.Error(codes.ResourceExhausted, "watcher did not keep up with changes")
+				default:
+					return status.Error(codes.Unavailable, "server is shutting down")
+				}
+			}
+			// event is shared by all subscribers, marshaling it caches its size, so the copy is sent
+			if err := stream.Send(&v1.WatchResponse{Api: apiVersion, Event: proto.Clone(e).(*v1.Event)}); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -25,13 +25,22 @@ func Test_toDoServiceServer_Create(t *testing.T) {
 	if err != nil {
 		t.Fatalf("failed to open database: %v", err)
 	}
-	mock.ExpectExec("CREATE TABLE \"to_dos\" (\"description\" varchar(255),\"id\" integer primary key autoincrement,\"reminder\" datetime,\"title\" varchar(255) )").
+	mock.ExpectExec("CREATE TABLE \"to_do_tags\" (\"to_do_id\" bigint,\"tag_id\" bigint, PRIMARY KEY (\"to_do_id\",\"tag_id\"))").
 		WillReturnResult(sqlmock.NewResult(0,0))
+	mock.ExpectExec("CREATE TABLE \"to_dos\" (\"delete_time\" datetime,\"description\" varchar(255),\"done\" bool,\"external_id\" varchar(255),\"id\" integer primary key autoincrement,\"project_id\" bigint,\"recurrence\" varchar(255),\"reminder\" datetime,\"title\" varchar(255) )").
+		WillReturnResult(sqlmock.NewResult(0,0))
+	mock.ExpectExec("CREATE INDEX idx_to_dos_external_id ON \"to_dos\"(external_id) ").
+		WillReturnResult(sqlmock.NewResult(0,0))
+	mock.ExpectExec("CREATE INDEX idx_to_dos_project_id ON \"to_dos\"(project_id) ").
+		WillReturnResult(sqlmock.NewResult(0,0))
+	// indexes are created in random order
+	mock.MatchExpectationsInOrder(false)
 	err = db.AutoMigrate(&v1.ToDoORM{}).Error
+	mock.MatchExpectationsInOrder(true)
 	if err != nil {
 		t.Fatalf("failed to create schema: %v", err)
 	}
-	s := NewToDoServiceServer(db)
+	s := NewToDoServiceServer(db, nil)
 	tm := time.Now().In(time.UTC)
 	reminder, _ := ptypes.TimestampProto(tm)
 
@@ -62,9 +71,13 @@ func Test_toDoServiceServer_Create(t *testing.T) {
 				},
 			},
 			mock: func() {
-				mock.ExpectExec("INSERT INTO \"to_dos\" (\"description\",\"reminder\",\"title\") VALUES (?,?,?)").
-					WithArgs("description", tm, "title").
+				mock.ExpectBegin()
+				mock.ExpectExec("INSERT INTO \"to_dos\" (\"delete_time\",\"description\",\"done\",\"external_id\",\"project_id\",\"recurrence\",\"reminder\",\"title\") VALUES (?,?,?,?,?,?,?,?)").
+					WithArgs(nil, "description", false, "", 0, "", tm, "title").
 					WillReturnResult(sqlmock.NewResult(1, 1))
+				expectAudit(mock, 1)
+				expectRevision(mock, 1)
+				mock.ExpectCommit()
 			},
 			want: &v1.CreateResponse{
 				Api: "v1",
@@ -126,9 +139,11 @@ func Test_toDoServiceServer_Create(t *testing.T) {
 				},
 			},
 			mock: func() {
-				mock.ExpectExec("INSERT INTO \"to_dos\" (\"description\",\"reminder\",\"title\") VALUES (?,?,?)").
-					WithArgs("description", tm, "title").
+				mock.ExpectBegin()
+				mock.ExpectExec("INSERT INTO \"to_dos\" (\"delete_time\",\"description\",\"done\",\"external_id\",\"project_id\",\"recurrence\",\"reminder\",\"title\") VALUES (?,?,?,?,?,?,?,?)").
+					WithArgs(nil, "description", false, "", 0, "", tm, "title").
 					WillReturnError(errors.New("INSERT failed"))
+				mock.ExpectRollback()
 			},
 			wantErr: true,
 		},
@@ -147,9 +162,11 @@ func Test_toDoServiceServer_Create(t *testing.T) {
 				},
 			},
 			mock: func() {
-				mock.ExpectExec("INSERT INTO \"to_dos\" (\"description\",\"reminder\",\"title\") VALUES (?,?,?)").
-					WithArgs("description", tm, "title").
+				mock.ExpectBegin()
+				mock.ExpectExec("INSERT INTO \"to_dos\" (\"delete_time\",\"description\",\"done\",\"external_id\",\"project_id\",\"recurrence\",\"reminder\",\"title\") VALUES (?,?,?,?,?,?,?,?)").
+					WithArgs(nil, "description", false, "", 0, "", tm, "title").
 					WillReturnResult(sqlmock.NewErrorResult(errors.New("LastInsertId failed")))
+				mock.ExpectRollback()
 			},
 			wantErr: true,
 		},
@@ -171,6 +188,30 @@ func Test_toDoServiceServer_Create(t *testing.T) {
 
 
 
+// expectAudit expects audit record of change of task id
+func expectAudit(mock sqlmock.Sqlmock, id int64) {
+	mock.ExpectExec("INSERT INTO \"audit_events\" (\"to_do_id\",\"actor\",\"rpc\",\"request_id\",\"before\",\"after\",\"time\",\"unverified_actor\") VALUES (?,?,?,?,?,?,?,?)").
+		WithArgs(id, "", "", "", sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), "").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+}
+
+// expectRevision expects first revision of task id
+func expectRevision(mock sqlmock.Sqlmock, id int64) {
+	mock.ExpectQuery("SELECT coalesce(max(number), 0) as number FROM \"to_do_revisions\"  WHERE (to_do_id = ?)").
+		WithArgs(id).
+		WillReturnRows(sqlmock.NewRows([]string{"number"}).AddRow(0))
+	mock.ExpectExec("INSERT INTO \"to_do_revisions\" (\"to_do_id\",\"number\",\"snapshot\",\"create_time\") VALUES (?,?,?,?)").
+		WithArgs(id, 1, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+}
+
+// expectTags expects preloading of tags of task id, task has no tags
+func expectTags(mock sqlmock.Sqlmock, id int64) {
+	mock.ExpectQuery("SELECT * FROM \"tags\" INNER JOIN \"to_do_tags\" ON \"to_do_tags\".\"tag_id\" = \"tags\".\"id\" WHERE (\"to_do_tags\".\"to_do_id\" IN (?))").
+		WithArgs(id).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "to_do_id", "tag_id"}))
+}
+
 func Test_toDoServiceServer_Read(t *testing.T) {
 	ctx := context.Background()
 	mockDB, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
@@ -183,13 +224,22 @@ func Test_toDoServiceServer_Read(t *testing.T) {
 	if err != nil {
 		t.Fatalf("failed to open database: %v", err)
 	}
-	mock.ExpectExec("CREATE TABLE \"to_dos\" (\"description\" varchar(255),\"id\" integer primary key autoincrement,\"reminder\" datetime,\"title\" varchar(255) )").
+	mock.ExpectExec("CREATE TABLE \"to_do_tags\" (\"to_do_id\" bigint,\"tag_id\" bigint, PRIMARY KEY (\"to_do_id\",\"tag_id\"))").
+		WillReturnResult(sqlmock.NewResult(0,0))
+	mock.ExpectExec("CREATE TABLE \"to_dos\" (\"delete_time\" datetime,\"description\" varchar(255),\"done\" bool,\"external_id\" varchar(255),\"id\" integer primary key autoincrement,\"project_id\" bigint,\"recurrence\" varchar(255),\"reminder\" datetime,\"title\" varchar(255) )").
+		WillReturnResult(sqlmock.NewResult(0,0))
+	mock.ExpectExec("CREATE INDEX idx_to_dos_external_id ON \"to_dos\"(external_id) ").
+		WillReturnResult(sqlmock.NewResult(0,0))
+	mock.ExpectExec("CREATE INDEX idx_to_dos_project_id ON \"to_dos\"(project_id) ").
 		WillReturnResult(sqlmock.NewResult(0,0))
+	// indexes are created in random order
+	mock.MatchExpectationsInOrder(false)
 	err = db.AutoMigrate(&v1.ToDoORM{}).Error
+	mock.MatchExpectationsInOrder(true)
 	if err != nil {
 		t.Fatalf("failed to create schema: %v", err)
 	}
-	s := NewToDoServiceServer(db)
+	s := NewToDoServiceServer(db, nil)
 	tm := time.Now().In(time.UTC)
 	reminder, _ := ptypes.TimestampProto(tm)
 
@@ -218,7 +268,8 @@ func Test_toDoServiceServer_Read(t *testing.T) {
 			mock: func() {
 				rows := sqlmock.NewRows([]string{"id", "title", "description", "reminder"}).
 					AddRow(1, "title", "description", tm)
-				mock.ExpectQuery("SELECT * FROM \"to_dos\" WHERE (\"to_dos\".\"id\" = 1) ORDER BY \"to_dos\".\"id\" ASC LIMIT 1").WillReturnRows(rows)
+				mock.ExpectQuery("SELECT * FROM \"to_dos\" WHERE \"to_dos\".\"delete_time\" IS NULL AND ((\"to_dos\".\"id\" = 1)) ORDER BY \"to_dos\".\"id\" ASC LIMIT 1").WillReturnRows(rows)
+				expectTags(mock, 1)
 			},
 			want: &v1.ReadResponse{
 				Api: "v1",
@@ -254,7 +305,7 @@ func Test_toDoServiceServer_Read(t *testing.T) {
 				},
 			},
 			mock: func() {
-				mock.ExpectQuery("SELECT * FROM \"to_dos\" WHERE (\"to_dos\".\"id\" = 1) ORDER BY \"to_dos\".\"id\" ASC LIMIT 1").
+				mock.ExpectQuery("SELECT * FROM \"to_dos\" WHERE \"to_dos\".\"delete_time\" IS NULL AND ((\"to_dos\".\"id\" = 1)) ORDER BY \"to_dos\".\"id\" ASC LIMIT 1").
 					WillReturnError(errors.New("SELECT failed"))
 			},
 			wantErr: true,
@@ -271,7 +322,7 @@ func Test_toDoServiceServer_Read(t *testing.T) {
 			},
 			mock: func() {
 				rows := sqlmock.NewRows([]string{"id", "title", "description", "reminder"})
-				mock.ExpectQuery("SELECT * FROM \"to_dos\" WHERE (\"to_dos\".\"id\" = 1) ORDER BY \"to_dos\".\"id\" ASC LIMIT 1").
+				mock.ExpectQuery("SELECT * FROM \"to_dos\" WHERE \"to_dos\".\"delete_time\" IS NULL AND ((\"to_dos\".\"id\" = 1)) ORDER BY \"to_dos\".\"id\" ASC LIMIT 1").
 					WillReturnRows(rows)
 			},
 			wantErr: true,
@@ -305,15 +356,30 @@ func Test_toDoServiceServer_Update(t *testing.T) {
 	if err != nil {
 		t.Fatalf("failed to open database: %v", err)
 	}
-	mock.ExpectExec("CREATE TABLE \"to_dos\" (\"description\" varchar(255),\"id\" integer primary key autoincrement,\"reminder\" datetime,\"title\" varchar(255) )").
+	mock.ExpectExec("CREATE TABLE \"to_do_tags\" (\"to_do_id\" bigint,\"tag_id\" bigint, PRIMARY KEY (\"to_do_id\",\"tag_id\"))").
 		WillReturnResult(sqlmock.NewResult(0,0))
+	mock.ExpectExec("CREATE TABLE \"to_dos\" (\"delete_time\" datetime,\"description\" varchar(255),\"done\" bool,\"external_id\" varchar(255),\"id\" integer primary key autoincrement,\"project_id\" bigint,\"recurrence\" varchar(255),\"reminder\" datetime,\"title\" varchar(255) )").
+		WillReturnResult(sqlmock.NewResult(0,0))
+	mock.ExpectExec("CREATE INDEX idx_to_dos_external_id ON \"to_dos\"(external_id) ").
+		WillReturnResult(sqlmock.NewResult(0,0))
+	mock.ExpectExec("CREATE INDEX idx_to_dos_project_id ON \"to_dos\"(project_id) ").
+		WillReturnResult(sqlmock.NewResult(0,0))
+	// indexes are created in random order
+	mock.MatchExpectationsInOrder(false)
 	err = db.AutoMigrate(&v1.ToDoORM{}).Error
+	mock.MatchExpectationsInOrder(true)
 	if err != nil {
 		t.Fatalf("failed to create schema: %v", err)
 	}
-	s := NewToDoServiceServer(db)
+	s := NewToDoServiceServer(db, nil)
 	tm := time.Now().In(time.UTC)
 	reminder, _ := ptypes.TimestampProto(tm)
+	expectSelect := func(deletedAt interface{}) {
+		rows := sqlmock.NewRows([]string{"id", "title", "description", "reminder", "delete_time"}).
+			AddRow(1, "title", "description", tm, deletedAt)
+		mock.ExpectQuery("SELECT * FROM \"to_dos\" WHERE (\"to_dos\".\"id\" = 1) ORDER BY \"to_dos\".\"id\" ASC LIMIT 1").WillReturnRows(rows)
+		expectTags(mock, 1)
+	}
 
 	type args struct {
 		ctx context.Context
@@ -343,9 +409,17 @@ func Test_toDoServiceServer_Update(t *testing.T) {
 				},
 			},
 			mock: func() {
-				mock.ExpectExec("UPDATE \"to_dos\" SET \"description\" = ?, \"reminder\" = ?, \"title\" = ? WHERE \"to_dos\".\"id\" = ?").
-					WithArgs("new description", tm, "new title", 1).
+				mock.ExpectBegin()
+				expectSelect(nil)
+				mock.ExpectExec("UPDATE \"to_dos\" SET \"delete_time\" = ?, \"description\" = ?, \"done\" = ?, \"external_id\" = ?, \"project_id\" = ?, \"recurrence\" = ?, \"reminder\" = ?, \"title\" = ? WHERE \"to_dos\".\"delete_time\" IS NULL AND \"to_dos\".\"id\" = ?").
+					WithArgs(nil, "new description", false, "", 0, "", tm, "new title", 1).
 					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec("DELETE FROM \"to_do_tags\" WHERE (\"to_do_id\" IN (?))").
+					WithArgs(1).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				expectAudit(mock, 1)
+				expectRevision(mock, 1)
+				mock.ExpectCommit()
 			},
 			want: &v1.UpdateResponse{
 				Api:     "v1",
@@ -407,8 +481,11 @@ func Test_toDoServiceServer_Update(t *testing.T) {
 				},
 			},
 			mock: func() {
-				mock.ExpectExec("UPDATE \"to_dos\" SET \"description\" = ?, \"reminder\" = ?, \"title\" = ? WHERE \"to_dos\".\"id\" = ?").WithArgs("new description", tm, "new title", 1).
+				mock.ExpectBegin()
+				expectSelect(nil)
+				mock.ExpectExec("UPDATE \"to_dos\" SET \"delete_time\" = ?, \"description\" = ?, \"done\" = ?, \"external_id\" = ?, \"project_id\" = ?, \"recurrence\" = ?, \"reminder\" = ?, \"title\" = ? WHERE \"to_dos\".\"delete_time\" IS NULL AND \"to_dos\".\"id\" = ?").WithArgs(nil, "new description", false, "", 0, "", tm, "new title", 1).
 					WillReturnError(errors.New("UPDATE failed"))
+				mock.ExpectRollback()
 			},
 			wantErr: true,
 		},
@@ -428,13 +505,16 @@ func Test_toDoServiceServer_Update(t *testing.T) {
 				},
 			},
 			mock: func() {
-				mock.ExpectExec("UPDATE \"to_dos\" SET \"description\" = ?, \"reminder\" = ?, \"title\" = ? WHERE \"to_dos\".\"id\" = ?").WithArgs("new description", tm, "new title", 1).
+				mock.ExpectBegin()
+				expectSelect(nil)
+				mock.ExpectExec("UPDATE \"to_dos\" SET \"delete_time\" = ?, \"description\" = ?, \"done\" = ?, \"external_id\" = ?, \"project_id\" = ?, \"recurrence\" = ?, \"reminder\" = ?, \"title\" = ? WHERE \"to_dos\".\"delete_time\" IS NULL AND \"to_dos\".\"id\" = ?").WithArgs(nil, "new description", false, "", 0, "", tm, "new title", 1).
 					WillReturnResult(sqlmock.NewErrorResult(errors.New("RowsAffected failed")))
+				mock.ExpectRollback()
 			},
 			wantErr: true,
 		},
 		{
-			name: "Not Found",
+			name: "In trash",
 			s:    s,
 			args: args{
 				ctx: ctx,
@@ -449,8 +529,9 @@ func Test_toDoServiceServer_Update(t *testing.T) {
 				},
 			},
 			mock: func() {
-				mock.ExpectExec("UPDATE \"to_dos\" SET \"description\" = ?, \"reminder\" = ?, \"title\" = ? WHERE \"to_dos\".\"id\" = ?").WithArgs("new description", tm, "new title", 1).
-					WillReturnResult(sqlmock.NewResult(1, 0))
+				mock.ExpectBegin()
+				expectSelect(tm)
+				mock.ExpectRollback()
 			},
 			wantErr: true,
 		},
@@ -482,13 +563,32 @@ func Test_toDoServiceServer_Delete(t *testing.T) {
 	if err != nil {
 		t.Fatalf("failed to open database: %v", err)
 	}
-	mock.ExpectExec("CREATE TABLE \"to_dos\" (\"description\" varchar(255),\"id\" integer primary key autoincrement,\"reminder\" datetime,\"title\" varchar(255) )").
+	mock.ExpectExec("CREATE TABLE \"to_do_tags\" (\"to_do_id\" bigint,\"tag_id\" bigint, PRIMARY KEY (\"to_do_id\",\"tag_id\"))").
+		WillReturnResult(sqlmock.NewResult(0,0))
+	mock.ExpectExec("CREATE TABLE \"to_dos\" (\"delete_time\" datetime,\"description\" varchar(255),\"done\" bool,\"external_id\" varchar(255),\"id\" integer primary key autoincrement,\"project_id\" bigint,\"recurrence\" varchar(255),\"reminder\" datetime,\"title\" varchar(255) )").
 		WillReturnResult(sqlmock.NewResult(0,0))
+	mock.ExpectExec("CREATE INDEX idx_to_dos_external_id ON \"to_dos\"(external_id) ").
+		WillReturnResult(sqlmock.NewResult(0,0))
+	mock.ExpectExec("CREATE INDEX idx_to_dos_project_id ON \"to_dos\"(project_id) ").
+		WillReturnResult(sqlmock.NewResult(0,0))
+	// indexes are created in random order
+	mock.MatchExpectationsInOrder(false)
 	err = db.AutoMigrate(&v1.ToDoORM{}).Error
+	mock.MatchExpectationsInOrder(true)
 	if err != nil {
 		t.Fatalf("failed to create schema: %v", err)
 	}
-	s := NewToDoServiceServer(db)
+	s := NewToDoServiceServer(db, nil)
+	expectSelect := func(found bool) {
+		rows := sqlmock.NewRows([]string{"id", "title", "description", "reminder"})
+		if found {
+			rows.AddRow(1, "title", "description", time.Now().In(time.UTC))
+		}
+		mock.ExpectQuery("SELECT * FROM \"to_dos\" WHERE \"to_dos\".\"delete_time\" IS NULL AND ((\"to_dos\".\"id\" = 1)) ORDER BY \"to_dos\".\"id\" ASC LIMIT 1").WillReturnRows(rows)
+		if found {
+			expectTags(mock, 1)
+		}
+	}
 
 	type args struct {
 		ctx context.Context
@@ -513,8 +613,12 @@ func Test_toDoServiceServer_Delete(t *testing.T) {
 				},
 			},
 			mock: func() {
-				mock.ExpectExec("DELETE FROM \"to_dos\" WHERE \"to_dos\".\"id\" = ?").WithArgs(1).
+				mock.ExpectBegin()
+				expectSelect(true)
+				mock.ExpectExec("UPDATE \"to_dos\" SET \"delete_time\"=? WHERE \"to_dos\".\"delete_time\" IS NULL AND \"to_dos\".\"id\" = ?").WithArgs(sqlmock.AnyArg(), 1).
 					WillReturnResult(sqlmock.NewResult(1, 1))
+				expectAudit(mock, 1)
+				mock.ExpectCommit()
 			},
 			want: &v1.DeleteResponse{
 				Api:     "v1",
@@ -545,8 +649,11 @@ func Test_toDoServiceServer_Delete(t *testing.T) {
 				},
 			},
 			mock: func() {
-				mock.ExpectExec("DELETE FROM \"to_dos\" WHERE \"to_dos\".\"id\" = ?").WithArgs(1).
+				mock.ExpectBegin()
+				expectSelect(true)
+				mock.ExpectExec("UPDATE \"to_dos\" SET \"delete_time\"=? WHERE \"to_dos\".\"delete_time\" IS NULL AND \"to_dos\".\"id\" = ?").WithArgs(sqlmock.AnyArg(), 1).
 					WillReturnError(errors.New("DELETE failed"))
+				mock.ExpectRollback()
 			},
 			wantErr: true,
 		},
@@ -561,8 +668,11 @@ func Test_toDoServiceServer_Delete(t *testing.T) {
 				},
 			},
 			mock: func() {
-				mock.ExpectExec("DELETE FROM \"to_dos\" WHERE \"to_dos\".\"id\" = ?").WithArgs(1).
+				mock.ExpectBegin()
+				expectSelect(true)
+				mock.ExpectExec("UPDATE \"to_dos\" SET \"delete_time\"=? WHERE \"to_dos\".\"delete_time\" IS NULL AND \"to_dos\".\"id\" = ?").WithArgs(sqlmock.AnyArg(), 1).
 					WillReturnResult(sqlmock.NewErrorResult(errors.New("RowsAffected failed")))
+				mock.ExpectRollback()
 			},
 			wantErr: true,
 		},
@@ -577,8 +687,9 @@ func Test_toDoServiceServer_Delete(t *testing.T) {
 				},
 			},
 			mock: func() {
-				mock.ExpectExec("DELETE FROM \"to_dos\" WHERE \"to_dos\".\"id\" = ?").WithArgs(1).
-					WillReturnResult(sqlmock.NewResult(1, 0))
+				mock.ExpectBegin()
+				expectSelect(false)
+				mock.ExpectCommit()
 			},
 			want: &v1.DeleteResponse{
 				Api:     "v1",
@@ -613,13 +724,22 @@ func Test_toDoServiceServer_ReadAll(t *testing.T) {
 	if err != nil {
 		t.Fatalf("failed to open database: %v", err)
 	}
-	mock.ExpectExec("CREATE TABLE \"to_dos\" (\"description\" varchar(255),\"id\" integer primary key autoincrement,\"reminder\" datetime,\"title\" varchar(255) )").
+	mock.ExpectExec("CREATE TABLE \"to_do_tags\" (\"to_do_id\" bigint,\"tag_id\" bigint, PRIMARY KEY (\"to_do_id\",\"tag_id\"))").
+		WillReturnResult(sqlmock.NewResult(0,0))
+	mock.ExpectExec("CREATE TABLE \"to_dos\" (\"delete_time\" datetime,\"description\" varchar(255),\"done\" bool,\"external_id\" varchar(255),\"id\" integer primary key autoincrement,\"project_id\" bigint,\"recurrence\" varchar(255),\"reminder\" datetime,\"title\" varchar(255) )").
+		WillReturnResult(sqlmock.NewResult(0,0))
+	mock.ExpectExec("CREATE INDEX idx_to_dos_external_id ON \"to_dos\"(external_id) ").
+		WillReturnResult(sqlmock.NewResult(0,0))
+	mock.ExpectExec("CREATE INDEX idx_to_dos_project_id ON \"to_dos\"(project_id) ").
 		WillReturnResult(sqlmock.NewResult(0,0))
+	// indexes are created in random order
+	mock.MatchExpectationsInOrder(false)
 	err = db.AutoMigrate(&v1.ToDoORM{}).Error
+	mock.MatchExpectationsInOrder(true)
 	if err != nil {
 		t.Fatalf("failed to create schema: %v", err)
 	}
-	s := NewToDoServiceServer(db)
+	s := NewToDoServiceServer(db, nil)
 	tm1 := time.Now().In(time.UTC)
 	reminder1, _ := ptypes.TimestampProto(tm1)
 	tm2 := time.Now().In(time.UTC)
@@ -651,9 +771,12 @@ func Test_toDoServiceServer_ReadAll(t *testing.T) {
 					AddRow(1, "title 1", "description 1", tm1).
 					AddRow(2, "title 2", "description 2", tm2)
 
-				mock.ExpectQuery("SELECT * FROM \"to_dos\"").WillReturnRows(rows)
-				mock.ExpectQuery("SELECT count(*) FROM \"to_dos\"").
+				mock.ExpectQuery("SELECT * FROM \"to_dos\" WHERE \"to_dos\".\"delete_time\" IS NULL").WillReturnRows(rows)
+				mock.ExpectQuery("SELECT count(*) FROM \"to_dos\" WHERE \"to_dos\".\"delete_time\" IS NULL").
 					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+				mock.ExpectQuery("SELECT to_do_tags.to_do_id, tags.name FROM \"to_do_tags\" JOIN tags ON tags.id = to_do_tags.tag_id WHERE (to_do_tags.to_do_id IN (?,?))").
+					WithArgs(1, 2).
+					WillReturnRows(sqlmock.NewRows([]string{"to_do_id", "name"}))
 			},
 			want: &v1.ReadAllResponse{
 				Api: "v1",
@@ -684,8 +807,8 @@ func Test_toDoServiceServer_ReadAll(t *testing.T) {
 			},
 			mock: func() {
 				rows := sqlmock.NewRows([]string{"id", "title", "description", "reminder"})
-				mock.ExpectQuery("SELECT * FROM \"to_dos\"").WillReturnRows(rows)
-				mock.ExpectQuery("SELECT count(*) FROM \"to_dos\"").
+				mock.ExpectQuery("SELECT * FROM \"to_dos\" WHERE \"to_dos\".\"delete_time\" IS NULL").WillReturnRows(rows)
+				mock.ExpectQuery("SELECT count(*) FROM \"to_dos\" WHERE \"to_dos\".\"delete_time\" IS NULL").
 					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
 			},
 			want: &v1.ReadAllResponse{
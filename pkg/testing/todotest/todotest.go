@@ -0,0 +1,211 @@
+// Package todotest boots the full ToDo service stack in-process for end-to-end tests:
+// real SQLite database in a temporary directory, gRPC server over in-memory
+// connection and HTTP/REST gateway on httptest.Server.
+//
+//	func TestSomething(t *testing.T) {
+//		srv := todotest.Start(t, todotest.Config{})
+//		defer srv.Close()
+//
+//		res, err := srv.Client.Create(ctx, &v1.CreateRequest{...})
+//		...
+//		resp, err := http.Get(srv.URL + "/v1/todo/all")
+//	}
+package todotest
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	// register SQLite driver
+	_ "github.com/jinzhu/gorm/dialects/sqlite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+
+	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
+	"go.smartmachine.io/go-grpc-api/pkg/client/rest"
+	"go.smartmachine.io/go-grpc-api/pkg/events"
+	grpcserver "go.smartmachine.io/go-grpc-api/pkg/protocol/grpc"
+	restserver "go.smartmachine.io/go-grpc-api/pkg/protocol/rest"
+	"go.smartmachine.io/go-grpc-api/pkg/schema"
+	servicev1 "go.smartmachine.io/go-grpc-api/pkg/service/v1"
+	"go.smartmachine.io/go-grpc-api/pkg/webhook"
+)
+
+const (
+	// APIVersion is version of API is provided by server
+	APIVersion = "v1"
+
+	// bufSize is size of in-memory connection buffer
+	bufSize = 1 << 20
+
+	// WebhookMaxAttempts is number of webhook delivery attempts, retries are 10ms apart
+	WebhookMaxAttempts = 3
+)
+
+// Config is configuration of test server
+type Config struct {
+	// AdminToken is bearer token required by Admin service, empty token disables it
+	AdminToken string
+	// LogPayload turns on logging of request/response payloads
+	LogPayload bool
+	// MaxRequestSize is size in bytes HTTP request bodies are limited to, 0 means no limit
+	MaxRequestSize int64
+}
+
+// Server is running ToDo service stack with ready clients
+type Server struct {
+	// DB is database of the service
+	DB *gorm.DB
+	// Bus publishes changes of tasks to watchers
+	Bus *events.Bus
+	// Webhooks posts changes of tasks to webhooks registered through Admin service
+	Webhooks *webhook.Dispatcher
+	// Addr is TCP address of gRPC server for clients dialing by address, e.g. 127.0.0.1:51235
+	Addr string
+	// Conn is gRPC connection to the server
+	Conn *grpc.ClientConn
+	// Client is ToDo service gRPC client
+	Client v1.ToDoServiceClient
+	// Admin is Admin service gRPC client
+	Admin v1.AdminServiceClient
+	// REST is ToDo service client calling HTTP/REST gateway
+	REST v1.ToDoServiceClient
+	// URL is base URL of HTTP/REST gateway, e.g. http://127.0.0.1:51234
+	URL string
+
+	dir    string
+	grpc   *grpc.Server
+	http   *httptest.Server
+	cancel context.CancelFunc
+}
+
+// Start starts server, it fails the test if server cannot be started.
+// Server must be closed by Close.
+func Start(t testing.TB, cfg Config) *Server {
+	s, err := start(cfg)
+	if err != nil {
+		if s != nil {
+			s.Close()
+		}
+		t.Fatalf("failed to start ToDo service: %v", err)
+	}
+	return s
+}
+
+// start starts server, partially started server is returned with error to be closed
+func start(cfg Config) (*Server, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &Server{cancel: cancel}
+
+	var err error
+	if s.dir, err = ioutil.TempDir("", "todotest"); err != nil {
+		return s, err
+	}
+	// busy timeout makes concurrent writers wait for lock instead of failing
+	if s.DB, err = gorm.Open("sqlite3", filepath.Join(s.dir, "todo.db")+"?_busy_timeout=5000"); err != nil {
+		return s, err
+	}
+	if err = schema.Migrate(s.DB); err != nil {
+		return s, err
+	}
+
+	s.Bus = events.NewBus(events.DefaultHistorySize)
+	s.Webhooks = webhook.New(s.DB, webhook.Config{
+		MaxAttempts: WebhookMaxAttempts,
+		Backoff:     10 * time.Millisecond,
+		MaxBackoff:  10 * time.Millisecond,
+	})
+	if err = s.Webhooks.Start(ctx, s.Bus); err != nil {
+		return s, err
+	}
+
+	lis := bufconn.Listen(bufSize)
+	s.grpc = grpcserver.NewServer(servicev1.NewToDoServiceServer(s.DB, s.Bus), servicev1.NewAdminServiceServer(s.Webhooks), grpcserver.Config{
+		AdminToken: cfg.AdminToken,
+		LogPayload: cfg.LogPayload,
+	})
+	go func() {
+		_ = s.grpc.Serve(lis)
+	}()
+	tcp, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return s, err
+	}
+	s.Addr = tcp.Addr().String()
+	go func() {
+		_ = s.grpc.Serve(tcp)
+	}()
+
+	dialOpts := []grpc.DialOption{
+		grpc.WithInsecure(),
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return lis.Dial()
+		}),
+	}
+	if s.Conn, err = grpc.DialContext(ctx, "bufnet", dialOpts...); err != nil {
+		return s, err
+	}
+	s.Client = v1.NewToDoServiceClient(s.Conn)
+	s.Admin = v1.NewAdminServiceClient(s.Conn)
+
+	handler, err := restserver.NewHandler(ctx, "bufnet", dialOpts, restserver.Config{
+		LogPayload:     cfg.LogPayload,
+		MaxRequestSize: cfg.MaxRequestSize,
+	})
+	if err != nil {
+		return s, err
+	}
+	s.http = httptest.NewServer(handler)
+	s.URL = s.http.URL
+	s.REST = rest.NewToDoServiceClient(s.URL, s.http.Client())
+
+	return s, nil
+}
+
+// Seed creates tasks through gRPC and returns their IDs
+func (s *Server) Seed(t testing.TB, toDos ...*v1.ToDo) []int64 {
+	ids := make([]int64, 0, len(toDos))
+	for _, td := range toDos {
+		res, err := s.Client.Create(context.Background(), &v1.CreateRequest{Api: APIVersion, ToDo: td})
+		if err != nil {
+			t.Fatalf("failed to seed task %q: %v", td.Title, err)
+		}
+		ids = append(ids, res.Id)
+	}
+	return ids
+}
+
+// Close stops servers, closes clients and removes database
+func (s *Server) Close() {
+	// end watch streams first, HTTP server waits for active requests
+	if s.Bus != nil {
+		s.Bus.Close()
+	}
+	if s.http != nil {
+		s.http.Close()
+	}
+	if s.Conn != nil {
+		s.Conn.Close()
+	}
+	if s.grpc != nil {
+		s.grpc.Stop()
+	}
+	s.cancel()
+	// webhook deliveries use database
+	if s.Webhooks != nil {
+		s.Webhooks.Wait()
+	}
+	if s.DB != nil {
+		s.DB.Close()
+	}
+	if len(s.dir) > 0 {
+		os.RemoveAll(s.dir)
+	}
+}
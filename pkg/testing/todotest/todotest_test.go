@@ -0,0 +1,54 @@
+package todotest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
+)
+
+func TestStart(t *testing.T) {
+	srv := Start(t, Config{AdminToken: "secret"})
+	defer srv.Close()
+
+	ctx := context.Background()
+	reminder, _ := ptypes.TimestampProto(time.Now())
+	ids := srv.Seed(t, &v1.ToDo{Title: "first", Reminder: reminder}, &v1.ToDo{Title: "second", Reminder: reminder})
+	if len(ids) != 2 {
+		t.Fatalf("Seed() = %v", ids)
+	}
+
+	// task created over gRPC is read over REST
+	res, err := srv.REST.Read(ctx, &v1.ReadRequest{Api: APIVersion, Id: ids[1]})
+	if err != nil {
+		t.Fatalf("REST Read() error = %v", err)
+	}
+	if res.ToDo.Title != "second" {
+		t.Errorf("REST Read() = %v", res.ToDo)
+	}
+
+	resp, err := http.Get(srv.URL + "/v1/todo/all")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("GET /v1/todo/all status = %v", resp.StatusCode)
+	}
+
+	// admin service requires configured token
+	if _, err := srv.Admin.GetLogLevels(ctx, &v1.GetLogLevelsRequest{Api: APIVersion}); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("GetLogLevels() without token error = %v, want Unauthenticated", err)
+	}
+	ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "bearer secret")
+	if _, err := srv.Admin.GetLogLevels(ctx, &v1.GetLogLevelsRequest{Api: APIVersion}); err != nil {
+		t.Errorf("GetLogLevels() error = %v", err)
+	}
+}
@@ -0,0 +1,139 @@
+// Package webhooktest provides local HTTP receiver of webhook events for tests.
+//
+//	func TestSomething(t *testing.T) {
+//		r := webhooktest.NewReceiver("secret")
+//		defer r.Close()
+//
+//		// register r.URL as webhook with secret "secret"
+//		...
+//		deliveries := r.Wait(t, 1)
+//	}
+package webhooktest
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+
+	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
+	"go.smartmachine.io/go-grpc-api/pkg/webhook"
+)
+
+// WaitTimeout is the longest time Wait waits for deliveries
+const WaitTimeout = 5 * time.Second
+
+// Delivery is event received by Receiver
+type Delivery struct {
+	// Event is posted event
+	Event *v1.Event
+	// Header is header of the request
+	Header http.Header
+	// Verified is true if signature of the request matches secret of Receiver
+	Verified bool
+}
+
+// Receiver is HTTP server recording delivered events
+type Receiver struct {
+	// URL is URL of the receiver to register as webhook
+	URL string
+
+	secret string
+	srv    *httptest.Server
+
+	mu         sync.Mutex
+	changed    chan struct{}
+	deliveries []*Delivery
+	failNext   int
+	attempts   int
+}
+
+// NewReceiver starts receiver verifying signatures with secret, it must be closed by Close
+func NewReceiver(secret string) *Receiver {
+	r := &Receiver{secret: secret, changed: make(chan struct{})}
+	r.srv = httptest.NewServer(http.HandlerFunc(r.serveHTTP))
+	r.URL = r.srv.URL
+	return r
+}
+
+// serveHTTP records event or fails request if failure was requested by FailNext
+func (r *Receiver) serveHTTP(w http.ResponseWriter, req *http.Request) {
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.attempts++
+	if r.failNext != 0 {
+		if r.failNext > 0 {
+			r.failNext--
+		}
+		http.Error(w, "failure requested by test", http.StatusServiceUnavailable)
+		return
+	}
+
+	e := new(v1.Event)
+	if err := jsonpb.Unmarshal(bytes.NewReader(body), e); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	r.deliveries = append(r.deliveries, &Delivery{
+		Event:    e,
+		Header:   req.Header,
+		Verified: webhook.Verify(r.secret, body, req.Header.Get(webhook.SignatureHeader)),
+	})
+	close(r.changed)
+	r.changed = make(chan struct{})
+}
+
+// FailNext makes the next n deliveries fail with HTTP 503, negative n makes all deliveries fail
+func (r *Receiver) FailNext(n int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.failNext = n
+}
+
+// Attempts returns number of requests received, including failed ones
+func (r *Receiver) Attempts() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.attempts
+}
+
+// Deliveries returns events received so far
+func (r *Receiver) Deliveries() []*Delivery {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]*Delivery(nil), r.deliveries...)
+}
+
+// Wait waits until n events were received and returns them, it fails the test after WaitTimeout
+func (r *Receiver) Wait(t testing.TB, n int) []*Delivery {
+	timeout := time.After(WaitTimeout)
+	for {
+		r.mu.Lock()
+		deliveries, changed := append([]*Delivery(nil), r.deliveries...), r.changed
+		r.mu.Unlock()
+		if len(deliveries) >= n {
+			return deliveries
+		}
+		select {
+		case <-changed:
+		case <-timeout:
+			t.Fatalf("received %d webhook events, want %d", len(deliveries), n)
+		}
+	}
+}
+
+// Close stops receiver
+func (r *Receiver) Close() {
+	r.srv.Close()
+}
@@ -0,0 +1,94 @@
+// Package trash purges todo tasks which were in trash longer than retention.
+//
+// Deleted tasks are kept in trash, so they can be restored, until purger
+// deletes them permanently. Replicas sharing the database may all run purger.
+package trash
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"go.uber.org/zap"
+
+	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
+	"go.smartmachine.io/go-grpc-api/pkg/logger"
+	"go.smartmachine.io/go-grpc-api/pkg/revision"
+)
+
+const (
+	// DefaultRetention is how long deleted tasks are kept in trash
+	DefaultRetention = 30 * 24 * time.Hour
+	// DefaultInterval is time between purges
+	DefaultInterval = time.Hour
+)
+
+// Config is configuration of purger
+type Config struct {
+	// Retention is how long deleted tasks are kept in trash, DefaultRetention if 0
+	Retention time.Duration
+	// Interval is time between purges, DefaultInterval if 0
+	Interval time.Duration
+}
+
+// Purger deletes tasks from trash permanently
+type Purger struct {
+	db  *gorm.DB
+	cfg Config
+	// now returns current time, tests replace it
+	now func() time.Time
+}
+
+// New creates purger of tasks in db
+func New(db *gorm.DB, cfg Config) *Purger {
+	if cfg.Retention <= 0 {
+		cfg.Retention = DefaultRetention
+	}
+	if cfg.Interval <= 0 {
+		cfg.Interval = DefaultInterval
+	}
+	return &Purger{db: db, cfg: cfg, now: time.Now}
+}
+
+// Run purges trash every interval until ctx is done
+func (p *Purger) Run(ctx context.Context) error {
+	log := logger.Log.Named("trash")
+	log.Info("starting trash purger...", zap.Duration("retention", p.cfg.Retention), zap.Duration("interval", p.cfg.Interval))
+
+	t := time.NewTicker(p.cfg.Interval)
+	defer t.Stop()
+	for {
+		n, err := p.Purge()
+		if err != nil {
+			log.Warn("failed to purge trash", zap.Error(err))
+		} else if n > 0 {
+			log.Info("trash purged", zap.Int64("purged", n))
+		}
+		select {
+		case <-ctx.Done():
+			log.Info("trash purger stopped")
+			return ctx.Err()
+		case <-t.C:
+		}
+	}
+}
+
+// Purge deletes tasks which were in trash longer than retention and returns their number.
+// Revision history and tag labels of purged tasks are deleted as well.
+func (p *Purger) Purge() (int64, error) {
+	before := p.now().UTC().Add(-p.cfg.Retention)
+	res := p.db.Unscoped().Where("delete_time < ?", before).Delete(&v1.ToDoORM{})
+	if res.Error != nil {
+		return 0, fmt.Errorf("failed to purge deleted tasks: %v", res.Error)
+	}
+	if res.RowsAffected > 0 {
+		if _, err := revision.Prune(p.db); err != nil {
+			return res.RowsAffected, err
+		}
+		if err := p.db.Exec("DELETE FROM to_do_tags WHERE to_do_id NOT IN (SELECT id FROM to_dos)").Error; err != nil {
+			return res.RowsAffected, fmt.Errorf("failed to delete tags of purged tasks: %v", err)
+		}
+	}
+	return res.RowsAffected, nil
+}
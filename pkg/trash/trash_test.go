@@ -0,0 +1,86 @@
+package trash
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/jinzhu/gorm"
+	_ "github.com/jinzhu/gorm/dialects/sqlite"
+
+	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
+	"go.smartmachine.io/go-grpc-api/pkg/revision"
+)
+
+func TestPurger_Purge(t *testing.T) {
+	db, err := gorm.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	db.DB().SetMaxOpenConns(1)
+	if err := db.AutoMigrate(&v1.ToDoORM{}, &v1.TagORM{}).Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := revision.Migrate(db); err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Date(2019, 6, 1, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name      string
+		deletedAt *time.Time
+		wantKept  bool
+	}{
+		{name: "Not deleted", wantKept: true},
+		{name: "Within retention", deletedAt: timePtr(now.Add(-time.Hour)), wantKept: true},
+		{name: "Expired", deletedAt: timePtr(now.Add(-2 * time.Hour))},
+	}
+	ids := make([]int64, len(tests))
+	reminder := ptypes.TimestampNow()
+	for i, tt := range tests {
+		td, err := v1.DefaultCreateToDo(context.Background(), &v1.ToDo{Title: tt.name, Reminder: reminder}, db)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids[i] = td.Id
+		if _, err := revision.Record(db, td); err != nil {
+			t.Fatal(err)
+		}
+		if tt.deletedAt != nil {
+			if err := db.Model(&v1.ToDoORM{Id: td.Id}).Update("delete_time", tt.deletedAt).Error; err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	p := New(db, Config{Retention: 90 * time.Minute})
+	p.now = func() time.Time { return now }
+	if n, err := p.Purge(); err != nil || n != 1 {
+		t.Fatalf("Purge() = %d, %v, want 1 purged", n, err)
+	}
+
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var count int
+			if err := db.Unscoped().Model(&v1.ToDoORM{}).Where("id = ?", ids[i]).Count(&count).Error; err != nil {
+				t.Fatal(err)
+			}
+			if (count == 1) != tt.wantKept {
+				t.Errorf("task kept = %v, want %v", count == 1, tt.wantKept)
+			}
+			revisions, err := revision.List(db, ids[i], 10)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if (len(revisions) == 1) != tt.wantKept {
+				t.Errorf("revisions kept = %v, want %v", len(revisions) == 1, tt.wantKept)
+			}
+		})
+	}
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
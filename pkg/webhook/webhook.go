@@ -0,0 +1,392 @@
+// Package webhook posts changes of todo tasks and fired reminders to registered HTTP endpoints.
+//
+// Events are read from event bus and posted as JSON v1.Event signed by HMAC-SHA256.
+// Failed deliveries are retried with exponential backoff, events which could not be
+// delivered after all attempts are kept as dead letters.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/jinzhu/gorm"
+	"go.uber.org/zap"
+
+	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
+	"go.smartmachine.io/go-grpc-api/pkg/events"
+	"go.smartmachine.io/go-grpc-api/pkg/logger"
+)
+
+const (
+	// SignatureHeader is header with "sha256=" and hex encoded HMAC-SHA256 of body
+	SignatureHeader = "X-Webhook-Signature"
+	// EventHeader is header with type of posted event, e.g. CREATED
+	EventHeader = "X-Webhook-Event"
+	// DeliveryHeader is header with unique ID of delivery, it is the same for retries
+	DeliveryHeader = "X-Webhook-Delivery"
+
+	// DefaultMaxAttempts is number of delivery attempts before event becomes dead letter
+	DefaultMaxAttempts = 5
+	// DefaultBackoff is time before the first retry, it doubles with every retry
+	DefaultBackoff = time.Second
+	// DefaultMaxBackoff is the longest time between retries
+	DefaultMaxBackoff = time.Minute
+	// DefaultTimeout is time limit of one delivery attempt
+	DefaultTimeout = 10 * time.Second
+
+	// signaturePrefix is prefix of signature naming its algorithm
+	signaturePrefix = "sha256="
+	// maxDeadLetters is the largest number of dead letters listed
+	maxDeadLetters = 1000
+	// maxErrorBody is the largest part of error response kept as error of delivery
+	maxErrorBody = 256
+)
+
+// ErrInvalidURL is returned when registered URL is not absolute http or https URL
+var ErrInvalidURL = errors.New("invalid webhook URL, expected absolute http or https URL")
+
+// Endpoint is registered webhook
+type Endpoint struct {
+	// ID is unique integer identifier of the webhook
+	ID int64 `gorm:"primary_key"`
+	// URL is URL events are posted to
+	URL string
+	// Secret is key of payload signatures
+	Secret string
+	// Types is comma separated list of event types delivered, all types if empty
+	Types string
+	// CreatedAt is time the webhook was registered
+	CreatedAt time.Time
+}
+
+// TableName returns table of webhooks
+func (Endpoint) TableName() string {
+	return "webhook_endpoints"
+}
+
+// accepts returns true if events of type typ are delivered to endpoint
+func (e *Endpoint) accepts(typ v1.EventType) bool {
+	if len(e.Types) == 0 {
+		return true
+	}
+	for _, t := range strings.Split(e.Types, ",") {
+		if t == typ.String() {
+			return true
+		}
+	}
+	return false
+}
+
+// DeadLetter is event which could not be delivered to webhook after all attempts
+type DeadLetter struct {
+	// ID is unique integer identifier of the dead letter
+	ID int64 `gorm:"primary_key"`
+	// EndpointID is ID of the webhook
+	EndpointID int64 `gorm:"index"`
+	// URL is URL the event was posted to
+	URL string
+	// Payload is posted JSON event
+	Payload string `gorm:"type:text"`
+	// Attempts is number of delivery attempts
+	Attempts int
+	// LastError is error of the last attempt
+	LastError string
+	// CreatedAt is time the event was given up
+	CreatedAt time.Time
+}
+
+// TableName returns table of dead letters
+func (DeadLetter) TableName() string {
+	return "webhook_dead_letters"
+}
+
+// Migrate creates schema of webhooks
+func Migrate(db *gorm.DB) error {
+	return db.AutoMigrate(&Endpoint{}, &DeadLetter{}).Error
+}
+
+// Sign returns signature of payload sent in SignatureHeader
+func Sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks signature of received payload
+func Verify(secret string, payload []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, payload)), []byte(signature))
+}
+
+// Config is configuration of dispatcher
+type Config struct {
+	// MaxAttempts is number of delivery attempts, DefaultMaxAttempts if 0
+	MaxAttempts int
+	// Backoff is time before the first retry, DefaultBackoff if 0
+	Backoff time.Duration
+	// MaxBackoff is the longest time between retries, DefaultMaxBackoff if 0
+	MaxBackoff time.Duration
+	// Timeout is time limit of one delivery attempt, DefaultTimeout if 0
+	Timeout time.Duration
+	// Client posts events, http.DefaultClient if nil
+	Client *http.Client
+}
+
+// Dispatcher posts events to registered webhooks
+type Dispatcher struct {
+	db        *gorm.DB
+	cfg       Config
+	marshaler jsonpb.Marshaler
+	// running waits for dispatching of events
+	running sync.WaitGroup
+	// deliveries waits for deliveries in progress
+	deliveries sync.WaitGroup
+}
+
+// New creates dispatcher of webhooks registered in db, schema must be created by Migrate
+func New(db *gorm.DB, cfg Config) *Dispatcher {
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = DefaultMaxAttempts
+	}
+	if cfg.Backoff <= 0 {
+		cfg.Backoff = DefaultBackoff
+	}
+	if cfg.MaxBackoff <= 0 {
+		cfg.MaxBackoff = DefaultMaxBackoff
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = DefaultTimeout
+	}
+	if cfg.Client == nil {
+		cfg.Client = http.DefaultClient
+	}
+	return &Dispatcher{db: db, cfg: cfg, marshaler: jsonpb.Marshaler{OrigName: true}}
+}
+
+// Register registers webhook receiving events of types, all types if empty.
+// Secret is generated if it is empty.
+func (d *Dispatcher) Register(rawURL string, types []v1.EventType, secret string) (*Endpoint, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || len(u.Host) == 0 {
+		return nil, ErrInvalidURL
+	}
+	if len(secret) == 0 {
+		b := make([]byte, 32)
+		if _, err := rand.Read(b); err != nil {
+			return nil, fmt.Errorf("failed to generate secret: %v", err)
+		}
+		secret = hex.EncodeToString(b)
+	}
+	names := make([]string, 0, len(types))
+	for _, t := range types {
+		names = append(names, t.String())
+	}
+
+	e := &Endpoint{URL: rawURL, Secret: secret, Types: strings.Join(names, ",")}
+	if err := d.db.Create(e).Error; err != nil {
+		return nil, fmt.Errorf("failed to register webhook: %v", err)
+	}
+	return e, nil
+}
+
+// Endpoints returns all webhooks ordered by ID
+func (d *Dispatcher) Endpoints() ([]*Endpoint, error) {
+	var list []*Endpoint
+	if err := d.db.Order("id").Find(&list).Error; err != nil {
+		return nil, fmt.Errorf("failed to read webhooks: %v", err)
+	}
+	return list, nil
+}
+
+// Delete deletes webhook and returns number of deleted webhooks
+func (d *Dispatcher) Delete(id int64) (int64, error) {
+	res := d.db.Where("id = ?", id).Delete(&Endpoint{})
+	if res.Error != nil {
+		return 0, fmt.Errorf("failed to delete webhook: %v", res.Error)
+	}
+	return res.RowsAffected, nil
+}
+
+// DeadLetters returns the most recent dead letters of webhook, of all webhooks if endpointID is 0
+func (d *Dispatcher) DeadLetters(endpointID int64) ([]*DeadLetter, error) {
+	q := d.db.Order("id desc").Limit(maxDeadLetters)
+	if endpointID > 0 {
+		q = q.Where("endpoint_id = ?", endpointID)
+	}
+	var list []*DeadLetter
+	if err := q.Find(&list).Error; err != nil {
+		return nil, fmt.Errorf("failed to read dead letters: %v", err)
+	}
+	return list, nil
+}
+
+// Start subscribes to bus and posts its events in background until ctx is done or bus is closed.
+// Events published after Start returned are posted.
+func (d *Dispatcher) Start(ctx context.Context, bus *events.Bus) error {
+	sub, err := bus.Subscribe(0, nil)
+	if err != nil {
+		return err
+	}
+	d.running.Add(1)
+	go func() {
+		defer d.running.Done()
+		d.run(ctx, bus, sub)
+	}()
+	return nil
+}
+
+// Wait waits until dispatcher stopped and deliveries in progress ended
+func (d *Dispatcher) Wait() {
+	d.running.Wait()
+	d.deliveries.Wait()
+}
+
+// run posts events of subscription, it subscribes again if dispatcher fell behind
+func (d *Dispatcher) run(ctx context.Context, bus *events.Bus, sub *events.Subscription) {
+	log := logger.Log.Named("webhook")
+	ctx = logger.WithContext(ctx, log)
+
+	last := sub.Sequence
+	for {
+		err := d.dispatchAll(ctx, log, sub, &last)
+		if err != events.ErrSlowSubscriber {
+			return
+		}
+		// missed events are replayed from history
+		if sub, err = bus.Subscribe(last, nil); err == events.ErrHistoryExpired {
+			log.Warn("webhook events were lost", zap.Int64("after", last))
+			sub, err = bus.Subscribe(0, nil)
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+// dispatchAll dispatches events of subscription until it ends, last is set to sequence of dispatched event.
+// It returns reason the subscription ended, nil if bus was closed.
+func (d *Dispatcher) dispatchAll(ctx context.Context, log *zap.Logger, sub *events.Subscription, last *int64) error {
+	defer sub.Close()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case e, ok := <-sub.C:
+			if !ok {
+				if err := sub.Err(); err != events.ErrClosed {
+					return err
+				}
+				return nil
+			}
+			*last = e.Sequence
+			// deliveries run in background, so the subscription keeps up with bus
+			if err := d.Dispatch(ctx, e); err != nil {
+				log.Warn("failed to dispatch event", zap.Int64("sequence", e.Sequence), zap.Error(err))
+			}
+		}
+	}
+}
+
+// Dispatch starts delivery of event to webhooks accepting its type
+func (d *Dispatcher) Dispatch(ctx context.Context, e *v1.Event) error {
+	list, err := d.Endpoints()
+	if err != nil {
+		return err
+	}
+	var b bytes.Buffer
+	if err := d.marshaler.Marshal(&b, e); err != nil {
+		return fmt.Errorf("failed to marshal event: %v", err)
+	}
+	for _, ep := range list {
+		if !ep.accepts(e.Type) {
+			continue
+		}
+		d.deliveries.Add(1)
+		go func(ep *Endpoint) {
+			defer d.deliveries.Done()
+			d.deliver(ctx, ep, e, b.Bytes())
+		}(ep)
+	}
+	return nil
+}
+
+// deliver posts event to webhook, it retries with exponential backoff and
+// keeps event as dead letter when all attempts failed or ctx is done
+func (d *Dispatcher) deliver(ctx context.Context, ep *Endpoint, e *v1.Event, payload []byte) {
+	log := logger.FromContext(ctx).With(zap.Int64("webhook", ep.ID), zap.Int64("sequence", e.Sequence))
+	backoff := d.cfg.Backoff
+	attempt := 1
+	for ; ; attempt++ {
+		err := d.post(ctx, ep, e, payload)
+		if err == nil {
+			log.Debug("webhook event delivered", zap.Int("attempt", attempt))
+			return
+		}
+		log.Warn("failed to deliver webhook event", zap.Int("attempt", attempt), zap.Error(err))
+		if attempt == d.cfg.MaxAttempts {
+			d.deadLetter(log, ep, payload, attempt, err)
+			return
+		}
+
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			d.deadLetter(log, ep, payload, attempt, err)
+			return
+		}
+		if backoff *= 2; backoff > d.cfg.MaxBackoff {
+			backoff = d.cfg.MaxBackoff
+		}
+	}
+}
+
+// post posts event to webhook once
+func (d *Dispatcher) post(ctx context.Context, ep *Endpoint, e *v1.Event, payload []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, d.cfg.Timeout)
+	defer cancel()
+
+	req, err := http.NewRequest(http.MethodPost, ep.URL, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, e.Type.String())
+	req.Header.Set(DeliveryHeader, strconv.FormatInt(ep.ID, 10)+"-"+strconv.FormatInt(e.Sequence, 10))
+	req.Header.Set(SignatureHeader, Sign(ep.Secret, payload))
+
+	resp, err := d.cfg.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("HTTP %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	return nil
+}
+
+// deadLetter keeps event which could not be delivered
+func (d *Dispatcher) deadLetter(log *zap.Logger, ep *Endpoint, payload []byte, attempts int, err error) {
+	dl := &DeadLetter{EndpointID: ep.ID, URL: ep.URL, Payload: string(payload), Attempts: attempts, LastError: err.Error()}
+	if err := d.db.Create(dl).Error; err != nil {
+		log.Error("failed to keep dead letter", zap.Error(err))
+		return
+	}
+	log.Warn("webhook event is dead letter", zap.Int64("deadLetter", dl.ID), zap.Int("attempts", attempts))
+}
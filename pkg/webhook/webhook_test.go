@@ -0,0 +1,137 @@
+package webhook_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	_ "github.com/jinzhu/gorm/dialects/sqlite"
+
+	"go.smartmachine.io/go-grpc-api/pkg/api/v1"
+	"go.smartmachine.io/go-grpc-api/pkg/testing/webhooktest"
+	"go.smartmachine.io/go-grpc-api/pkg/webhook"
+)
+
+func TestSignVerify(t *testing.T) {
+	payload := []byte(`{"sequence":"1"}`)
+	sig := webhook.Sign("secret", payload)
+
+	tests := []struct {
+		name      string
+		secret    string
+		payload   []byte
+		signature string
+		want      bool
+	}{
+		{name: "Valid", secret: "secret", payload: payload, signature: sig, want: true},
+		{name: "Other secret", secret: "other", payload: payload, signature: sig},
+		{name: "Changed payload", secret: "secret", payload: []byte(`{"sequence":"2"}`), signature: sig},
+		{name: "Missing signature", secret: "secret", payload: payload},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := webhook.Verify(tt.secret, tt.payload, tt.signature); got != tt.want {
+				t.Errorf("Verify() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDispatcher_Dispatch(t *testing.T) {
+	tests := []struct {
+		name            string
+		types           []v1.EventType
+		event           v1.EventType
+		failNext        int
+		wantDelivered   bool
+		wantAttempts    int
+		wantDeadLetters int
+	}{
+		{name: "Delivered", event: v1.EventType_CREATED, wantDelivered: true, wantAttempts: 1},
+		{name: "Retried", event: v1.EventType_CREATED, failNext: 2, wantDelivered: true, wantAttempts: 3},
+		{name: "Dead letter", event: v1.EventType_CREATED, failNext: -1, wantAttempts: 3, wantDeadLetters: 1},
+		{name: "Accepted type", types: []v1.EventType{v1.EventType_REMINDED}, event: v1.EventType_REMINDED,
+			wantDelivered: true, wantAttempts: 1},
+		{name: "Filtered type", types: []v1.EventType{v1.EventType_REMINDED}, event: v1.EventType_CREATED},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, err := gorm.Open("sqlite3", ":memory:")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer db.Close()
+			db.DB().SetMaxOpenConns(1)
+			if err := webhook.Migrate(db); err != nil {
+				t.Fatal(err)
+			}
+
+			r := webhooktest.NewReceiver("secret")
+			defer r.Close()
+			r.FailNext(tt.failNext)
+
+			d := webhook.New(db, webhook.Config{MaxAttempts: 3, Backoff: time.Millisecond})
+			ep, err := d.Register(r.URL, tt.types, "secret")
+			if err != nil {
+				t.Fatal(err)
+			}
+			e := &v1.Event{Sequence: 7, Type: tt.event, ToDo: &v1.ToDo{Id: 1, Title: "task"}}
+			if err := d.Dispatch(context.Background(), e); err != nil {
+				t.Fatal(err)
+			}
+			d.Wait()
+
+			if got := r.Attempts(); got != tt.wantAttempts {
+				t.Errorf("attempts = %d, want %d", got, tt.wantAttempts)
+			}
+			deliveries := r.Deliveries()
+			if (len(deliveries) == 1) != tt.wantDelivered {
+				t.Fatalf("deliveries = %d, want delivered %v", len(deliveries), tt.wantDelivered)
+			}
+			if tt.wantDelivered {
+				got := deliveries[0]
+				if !got.Verified || got.Event.Sequence != 7 || got.Event.ToDo.Title != "task" ||
+					got.Header.Get(webhook.EventHeader) != tt.event.String() {
+					t.Errorf("delivery = %+v", got)
+				}
+			}
+
+			letters, err := d.DeadLetters(ep.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(letters) != tt.wantDeadLetters {
+				t.Fatalf("dead letters = %d, want %d", len(letters), tt.wantDeadLetters)
+			}
+			if len(letters) > 0 && (letters[0].Attempts != 3 || len(letters[0].LastError) == 0) {
+				t.Errorf("dead letter = %+v", letters[0])
+			}
+		})
+	}
+}
+
+func TestDispatcher_Register(t *testing.T) {
+	db, err := gorm.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	if err := webhook.Migrate(db); err != nil {
+		t.Fatal(err)
+	}
+	d := webhook.New(db, webhook.Config{})
+
+	for _, u := range []string{"", "ftp://example.com", "/hooks", "http://"} {
+		if _, err := d.Register(u, nil, ""); err != webhook.ErrInvalidURL {
+			t.Errorf("Register(%q) error = %v, want ErrInvalidURL", u, err)
+		}
+	}
+	ep, err := d.Register("https://example.com/hooks", nil, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ep.Secret) != 64 {
+		t.Errorf("generated secret = %q", ep.Secret)
+	}
+}